@@ -17,16 +17,113 @@
 package config
 
 import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"time"
+
 	"github.com/devicechain-io/dc-microservice/config"
 )
 
 const (
 	KAFKA_TOPIC_FAILED_EVENTS   = "failed-events"
 	KAFKA_TOPIC_RESOLVED_EVENTS = "resolved-events"
+	KAFKA_TOPIC_THROTTLE_EVENTS = "throttle-events"
+
+	DEDUP_BACKEND_MEMORY = "memory"
+	DEDUP_BACKEND_REDIS  = "redis"
+
+	MICROSERVICE_CONFIG_PATH = "/etc/dct-config" // Configmap volume mapping for microservice configuration
+	CONFIG_RELOAD_INTERVAL   = 15 * time.Second  // How often configuration is checked for changes
+
+	ENV_CA_KEY_ENCRYPTION_KEY = "DC_CA_KEY_ENCRYPTION_KEY" // Base64 encoded AES-256 key, mapped from a secret
 )
 
+// Load the key used to encrypt certificate authority keys. Returns nil if none is configured.
+func LoadCaKeyEncryptionKey() ([]byte, error) {
+	encoded := os.Getenv(ENV_CA_KEY_ENCRYPTION_KEY)
+	if encoded == "" {
+		return nil, nil
+	}
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%s is not valid base64: %w", ENV_CA_KEY_ENCRYPTION_KEY, err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("%s must be a 32 byte key", ENV_CA_KEY_ENCRYPTION_KEY)
+	}
+	return key, nil
+}
+
+// Settings for retrying api calls that fail with transient errors during event resolution.
+type RetryConfiguration struct {
+	MaxRetries       int
+	InitialBackoffMs int
+	MaxBackoffMs     int
+}
+
+// Sizing of inbound event processing. Changes are applied without a restart.
+type ProcessorConfiguration struct {
+	ResolverCount         int // Number of event resolvers running in parallel
+	InboundBacklogSize    int // Number of Kafka messages that can be waiting for each resolver
+	FailedBacklogSize     int // Number of failed events that can be waiting to push to kafka
+	ResolvedBacklogSize   int // Number of resolved events that can be waiting to push to kafka
+	WriterBatchSize       int // Maximum number of events written to kafka in a single batch
+	WriterFlushIntervalMs int // Maximum time a partial batch of events waits before being written
+}
+
+// Settings for dropping events with a (device, AltId) pair seen within a window.
+type DeduplicationConfiguration struct {
+	Enabled   bool
+	Backend   string // Either "memory" (per instance) or "redis" (shared across instances)
+	WindowMs  int
+	PendingMs int // Time an event is held as seen while it is processed, before it has been written
+	CacheSize int // Maximum number of entries kept by the memory backend
+}
+
+// Default limits on inbound event rates for devices. Device types and devices may override these.
+type RateLimitConfiguration struct {
+	DefaultRate   float64 // Events allowed per second for each device (0 means unlimited)
+	DefaultBurst  int     // Events allowed in a burst above the rate
+	IdleTimeoutMs int     // Time without events after which a device's limit state is discarded
+	MaxDevices    int     // Maximum number of devices with limit state kept (0 means no limit)
+}
+
+// Settings for exporting traces of event processing and graphql requests.
+type TracingConfiguration struct {
+	Enabled     bool
+	Endpoint    string  // Host and port of OTLP/HTTP trace collector
+	Insecure    bool    // Send traces without TLS
+	SampleRatio float64 // Fraction of new traces that are sampled
+}
+
+// Settings for liveness and readiness endpoints.
+type HealthConfiguration struct {
+	Port             int   // Port on which health endpoints are served
+	StallThresholdMs int   // Time events may wait without any being resolved before the pipeline is stuck
+	MaxConsumerLag   int64 // Inbound consumer lag above which the instance is not ready (0 means no limit)
+}
+
+// Settings for reloading rules cached by event processing.
+type ReloadConfiguration struct {
+	IntervalMs int // How often cached rules are reloaded to pick up changes made by other instances
+}
+
+// Settings for fanning out events along tracked asset, area and customer relationships.
+type FanOutConfiguration struct {
+	MaxDepth int // Number of relationships followed beyond a device relationship (0 disables fan-out)
+}
+
 type DeviceManagementConfiguration struct {
 	RdbConfiguration config.MicroserviceDatastoreConfiguration
+	Retry            RetryConfiguration
+	Processor        ProcessorConfiguration
+	Deduplication    DeduplicationConfiguration
+	RateLimits       RateLimitConfiguration
+	Tracing          TracingConfiguration
+	Health           HealthConfiguration
+	Reload           ReloadConfiguration
+	FanOut           FanOutConfiguration
 }
 
 // Creates the default device management configuration
@@ -35,5 +132,88 @@ func NewDeviceManagementConfiguration() *DeviceManagementConfiguration {
 		RdbConfiguration: config.MicroserviceDatastoreConfiguration{
 			SqlDebug: true,
 		},
+		Retry:         NewRetryConfiguration(),
+		Processor:     NewProcessorConfiguration(),
+		Deduplication: NewDeduplicationConfiguration(),
+		RateLimits:    NewRateLimitConfiguration(),
+		Tracing:       NewTracingConfiguration(),
+		Health:        NewHealthConfiguration(),
+		Reload:        NewReloadConfiguration(),
+		FanOut:        NewFanOutConfiguration(),
+	}
+}
+
+// Creates the default reload configuration
+func NewReloadConfiguration() ReloadConfiguration {
+	return ReloadConfiguration{
+		IntervalMs: 30000,
+	}
+}
+
+// Creates the default fan-out configuration
+func NewFanOutConfiguration() FanOutConfiguration {
+	return FanOutConfiguration{
+		MaxDepth: 3,
+	}
+}
+
+// Creates the default deduplication configuration
+func NewDeduplicationConfiguration() DeduplicationConfiguration {
+	return DeduplicationConfiguration{
+		Enabled:   false,
+		Backend:   DEDUP_BACKEND_MEMORY,
+		WindowMs:  60000,
+		PendingMs: 10000,
+		CacheSize: 100000,
+	}
+}
+
+// Creates the default health configuration
+func NewHealthConfiguration() HealthConfiguration {
+	return HealthConfiguration{
+		Port:             8081,
+		StallThresholdMs: 60000,
+		MaxConsumerLag:   0,
+	}
+}
+
+// Creates the default tracing configuration
+func NewTracingConfiguration() TracingConfiguration {
+	return TracingConfiguration{
+		Enabled:     false,
+		Endpoint:    "otel-collector:4318",
+		Insecure:    true,
+		SampleRatio: 1.0,
+	}
+}
+
+// Creates the default rate limit configuration
+func NewRateLimitConfiguration() RateLimitConfiguration {
+	return RateLimitConfiguration{
+		DefaultRate:   0,
+		DefaultBurst:  10,
+		IdleTimeoutMs: 60000,
+		MaxDevices:    100000,
+	}
+}
+
+// Creates the default processor configuration
+func NewProcessorConfiguration() ProcessorConfiguration {
+	return ProcessorConfiguration{
+		ResolverCount:         5,
+		InboundBacklogSize:    100,
+		FailedBacklogSize:     100,
+		ResolvedBacklogSize:   100,
+		WriterBatchSize:       50,
+		WriterFlushIntervalMs: 100,
+	}
+}
+
+// Creates the default retry configuration
+func NewRetryConfiguration() RetryConfiguration {
+	return RetryConfiguration{
+		MaxRetries:       5,
+		InitialBackoffMs: 50,
+		MaxBackoffMs:     2000,
 	}
 }
@@ -193,7 +193,9 @@ func CreateAreaRelationshipType(
 	request model.AreaRelationshipTypeCreateRequest,
 ) (IAreaRelationshipType, error) {
 	cresp, err := createAreaRelationshipType(ctx, client, request.Token, request.Name,
-		request.Description, request.Metadata)
+		request.Description, request.Metadata, request.Tracked,
+		request.Cardinality, request.OnConflict, optionalStrings(request.AllowedTargetKinds),
+		optionalStrings(request.AllowedSourceTypes), optionalStrings(request.AllowedTargetTypes))
 	if err != nil {
 		return nil, err
 	}
@@ -409,7 +411,9 @@ func CreateAreaGroupRelationshipType(
 	request model.AreaGroupRelationshipTypeCreateRequest,
 ) (IAreaGroupRelationshipType, error) {
 	cresp, err := createAreaGroupRelationshipType(ctx, client, request.Token, request.Name,
-		request.Description, request.Metadata)
+		request.Description, request.Metadata,
+		request.Cardinality, request.OnConflict, optionalStrings(request.AllowedTargetKinds),
+		optionalStrings(request.AllowedSourceTypes), optionalStrings(request.AllowedTargetTypes))
 	if err != nil {
 		return nil, err
 	}
@@ -193,7 +193,9 @@ func CreateAssetRelationshipType(
 	request model.AssetRelationshipTypeCreateRequest,
 ) (IAssetRelationshipType, error) {
 	cresp, err := createAssetRelationshipType(ctx, client, request.Token, request.Name,
-		request.Description, request.Metadata)
+		request.Description, request.Metadata, request.Tracked,
+		request.Cardinality, request.OnConflict, optionalStrings(request.AllowedTargetKinds),
+		optionalStrings(request.AllowedSourceTypes), optionalStrings(request.AllowedTargetTypes))
 	if err != nil {
 		return nil, err
 	}
@@ -409,7 +411,9 @@ func CreateAssetGroupRelationshipType(
 	request model.AssetGroupRelationshipTypeCreateRequest,
 ) (IAssetGroupRelationshipType, error) {
 	cresp, err := createAssetGroupRelationshipType(ctx, client, request.Token, request.Name,
-		request.Description, request.Metadata)
+		request.Description, request.Metadata,
+		request.Cardinality, request.OnConflict, optionalStrings(request.AllowedTargetKinds),
+		optionalStrings(request.AllowedSourceTypes), optionalStrings(request.AllowedTargetTypes))
 	if err != nil {
 		return nil, err
 	}
@@ -35,3 +35,20 @@ func targets(req model.EntityRelationshipCreateRequest) EntityRelationshipTarget
 		TargetCustomerGroup: req.TargetCustomerGroup,
 	}
 }
+
+// Converts an optional 32-bit integer into the generated datatype.
+func optionalInt(val *int32) *int {
+	if val == nil {
+		return nil
+	}
+	result := int(*val)
+	return &result
+}
+
+// Converts an optional list of strings into the generated datatype.
+func optionalStrings(val *[]string) []string {
+	if val == nil {
+		return nil
+	}
+	return *val
+}
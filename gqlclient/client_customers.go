@@ -193,7 +193,9 @@ func CreateCustomerRelationshipType(
 	request model.CustomerRelationshipTypeCreateRequest,
 ) (ICustomerRelationshipType, error) {
 	cresp, err := createCustomerRelationshipType(ctx, client, request.Token, request.Name,
-		request.Description, request.Metadata)
+		request.Description, request.Metadata, request.Tracked,
+		request.Cardinality, request.OnConflict, optionalStrings(request.AllowedTargetKinds),
+		optionalStrings(request.AllowedSourceTypes), optionalStrings(request.AllowedTargetTypes))
 	if err != nil {
 		return nil, err
 	}
@@ -409,7 +411,9 @@ func CreateCustomerGroupRelationshipType(
 	request model.CustomerGroupRelationshipTypeCreateRequest,
 ) (ICustomerGroupRelationshipType, error) {
 	cresp, err := createCustomerGroupRelationshipType(ctx, client, request.Token, request.Name,
-		request.Description, request.Metadata)
+		request.Description, request.Metadata,
+		request.Cardinality, request.OnConflict, optionalStrings(request.AllowedTargetKinds),
+		optionalStrings(request.AllowedSourceTypes), optionalStrings(request.AllowedTargetTypes))
 	if err != nil {
 		return nil, err
 	}
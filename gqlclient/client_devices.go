@@ -51,7 +51,7 @@ func CreateDeviceType(
 ) (IDeviceType, error) {
 	cresp, err := createDeviceType(ctx, client, request.Token, request.Name, request.Description,
 		request.ImageUrl, request.Icon, request.BackgroundColor, request.ForegroundColor,
-		request.BorderColor, request.Metadata)
+		request.BorderColor, request.Metadata, request.RateLimit, optionalInt(request.RateBurst))
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +122,8 @@ func CreateDevice(
 	request model.DeviceCreateRequest,
 ) (IDevice, error) {
 	cresp, err := createDevice(ctx, client, request.Token, request.DeviceTypeToken,
-		request.Name, request.Description, request.Metadata)
+		request.Name, request.Description, request.Status, request.Metadata,
+		request.RateLimit, optionalInt(request.RateBurst))
 	if err != nil {
 		return nil, err
 	}
@@ -193,7 +194,10 @@ func CreateDeviceRelationshipType(
 	request model.DeviceRelationshipTypeCreateRequest,
 ) (IDeviceRelationshipType, error) {
 	cresp, err := createDeviceRelationshipType(ctx, client, request.Token, request.Name,
-		request.Description, request.Metadata, request.Tracked)
+		request.Description, request.Metadata, request.Tracked, request.Enriched,
+		optionalStrings(request.EnrichedMetadataKeys),
+		request.Cardinality, request.OnConflict, optionalStrings(request.AllowedTargetKinds),
+		optionalStrings(request.AllowedSourceTypes), optionalStrings(request.AllowedTargetTypes))
 	if err != nil {
 		return nil, err
 	}
@@ -410,7 +414,9 @@ func CreateDeviceGroupRelationshipType(
 	request model.DeviceGroupRelationshipTypeCreateRequest,
 ) (IDeviceGroupRelationshipType, error) {
 	cresp, err := createDeviceGroupRelationshipType(ctx, client, request.Token, request.Name,
-		request.Description, request.Metadata)
+		request.Description, request.Metadata,
+		request.Cardinality, request.OnConflict, optionalStrings(request.AllowedTargetKinds),
+		optionalStrings(request.AllowedSourceTypes), optionalStrings(request.AllowedTargetTypes))
 	if err != nil {
 		return nil, err
 	}
@@ -526,3 +532,16 @@ func ListDeviceGroupRelationships(
 	}
 	return results, &resp.DeviceGroupRelationships.Pagination.DefaultPagination, nil
 }
+
+// Verify authentication material presented by a device.
+func VerifyDeviceCredential(
+	ctx context.Context,
+	client graphql.Client,
+	request model.DeviceCredentialVerifyRequest,
+) (IDeviceCredentialVerification, error) {
+	vresp, err := verifyDeviceCredential(ctx, client, request.Device, request.CredentialType, request.Value)
+	if err != nil {
+		return nil, err
+	}
+	return &vresp.VerifyDeviceCredential, nil
+}
@@ -317,14 +317,19 @@ func (v *DefaultAreaGroupRelationshipTargetsEntityRelationshipTargets) __premars
 
 // Content associated with area group relationship type.
 type DefaultAreaGroupRelationshipType struct {
-	Id          string  `json:"id"`
-	CreatedAt   *string `json:"createdAt"`
-	UpdatedAt   *string `json:"updatedAt"`
-	DeletedAt   *string `json:"deletedAt"`
-	Token       string  `json:"token"`
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
-	Metadata    *string `json:"metadata"`
+	Id                 string   `json:"id"`
+	CreatedAt          *string  `json:"createdAt"`
+	UpdatedAt          *string  `json:"updatedAt"`
+	DeletedAt          *string  `json:"deletedAt"`
+	Token              string   `json:"token"`
+	Name               *string  `json:"name"`
+	Description        *string  `json:"description"`
+	Metadata           *string  `json:"metadata"`
+	Cardinality        string   `json:"cardinality"`
+	OnConflict         string   `json:"onConflict"`
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 // GetId returns DefaultAreaGroupRelationshipType.Id, and is useful for accessing the field via an interface.
@@ -351,6 +356,27 @@ func (v *DefaultAreaGroupRelationshipType) GetDescription() *string { return v.D
 // GetMetadata returns DefaultAreaGroupRelationshipType.Metadata, and is useful for accessing the field via an interface.
 func (v *DefaultAreaGroupRelationshipType) GetMetadata() *string { return v.Metadata }
 
+// GetCardinality returns DefaultAreaGroupRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *DefaultAreaGroupRelationshipType) GetCardinality() string { return v.Cardinality }
+
+// GetOnConflict returns DefaultAreaGroupRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *DefaultAreaGroupRelationshipType) GetOnConflict() string { return v.OnConflict }
+
+// GetAllowedTargetKinds returns DefaultAreaGroupRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *DefaultAreaGroupRelationshipType) GetAllowedTargetKinds() []string {
+	return v.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns DefaultAreaGroupRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *DefaultAreaGroupRelationshipType) GetAllowedSourceTypes() []string {
+	return v.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns DefaultAreaGroupRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *DefaultAreaGroupRelationshipType) GetAllowedTargetTypes() []string {
+	return v.AllowedTargetTypes
+}
+
 // Content associated with area relationship response.
 type DefaultAreaRelationship struct {
 	Id               string                                                      `json:"id"`
@@ -547,14 +573,20 @@ func (v *DefaultAreaRelationshipTargetsEntityRelationshipTargets) __premarshalJS
 
 // Content associated with area relationship type response.
 type DefaultAreaRelationshipType struct {
-	Id          string  `json:"id"`
-	CreatedAt   *string `json:"createdAt"`
-	UpdatedAt   *string `json:"updatedAt"`
-	DeletedAt   *string `json:"deletedAt"`
-	Token       string  `json:"token"`
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
-	Metadata    *string `json:"metadata"`
+	Id                 string   `json:"id"`
+	CreatedAt          *string  `json:"createdAt"`
+	UpdatedAt          *string  `json:"updatedAt"`
+	DeletedAt          *string  `json:"deletedAt"`
+	Token              string   `json:"token"`
+	Name               *string  `json:"name"`
+	Description        *string  `json:"description"`
+	Metadata           *string  `json:"metadata"`
+	Tracked            bool     `json:"tracked"`
+	Cardinality        string   `json:"cardinality"`
+	OnConflict         string   `json:"onConflict"`
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 // GetId returns DefaultAreaRelationshipType.Id, and is useful for accessing the field via an interface.
@@ -581,6 +613,24 @@ func (v *DefaultAreaRelationshipType) GetDescription() *string { return v.Descri
 // GetMetadata returns DefaultAreaRelationshipType.Metadata, and is useful for accessing the field via an interface.
 func (v *DefaultAreaRelationshipType) GetMetadata() *string { return v.Metadata }
 
+// GetTracked returns DefaultAreaRelationshipType.Tracked, and is useful for accessing the field via an interface.
+func (v *DefaultAreaRelationshipType) GetTracked() bool { return v.Tracked }
+
+// GetCardinality returns DefaultAreaRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *DefaultAreaRelationshipType) GetCardinality() string { return v.Cardinality }
+
+// GetOnConflict returns DefaultAreaRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *DefaultAreaRelationshipType) GetOnConflict() string { return v.OnConflict }
+
+// GetAllowedTargetKinds returns DefaultAreaRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *DefaultAreaRelationshipType) GetAllowedTargetKinds() []string { return v.AllowedTargetKinds }
+
+// GetAllowedSourceTypes returns DefaultAreaRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *DefaultAreaRelationshipType) GetAllowedSourceTypes() []string { return v.AllowedSourceTypes }
+
+// GetAllowedTargetTypes returns DefaultAreaRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *DefaultAreaRelationshipType) GetAllowedTargetTypes() []string { return v.AllowedTargetTypes }
+
 // Content associated with area type response.
 type DefaultAreaType struct {
 	Id              string  `json:"id"`
@@ -947,14 +997,19 @@ func (v *DefaultAssetGroupRelationshipTargetsEntityRelationshipTargets) __premar
 
 // Content associated with asset group relationship type.
 type DefaultAssetGroupRelationshipType struct {
-	Id          string  `json:"id"`
-	CreatedAt   *string `json:"createdAt"`
-	UpdatedAt   *string `json:"updatedAt"`
-	DeletedAt   *string `json:"deletedAt"`
-	Token       string  `json:"token"`
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
-	Metadata    *string `json:"metadata"`
+	Id                 string   `json:"id"`
+	CreatedAt          *string  `json:"createdAt"`
+	UpdatedAt          *string  `json:"updatedAt"`
+	DeletedAt          *string  `json:"deletedAt"`
+	Token              string   `json:"token"`
+	Name               *string  `json:"name"`
+	Description        *string  `json:"description"`
+	Metadata           *string  `json:"metadata"`
+	Cardinality        string   `json:"cardinality"`
+	OnConflict         string   `json:"onConflict"`
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 // GetId returns DefaultAssetGroupRelationshipType.Id, and is useful for accessing the field via an interface.
@@ -981,6 +1036,27 @@ func (v *DefaultAssetGroupRelationshipType) GetDescription() *string { return v.
 // GetMetadata returns DefaultAssetGroupRelationshipType.Metadata, and is useful for accessing the field via an interface.
 func (v *DefaultAssetGroupRelationshipType) GetMetadata() *string { return v.Metadata }
 
+// GetCardinality returns DefaultAssetGroupRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *DefaultAssetGroupRelationshipType) GetCardinality() string { return v.Cardinality }
+
+// GetOnConflict returns DefaultAssetGroupRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *DefaultAssetGroupRelationshipType) GetOnConflict() string { return v.OnConflict }
+
+// GetAllowedTargetKinds returns DefaultAssetGroupRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *DefaultAssetGroupRelationshipType) GetAllowedTargetKinds() []string {
+	return v.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns DefaultAssetGroupRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *DefaultAssetGroupRelationshipType) GetAllowedSourceTypes() []string {
+	return v.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns DefaultAssetGroupRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *DefaultAssetGroupRelationshipType) GetAllowedTargetTypes() []string {
+	return v.AllowedTargetTypes
+}
+
 // Content associated with asset relationship response.
 type DefaultAssetRelationship struct {
 	Id               string                                                        `json:"id"`
@@ -1177,14 +1253,20 @@ func (v *DefaultAssetRelationshipTargetsEntityRelationshipTargets) __premarshalJ
 
 // Content associated with asset relationship type response.
 type DefaultAssetRelationshipType struct {
-	Id          string  `json:"id"`
-	CreatedAt   *string `json:"createdAt"`
-	UpdatedAt   *string `json:"updatedAt"`
-	DeletedAt   *string `json:"deletedAt"`
-	Token       string  `json:"token"`
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
-	Metadata    *string `json:"metadata"`
+	Id                 string   `json:"id"`
+	CreatedAt          *string  `json:"createdAt"`
+	UpdatedAt          *string  `json:"updatedAt"`
+	DeletedAt          *string  `json:"deletedAt"`
+	Token              string   `json:"token"`
+	Name               *string  `json:"name"`
+	Description        *string  `json:"description"`
+	Metadata           *string  `json:"metadata"`
+	Tracked            bool     `json:"tracked"`
+	Cardinality        string   `json:"cardinality"`
+	OnConflict         string   `json:"onConflict"`
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 // GetId returns DefaultAssetRelationshipType.Id, and is useful for accessing the field via an interface.
@@ -1211,6 +1293,24 @@ func (v *DefaultAssetRelationshipType) GetDescription() *string { return v.Descr
 // GetMetadata returns DefaultAssetRelationshipType.Metadata, and is useful for accessing the field via an interface.
 func (v *DefaultAssetRelationshipType) GetMetadata() *string { return v.Metadata }
 
+// GetTracked returns DefaultAssetRelationshipType.Tracked, and is useful for accessing the field via an interface.
+func (v *DefaultAssetRelationshipType) GetTracked() bool { return v.Tracked }
+
+// GetCardinality returns DefaultAssetRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *DefaultAssetRelationshipType) GetCardinality() string { return v.Cardinality }
+
+// GetOnConflict returns DefaultAssetRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *DefaultAssetRelationshipType) GetOnConflict() string { return v.OnConflict }
+
+// GetAllowedTargetKinds returns DefaultAssetRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *DefaultAssetRelationshipType) GetAllowedTargetKinds() []string { return v.AllowedTargetKinds }
+
+// GetAllowedSourceTypes returns DefaultAssetRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *DefaultAssetRelationshipType) GetAllowedSourceTypes() []string { return v.AllowedSourceTypes }
+
+// GetAllowedTargetTypes returns DefaultAssetRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *DefaultAssetRelationshipType) GetAllowedTargetTypes() []string { return v.AllowedTargetTypes }
+
 // Content associated with asset type response.
 type DefaultAssetType struct {
 	Id              string  `json:"id"`
@@ -1577,14 +1677,19 @@ func (v *DefaultCustomerGroupRelationshipTargetsEntityRelationshipTargets) __pre
 
 // Content associated with customer group relationship type.
 type DefaultCustomerGroupRelationshipType struct {
-	Id          string  `json:"id"`
-	CreatedAt   *string `json:"createdAt"`
-	UpdatedAt   *string `json:"updatedAt"`
-	DeletedAt   *string `json:"deletedAt"`
-	Token       string  `json:"token"`
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
-	Metadata    *string `json:"metadata"`
+	Id                 string   `json:"id"`
+	CreatedAt          *string  `json:"createdAt"`
+	UpdatedAt          *string  `json:"updatedAt"`
+	DeletedAt          *string  `json:"deletedAt"`
+	Token              string   `json:"token"`
+	Name               *string  `json:"name"`
+	Description        *string  `json:"description"`
+	Metadata           *string  `json:"metadata"`
+	Cardinality        string   `json:"cardinality"`
+	OnConflict         string   `json:"onConflict"`
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 // GetId returns DefaultCustomerGroupRelationshipType.Id, and is useful for accessing the field via an interface.
@@ -1611,6 +1716,27 @@ func (v *DefaultCustomerGroupRelationshipType) GetDescription() *string { return
 // GetMetadata returns DefaultCustomerGroupRelationshipType.Metadata, and is useful for accessing the field via an interface.
 func (v *DefaultCustomerGroupRelationshipType) GetMetadata() *string { return v.Metadata }
 
+// GetCardinality returns DefaultCustomerGroupRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *DefaultCustomerGroupRelationshipType) GetCardinality() string { return v.Cardinality }
+
+// GetOnConflict returns DefaultCustomerGroupRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *DefaultCustomerGroupRelationshipType) GetOnConflict() string { return v.OnConflict }
+
+// GetAllowedTargetKinds returns DefaultCustomerGroupRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *DefaultCustomerGroupRelationshipType) GetAllowedTargetKinds() []string {
+	return v.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns DefaultCustomerGroupRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *DefaultCustomerGroupRelationshipType) GetAllowedSourceTypes() []string {
+	return v.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns DefaultCustomerGroupRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *DefaultCustomerGroupRelationshipType) GetAllowedTargetTypes() []string {
+	return v.AllowedTargetTypes
+}
+
 // Content associated with customer relationship response.
 type DefaultCustomerRelationship struct {
 	Id               string                                                              `json:"id"`
@@ -1807,14 +1933,20 @@ func (v *DefaultCustomerRelationshipTargetsEntityRelationshipTargets) __premarsh
 
 // Content associated with customer relationship type response.
 type DefaultCustomerRelationshipType struct {
-	Id          string  `json:"id"`
-	CreatedAt   *string `json:"createdAt"`
-	UpdatedAt   *string `json:"updatedAt"`
-	DeletedAt   *string `json:"deletedAt"`
-	Token       string  `json:"token"`
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
-	Metadata    *string `json:"metadata"`
+	Id                 string   `json:"id"`
+	CreatedAt          *string  `json:"createdAt"`
+	UpdatedAt          *string  `json:"updatedAt"`
+	DeletedAt          *string  `json:"deletedAt"`
+	Token              string   `json:"token"`
+	Name               *string  `json:"name"`
+	Description        *string  `json:"description"`
+	Metadata           *string  `json:"metadata"`
+	Tracked            bool     `json:"tracked"`
+	Cardinality        string   `json:"cardinality"`
+	OnConflict         string   `json:"onConflict"`
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 // GetId returns DefaultCustomerRelationshipType.Id, and is useful for accessing the field via an interface.
@@ -1841,6 +1973,30 @@ func (v *DefaultCustomerRelationshipType) GetDescription() *string { return v.De
 // GetMetadata returns DefaultCustomerRelationshipType.Metadata, and is useful for accessing the field via an interface.
 func (v *DefaultCustomerRelationshipType) GetMetadata() *string { return v.Metadata }
 
+// GetTracked returns DefaultCustomerRelationshipType.Tracked, and is useful for accessing the field via an interface.
+func (v *DefaultCustomerRelationshipType) GetTracked() bool { return v.Tracked }
+
+// GetCardinality returns DefaultCustomerRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *DefaultCustomerRelationshipType) GetCardinality() string { return v.Cardinality }
+
+// GetOnConflict returns DefaultCustomerRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *DefaultCustomerRelationshipType) GetOnConflict() string { return v.OnConflict }
+
+// GetAllowedTargetKinds returns DefaultCustomerRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *DefaultCustomerRelationshipType) GetAllowedTargetKinds() []string {
+	return v.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns DefaultCustomerRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *DefaultCustomerRelationshipType) GetAllowedSourceTypes() []string {
+	return v.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns DefaultCustomerRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *DefaultCustomerRelationshipType) GetAllowedTargetTypes() []string {
+	return v.AllowedTargetTypes
+}
+
 // Content associated with customer type response.
 type DefaultCustomerType struct {
 	Id              string  `json:"id"`
@@ -1907,7 +2063,10 @@ type DefaultDevice struct {
 	Name        *string                 `json:"name"`
 	Description *string                 `json:"description"`
 	DeviceType  DefaultDeviceDeviceType `json:"deviceType"`
+	Status      string                  `json:"status"`
 	Metadata    *string                 `json:"metadata"`
+	RateLimit   *float64                `json:"rateLimit"`
+	RateBurst   *int                    `json:"rateBurst"`
 }
 
 // GetId returns DefaultDevice.Id, and is useful for accessing the field via an interface.
@@ -1934,9 +2093,80 @@ func (v *DefaultDevice) GetDescription() *string { return v.Description }
 // GetDeviceType returns DefaultDevice.DeviceType, and is useful for accessing the field via an interface.
 func (v *DefaultDevice) GetDeviceType() DefaultDeviceDeviceType { return v.DeviceType }
 
+// GetStatus returns DefaultDevice.Status, and is useful for accessing the field via an interface.
+func (v *DefaultDevice) GetStatus() string { return v.Status }
+
 // GetMetadata returns DefaultDevice.Metadata, and is useful for accessing the field via an interface.
 func (v *DefaultDevice) GetMetadata() *string { return v.Metadata }
 
+// GetRateLimit returns DefaultDevice.RateLimit, and is useful for accessing the field via an interface.
+func (v *DefaultDevice) GetRateLimit() *float64 { return v.RateLimit }
+
+// GetRateBurst returns DefaultDevice.RateBurst, and is useful for accessing the field via an interface.
+func (v *DefaultDevice) GetRateBurst() *int { return v.RateBurst }
+
+// Content associated with a device credential verification response.
+type DefaultDeviceCredentialVerification struct {
+	Valid      bool                                                           `json:"valid"`
+	Reason     *string                                                        `json:"reason"`
+	Device     *DefaultDeviceCredentialVerificationDevice                     `json:"device"`
+	Credential *DefaultDeviceCredentialVerificationCredentialDeviceCredential `json:"credential"`
+}
+
+// GetValid returns DefaultDeviceCredentialVerification.Valid, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceCredentialVerification) GetValid() bool { return v.Valid }
+
+// GetReason returns DefaultDeviceCredentialVerification.Reason, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceCredentialVerification) GetReason() *string { return v.Reason }
+
+// GetDevice returns DefaultDeviceCredentialVerification.Device, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceCredentialVerification) GetDevice() *DefaultDeviceCredentialVerificationDevice {
+	return v.Device
+}
+
+// GetCredential returns DefaultDeviceCredentialVerification.Credential, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceCredentialVerification) GetCredential() *DefaultDeviceCredentialVerificationCredentialDeviceCredential {
+	return v.Credential
+}
+
+// DefaultDeviceCredentialVerificationCredentialDeviceCredential includes the requested fields of the GraphQL type DeviceCredential.
+type DefaultDeviceCredentialVerificationCredentialDeviceCredential struct {
+	Token          string  `json:"token"`
+	CredentialType string  `json:"credentialType"`
+	ExpiresAt      *string `json:"expiresAt"`
+}
+
+// GetToken returns DefaultDeviceCredentialVerificationCredentialDeviceCredential.Token, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceCredentialVerificationCredentialDeviceCredential) GetToken() string {
+	return v.Token
+}
+
+// GetCredentialType returns DefaultDeviceCredentialVerificationCredentialDeviceCredential.CredentialType, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceCredentialVerificationCredentialDeviceCredential) GetCredentialType() string {
+	return v.CredentialType
+}
+
+// GetExpiresAt returns DefaultDeviceCredentialVerificationCredentialDeviceCredential.ExpiresAt, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceCredentialVerificationCredentialDeviceCredential) GetExpiresAt() *string {
+	return v.ExpiresAt
+}
+
+// DefaultDeviceCredentialVerificationDevice includes the requested fields of the GraphQL type Device.
+type DefaultDeviceCredentialVerificationDevice struct {
+	Token       string  `json:"token"`
+	Name        *string `json:"name"`
+	Description *string `json:"description"`
+}
+
+// GetToken returns DefaultDeviceCredentialVerificationDevice.Token, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceCredentialVerificationDevice) GetToken() string { return v.Token }
+
+// GetName returns DefaultDeviceCredentialVerificationDevice.Name, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceCredentialVerificationDevice) GetName() *string { return v.Name }
+
+// GetDescription returns DefaultDeviceCredentialVerificationDevice.Description, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceCredentialVerificationDevice) GetDescription() *string { return v.Description }
+
 // DefaultDeviceDeviceType includes the requested fields of the GraphQL type DeviceType.
 type DefaultDeviceDeviceType struct {
 	Token       string  `json:"token"`
@@ -2207,14 +2437,19 @@ func (v *DefaultDeviceGroupRelationshipTargetsEntityRelationshipTargets) __prema
 
 // Content associated with a device group relationship type.
 type DefaultDeviceGroupRelationshipType struct {
-	Id          string  `json:"id"`
-	CreatedAt   *string `json:"createdAt"`
-	UpdatedAt   *string `json:"updatedAt"`
-	DeletedAt   *string `json:"deletedAt"`
-	Token       string  `json:"token"`
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
-	Metadata    *string `json:"metadata"`
+	Id                 string   `json:"id"`
+	CreatedAt          *string  `json:"createdAt"`
+	UpdatedAt          *string  `json:"updatedAt"`
+	DeletedAt          *string  `json:"deletedAt"`
+	Token              string   `json:"token"`
+	Name               *string  `json:"name"`
+	Description        *string  `json:"description"`
+	Metadata           *string  `json:"metadata"`
+	Cardinality        string   `json:"cardinality"`
+	OnConflict         string   `json:"onConflict"`
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 // GetId returns DefaultDeviceGroupRelationshipType.Id, and is useful for accessing the field via an interface.
@@ -2241,6 +2476,27 @@ func (v *DefaultDeviceGroupRelationshipType) GetDescription() *string { return v
 // GetMetadata returns DefaultDeviceGroupRelationshipType.Metadata, and is useful for accessing the field via an interface.
 func (v *DefaultDeviceGroupRelationshipType) GetMetadata() *string { return v.Metadata }
 
+// GetCardinality returns DefaultDeviceGroupRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceGroupRelationshipType) GetCardinality() string { return v.Cardinality }
+
+// GetOnConflict returns DefaultDeviceGroupRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceGroupRelationshipType) GetOnConflict() string { return v.OnConflict }
+
+// GetAllowedTargetKinds returns DefaultDeviceGroupRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceGroupRelationshipType) GetAllowedTargetKinds() []string {
+	return v.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns DefaultDeviceGroupRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceGroupRelationshipType) GetAllowedSourceTypes() []string {
+	return v.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns DefaultDeviceGroupRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceGroupRelationshipType) GetAllowedTargetTypes() []string {
+	return v.AllowedTargetTypes
+}
+
 // Content associated with a device relationship response.
 type DefaultDeviceRelationship struct {
 	Id               string                                                          `json:"id"`
@@ -2437,15 +2693,22 @@ func (v *DefaultDeviceRelationshipTargetsEntityRelationshipTargets) __premarshal
 
 // Content associated with a device relationship type response.
 type DefaultDeviceRelationshipType struct {
-	Id          string  `json:"id"`
-	CreatedAt   *string `json:"createdAt"`
-	UpdatedAt   *string `json:"updatedAt"`
-	DeletedAt   *string `json:"deletedAt"`
-	Token       string  `json:"token"`
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
-	Metadata    *string `json:"metadata"`
-	Tracked     bool    `json:"tracked"`
+	Id                   string   `json:"id"`
+	CreatedAt            *string  `json:"createdAt"`
+	UpdatedAt            *string  `json:"updatedAt"`
+	DeletedAt            *string  `json:"deletedAt"`
+	Token                string   `json:"token"`
+	Name                 *string  `json:"name"`
+	Description          *string  `json:"description"`
+	Metadata             *string  `json:"metadata"`
+	Tracked              bool     `json:"tracked"`
+	Enriched             bool     `json:"enriched"`
+	EnrichedMetadataKeys []string `json:"enrichedMetadataKeys"`
+	Cardinality          string   `json:"cardinality"`
+	OnConflict           string   `json:"onConflict"`
+	AllowedTargetKinds   []string `json:"allowedTargetKinds"`
+	AllowedSourceTypes   []string `json:"allowedSourceTypes"`
+	AllowedTargetTypes   []string `json:"allowedTargetTypes"`
 }
 
 // GetId returns DefaultDeviceRelationshipType.Id, and is useful for accessing the field via an interface.
@@ -2475,21 +2738,46 @@ func (v *DefaultDeviceRelationshipType) GetMetadata() *string { return v.Metadat
 // GetTracked returns DefaultDeviceRelationshipType.Tracked, and is useful for accessing the field via an interface.
 func (v *DefaultDeviceRelationshipType) GetTracked() bool { return v.Tracked }
 
+// GetEnriched returns DefaultDeviceRelationshipType.Enriched, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceRelationshipType) GetEnriched() bool { return v.Enriched }
+
+// GetEnrichedMetadataKeys returns DefaultDeviceRelationshipType.EnrichedMetadataKeys, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceRelationshipType) GetEnrichedMetadataKeys() []string {
+	return v.EnrichedMetadataKeys
+}
+
+// GetCardinality returns DefaultDeviceRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceRelationshipType) GetCardinality() string { return v.Cardinality }
+
+// GetOnConflict returns DefaultDeviceRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceRelationshipType) GetOnConflict() string { return v.OnConflict }
+
+// GetAllowedTargetKinds returns DefaultDeviceRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceRelationshipType) GetAllowedTargetKinds() []string { return v.AllowedTargetKinds }
+
+// GetAllowedSourceTypes returns DefaultDeviceRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceRelationshipType) GetAllowedSourceTypes() []string { return v.AllowedSourceTypes }
+
+// GetAllowedTargetTypes returns DefaultDeviceRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceRelationshipType) GetAllowedTargetTypes() []string { return v.AllowedTargetTypes }
+
 // Content associated with a device type response.
 type DefaultDeviceType struct {
-	Id              string  `json:"id"`
-	CreatedAt       *string `json:"createdAt"`
-	UpdatedAt       *string `json:"updatedAt"`
-	DeletedAt       *string `json:"deletedAt"`
-	Token           string  `json:"token"`
-	Name            *string `json:"name"`
-	Description     *string `json:"description"`
-	ImageUrl        *string `json:"imageUrl"`
-	Icon            *string `json:"icon"`
-	BackgroundColor *string `json:"backgroundColor"`
-	ForegroundColor *string `json:"foregroundColor"`
-	BorderColor     *string `json:"borderColor"`
-	Metadata        *string `json:"metadata"`
+	Id              string   `json:"id"`
+	CreatedAt       *string  `json:"createdAt"`
+	UpdatedAt       *string  `json:"updatedAt"`
+	DeletedAt       *string  `json:"deletedAt"`
+	Token           string   `json:"token"`
+	Name            *string  `json:"name"`
+	Description     *string  `json:"description"`
+	ImageUrl        *string  `json:"imageUrl"`
+	Icon            *string  `json:"icon"`
+	BackgroundColor *string  `json:"backgroundColor"`
+	ForegroundColor *string  `json:"foregroundColor"`
+	BorderColor     *string  `json:"borderColor"`
+	Metadata        *string  `json:"metadata"`
+	RateLimit       *float64 `json:"rateLimit"`
+	RateBurst       *int     `json:"rateBurst"`
 }
 
 // GetId returns DefaultDeviceType.Id, and is useful for accessing the field via an interface.
@@ -2531,6 +2819,12 @@ func (v *DefaultDeviceType) GetBorderColor() *string { return v.BorderColor }
 // GetMetadata returns DefaultDeviceType.Metadata, and is useful for accessing the field via an interface.
 func (v *DefaultDeviceType) GetMetadata() *string { return v.Metadata }
 
+// GetRateLimit returns DefaultDeviceType.RateLimit, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceType) GetRateLimit() *float64 { return v.RateLimit }
+
+// GetRateBurst returns DefaultDeviceType.RateBurst, and is useful for accessing the field via an interface.
+func (v *DefaultDeviceType) GetRateBurst() *int { return v.RateBurst }
+
 // Content associated with pagination.
 type DefaultPagination struct {
 	PageStart    *int `json:"pageStart"`
@@ -2770,10 +3064,15 @@ func (v *__createAreaGroupRelationshipInput) GetRelationshipType() string { retu
 
 // __createAreaGroupRelationshipTypeInput is used internally by genqlient
 type __createAreaGroupRelationshipTypeInput struct {
-	Token       string  `json:"token"`
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
-	Metadata    *string `json:"metadata"`
+	Token              string   `json:"token"`
+	Name               *string  `json:"name"`
+	Description        *string  `json:"description"`
+	Metadata           *string  `json:"metadata"`
+	Cardinality        *string  `json:"cardinality"`
+	OnConflict         *string  `json:"onConflict"`
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 // GetToken returns __createAreaGroupRelationshipTypeInput.Token, and is useful for accessing the field via an interface.
@@ -2788,6 +3087,27 @@ func (v *__createAreaGroupRelationshipTypeInput) GetDescription() *string { retu
 // GetMetadata returns __createAreaGroupRelationshipTypeInput.Metadata, and is useful for accessing the field via an interface.
 func (v *__createAreaGroupRelationshipTypeInput) GetMetadata() *string { return v.Metadata }
 
+// GetCardinality returns __createAreaGroupRelationshipTypeInput.Cardinality, and is useful for accessing the field via an interface.
+func (v *__createAreaGroupRelationshipTypeInput) GetCardinality() *string { return v.Cardinality }
+
+// GetOnConflict returns __createAreaGroupRelationshipTypeInput.OnConflict, and is useful for accessing the field via an interface.
+func (v *__createAreaGroupRelationshipTypeInput) GetOnConflict() *string { return v.OnConflict }
+
+// GetAllowedTargetKinds returns __createAreaGroupRelationshipTypeInput.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *__createAreaGroupRelationshipTypeInput) GetAllowedTargetKinds() []string {
+	return v.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns __createAreaGroupRelationshipTypeInput.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *__createAreaGroupRelationshipTypeInput) GetAllowedSourceTypes() []string {
+	return v.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns __createAreaGroupRelationshipTypeInput.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *__createAreaGroupRelationshipTypeInput) GetAllowedTargetTypes() []string {
+	return v.AllowedTargetTypes
+}
+
 // __createAreaInput is used internally by genqlient
 type __createAreaInput struct {
 	Token         string  `json:"token"`
@@ -2836,10 +3156,16 @@ func (v *__createAreaRelationshipInput) GetRelationshipType() string { return v.
 
 // __createAreaRelationshipTypeInput is used internally by genqlient
 type __createAreaRelationshipTypeInput struct {
-	Token       string  `json:"token"`
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
-	Metadata    *string `json:"metadata"`
+	Token              string   `json:"token"`
+	Name               *string  `json:"name"`
+	Description        *string  `json:"description"`
+	Metadata           *string  `json:"metadata"`
+	Tracked            *bool    `json:"tracked"`
+	Cardinality        *string  `json:"cardinality"`
+	OnConflict         *string  `json:"onConflict"`
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 // GetToken returns __createAreaRelationshipTypeInput.Token, and is useful for accessing the field via an interface.
@@ -2854,6 +3180,30 @@ func (v *__createAreaRelationshipTypeInput) GetDescription() *string { return v.
 // GetMetadata returns __createAreaRelationshipTypeInput.Metadata, and is useful for accessing the field via an interface.
 func (v *__createAreaRelationshipTypeInput) GetMetadata() *string { return v.Metadata }
 
+// GetTracked returns __createAreaRelationshipTypeInput.Tracked, and is useful for accessing the field via an interface.
+func (v *__createAreaRelationshipTypeInput) GetTracked() *bool { return v.Tracked }
+
+// GetCardinality returns __createAreaRelationshipTypeInput.Cardinality, and is useful for accessing the field via an interface.
+func (v *__createAreaRelationshipTypeInput) GetCardinality() *string { return v.Cardinality }
+
+// GetOnConflict returns __createAreaRelationshipTypeInput.OnConflict, and is useful for accessing the field via an interface.
+func (v *__createAreaRelationshipTypeInput) GetOnConflict() *string { return v.OnConflict }
+
+// GetAllowedTargetKinds returns __createAreaRelationshipTypeInput.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *__createAreaRelationshipTypeInput) GetAllowedTargetKinds() []string {
+	return v.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns __createAreaRelationshipTypeInput.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *__createAreaRelationshipTypeInput) GetAllowedSourceTypes() []string {
+	return v.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns __createAreaRelationshipTypeInput.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *__createAreaRelationshipTypeInput) GetAllowedTargetTypes() []string {
+	return v.AllowedTargetTypes
+}
+
 // __createAreaTypeInput is used internally by genqlient
 type __createAreaTypeInput struct {
 	Token           string  `json:"token"`
@@ -2958,10 +3308,15 @@ func (v *__createAssetGroupRelationshipInput) GetRelationshipType() string { ret
 
 // __createAssetGroupRelationshipTypeInput is used internally by genqlient
 type __createAssetGroupRelationshipTypeInput struct {
-	Token       string  `json:"token"`
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
-	Metadata    *string `json:"metadata"`
+	Token              string   `json:"token"`
+	Name               *string  `json:"name"`
+	Description        *string  `json:"description"`
+	Metadata           *string  `json:"metadata"`
+	Cardinality        *string  `json:"cardinality"`
+	OnConflict         *string  `json:"onConflict"`
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 // GetToken returns __createAssetGroupRelationshipTypeInput.Token, and is useful for accessing the field via an interface.
@@ -2976,6 +3331,27 @@ func (v *__createAssetGroupRelationshipTypeInput) GetDescription() *string { ret
 // GetMetadata returns __createAssetGroupRelationshipTypeInput.Metadata, and is useful for accessing the field via an interface.
 func (v *__createAssetGroupRelationshipTypeInput) GetMetadata() *string { return v.Metadata }
 
+// GetCardinality returns __createAssetGroupRelationshipTypeInput.Cardinality, and is useful for accessing the field via an interface.
+func (v *__createAssetGroupRelationshipTypeInput) GetCardinality() *string { return v.Cardinality }
+
+// GetOnConflict returns __createAssetGroupRelationshipTypeInput.OnConflict, and is useful for accessing the field via an interface.
+func (v *__createAssetGroupRelationshipTypeInput) GetOnConflict() *string { return v.OnConflict }
+
+// GetAllowedTargetKinds returns __createAssetGroupRelationshipTypeInput.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *__createAssetGroupRelationshipTypeInput) GetAllowedTargetKinds() []string {
+	return v.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns __createAssetGroupRelationshipTypeInput.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *__createAssetGroupRelationshipTypeInput) GetAllowedSourceTypes() []string {
+	return v.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns __createAssetGroupRelationshipTypeInput.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *__createAssetGroupRelationshipTypeInput) GetAllowedTargetTypes() []string {
+	return v.AllowedTargetTypes
+}
+
 // __createAssetInput is used internally by genqlient
 type __createAssetInput struct {
 	Token          string  `json:"token"`
@@ -3024,10 +3400,16 @@ func (v *__createAssetRelationshipInput) GetRelationshipType() string { return v
 
 // __createAssetRelationshipTypeInput is used internally by genqlient
 type __createAssetRelationshipTypeInput struct {
-	Token       string  `json:"token"`
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
-	Metadata    *string `json:"metadata"`
+	Token              string   `json:"token"`
+	Name               *string  `json:"name"`
+	Description        *string  `json:"description"`
+	Metadata           *string  `json:"metadata"`
+	Tracked            *bool    `json:"tracked"`
+	Cardinality        *string  `json:"cardinality"`
+	OnConflict         *string  `json:"onConflict"`
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 // GetToken returns __createAssetRelationshipTypeInput.Token, and is useful for accessing the field via an interface.
@@ -3042,6 +3424,30 @@ func (v *__createAssetRelationshipTypeInput) GetDescription() *string { return v
 // GetMetadata returns __createAssetRelationshipTypeInput.Metadata, and is useful for accessing the field via an interface.
 func (v *__createAssetRelationshipTypeInput) GetMetadata() *string { return v.Metadata }
 
+// GetTracked returns __createAssetRelationshipTypeInput.Tracked, and is useful for accessing the field via an interface.
+func (v *__createAssetRelationshipTypeInput) GetTracked() *bool { return v.Tracked }
+
+// GetCardinality returns __createAssetRelationshipTypeInput.Cardinality, and is useful for accessing the field via an interface.
+func (v *__createAssetRelationshipTypeInput) GetCardinality() *string { return v.Cardinality }
+
+// GetOnConflict returns __createAssetRelationshipTypeInput.OnConflict, and is useful for accessing the field via an interface.
+func (v *__createAssetRelationshipTypeInput) GetOnConflict() *string { return v.OnConflict }
+
+// GetAllowedTargetKinds returns __createAssetRelationshipTypeInput.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *__createAssetRelationshipTypeInput) GetAllowedTargetKinds() []string {
+	return v.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns __createAssetRelationshipTypeInput.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *__createAssetRelationshipTypeInput) GetAllowedSourceTypes() []string {
+	return v.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns __createAssetRelationshipTypeInput.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *__createAssetRelationshipTypeInput) GetAllowedTargetTypes() []string {
+	return v.AllowedTargetTypes
+}
+
 // __createAssetTypeInput is used internally by genqlient
 type __createAssetTypeInput struct {
 	Token           string  `json:"token"`
@@ -3148,10 +3554,15 @@ func (v *__createCustomerGroupRelationshipInput) GetRelationshipType() string {
 
 // __createCustomerGroupRelationshipTypeInput is used internally by genqlient
 type __createCustomerGroupRelationshipTypeInput struct {
-	Token       string  `json:"token"`
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
-	Metadata    *string `json:"metadata"`
+	Token              string   `json:"token"`
+	Name               *string  `json:"name"`
+	Description        *string  `json:"description"`
+	Metadata           *string  `json:"metadata"`
+	Cardinality        *string  `json:"cardinality"`
+	OnConflict         *string  `json:"onConflict"`
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 // GetToken returns __createCustomerGroupRelationshipTypeInput.Token, and is useful for accessing the field via an interface.
@@ -3166,6 +3577,27 @@ func (v *__createCustomerGroupRelationshipTypeInput) GetDescription() *string {
 // GetMetadata returns __createCustomerGroupRelationshipTypeInput.Metadata, and is useful for accessing the field via an interface.
 func (v *__createCustomerGroupRelationshipTypeInput) GetMetadata() *string { return v.Metadata }
 
+// GetCardinality returns __createCustomerGroupRelationshipTypeInput.Cardinality, and is useful for accessing the field via an interface.
+func (v *__createCustomerGroupRelationshipTypeInput) GetCardinality() *string { return v.Cardinality }
+
+// GetOnConflict returns __createCustomerGroupRelationshipTypeInput.OnConflict, and is useful for accessing the field via an interface.
+func (v *__createCustomerGroupRelationshipTypeInput) GetOnConflict() *string { return v.OnConflict }
+
+// GetAllowedTargetKinds returns __createCustomerGroupRelationshipTypeInput.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *__createCustomerGroupRelationshipTypeInput) GetAllowedTargetKinds() []string {
+	return v.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns __createCustomerGroupRelationshipTypeInput.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *__createCustomerGroupRelationshipTypeInput) GetAllowedSourceTypes() []string {
+	return v.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns __createCustomerGroupRelationshipTypeInput.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *__createCustomerGroupRelationshipTypeInput) GetAllowedTargetTypes() []string {
+	return v.AllowedTargetTypes
+}
+
 // __createCustomerInput is used internally by genqlient
 type __createCustomerInput struct {
 	Token             string  `json:"token"`
@@ -3214,10 +3646,16 @@ func (v *__createCustomerRelationshipInput) GetRelationshipType() string { retur
 
 // __createCustomerRelationshipTypeInput is used internally by genqlient
 type __createCustomerRelationshipTypeInput struct {
-	Token       string  `json:"token"`
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
-	Metadata    *string `json:"metadata"`
+	Token              string   `json:"token"`
+	Name               *string  `json:"name"`
+	Description        *string  `json:"description"`
+	Metadata           *string  `json:"metadata"`
+	Tracked            *bool    `json:"tracked"`
+	Cardinality        *string  `json:"cardinality"`
+	OnConflict         *string  `json:"onConflict"`
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 // GetToken returns __createCustomerRelationshipTypeInput.Token, and is useful for accessing the field via an interface.
@@ -3232,6 +3670,30 @@ func (v *__createCustomerRelationshipTypeInput) GetDescription() *string { retur
 // GetMetadata returns __createCustomerRelationshipTypeInput.Metadata, and is useful for accessing the field via an interface.
 func (v *__createCustomerRelationshipTypeInput) GetMetadata() *string { return v.Metadata }
 
+// GetTracked returns __createCustomerRelationshipTypeInput.Tracked, and is useful for accessing the field via an interface.
+func (v *__createCustomerRelationshipTypeInput) GetTracked() *bool { return v.Tracked }
+
+// GetCardinality returns __createCustomerRelationshipTypeInput.Cardinality, and is useful for accessing the field via an interface.
+func (v *__createCustomerRelationshipTypeInput) GetCardinality() *string { return v.Cardinality }
+
+// GetOnConflict returns __createCustomerRelationshipTypeInput.OnConflict, and is useful for accessing the field via an interface.
+func (v *__createCustomerRelationshipTypeInput) GetOnConflict() *string { return v.OnConflict }
+
+// GetAllowedTargetKinds returns __createCustomerRelationshipTypeInput.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *__createCustomerRelationshipTypeInput) GetAllowedTargetKinds() []string {
+	return v.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns __createCustomerRelationshipTypeInput.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *__createCustomerRelationshipTypeInput) GetAllowedSourceTypes() []string {
+	return v.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns __createCustomerRelationshipTypeInput.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *__createCustomerRelationshipTypeInput) GetAllowedTargetTypes() []string {
+	return v.AllowedTargetTypes
+}
+
 // __createCustomerTypeInput is used internally by genqlient
 type __createCustomerTypeInput struct {
 	Token           string  `json:"token"`
@@ -3340,10 +3802,15 @@ func (v *__createDeviceGroupRelationshipInput) GetRelationshipType() string {
 
 // __createDeviceGroupRelationshipTypeInput is used internally by genqlient
 type __createDeviceGroupRelationshipTypeInput struct {
-	Token       string  `json:"token"`
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
-	Metadata    *string `json:"metadata"`
+	Token              string   `json:"token"`
+	Name               *string  `json:"name"`
+	Description        *string  `json:"description"`
+	Metadata           *string  `json:"metadata"`
+	Cardinality        *string  `json:"cardinality"`
+	OnConflict         *string  `json:"onConflict"`
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 // GetToken returns __createDeviceGroupRelationshipTypeInput.Token, and is useful for accessing the field via an interface.
@@ -3358,13 +3825,37 @@ func (v *__createDeviceGroupRelationshipTypeInput) GetDescription() *string { re
 // GetMetadata returns __createDeviceGroupRelationshipTypeInput.Metadata, and is useful for accessing the field via an interface.
 func (v *__createDeviceGroupRelationshipTypeInput) GetMetadata() *string { return v.Metadata }
 
+// GetCardinality returns __createDeviceGroupRelationshipTypeInput.Cardinality, and is useful for accessing the field via an interface.
+func (v *__createDeviceGroupRelationshipTypeInput) GetCardinality() *string { return v.Cardinality }
+
+// GetOnConflict returns __createDeviceGroupRelationshipTypeInput.OnConflict, and is useful for accessing the field via an interface.
+func (v *__createDeviceGroupRelationshipTypeInput) GetOnConflict() *string { return v.OnConflict }
+
+// GetAllowedTargetKinds returns __createDeviceGroupRelationshipTypeInput.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *__createDeviceGroupRelationshipTypeInput) GetAllowedTargetKinds() []string {
+	return v.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns __createDeviceGroupRelationshipTypeInput.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *__createDeviceGroupRelationshipTypeInput) GetAllowedSourceTypes() []string {
+	return v.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns __createDeviceGroupRelationshipTypeInput.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *__createDeviceGroupRelationshipTypeInput) GetAllowedTargetTypes() []string {
+	return v.AllowedTargetTypes
+}
+
 // __createDeviceInput is used internally by genqlient
 type __createDeviceInput struct {
-	Token           string  `json:"token"`
-	DeviceTypeToken string  `json:"deviceTypeToken"`
-	Name            *string `json:"name"`
-	Description     *string `json:"description"`
-	Metadata        *string `json:"metadata"`
+	Token           string   `json:"token"`
+	DeviceTypeToken string   `json:"deviceTypeToken"`
+	Name            *string  `json:"name"`
+	Description     *string  `json:"description"`
+	Status          *string  `json:"status"`
+	Metadata        *string  `json:"metadata"`
+	RateLimit       *float64 `json:"rateLimit"`
+	RateBurst       *int     `json:"rateBurst"`
 }
 
 // GetToken returns __createDeviceInput.Token, and is useful for accessing the field via an interface.
@@ -3379,9 +3870,18 @@ func (v *__createDeviceInput) GetName() *string { return v.Name }
 // GetDescription returns __createDeviceInput.Description, and is useful for accessing the field via an interface.
 func (v *__createDeviceInput) GetDescription() *string { return v.Description }
 
+// GetStatus returns __createDeviceInput.Status, and is useful for accessing the field via an interface.
+func (v *__createDeviceInput) GetStatus() *string { return v.Status }
+
 // GetMetadata returns __createDeviceInput.Metadata, and is useful for accessing the field via an interface.
 func (v *__createDeviceInput) GetMetadata() *string { return v.Metadata }
 
+// GetRateLimit returns __createDeviceInput.RateLimit, and is useful for accessing the field via an interface.
+func (v *__createDeviceInput) GetRateLimit() *float64 { return v.RateLimit }
+
+// GetRateBurst returns __createDeviceInput.RateBurst, and is useful for accessing the field via an interface.
+func (v *__createDeviceInput) GetRateBurst() *int { return v.RateBurst }
+
 // __createDeviceRelationshipInput is used internally by genqlient
 type __createDeviceRelationshipInput struct {
 	Token            string                                 `json:"token"`
@@ -3406,11 +3906,18 @@ func (v *__createDeviceRelationshipInput) GetRelationshipType() string { return
 
 // __createDeviceRelationshipTypeInput is used internally by genqlient
 type __createDeviceRelationshipTypeInput struct {
-	Token       string  `json:"token"`
-	Name        *string `json:"name"`
-	Description *string `json:"description"`
-	Metadata    *string `json:"metadata"`
-	Tracked     bool    `json:"tracked"`
+	Token                string   `json:"token"`
+	Name                 *string  `json:"name"`
+	Description          *string  `json:"description"`
+	Metadata             *string  `json:"metadata"`
+	Tracked              bool     `json:"tracked"`
+	Enriched             *bool    `json:"enriched"`
+	EnrichedMetadataKeys []string `json:"enrichedMetadataKeys"`
+	Cardinality          *string  `json:"cardinality"`
+	OnConflict           *string  `json:"onConflict"`
+	AllowedTargetKinds   []string `json:"allowedTargetKinds"`
+	AllowedSourceTypes   []string `json:"allowedSourceTypes"`
+	AllowedTargetTypes   []string `json:"allowedTargetTypes"`
 }
 
 // GetToken returns __createDeviceRelationshipTypeInput.Token, and is useful for accessing the field via an interface.
@@ -3428,17 +3935,48 @@ func (v *__createDeviceRelationshipTypeInput) GetMetadata() *string { return v.M
 // GetTracked returns __createDeviceRelationshipTypeInput.Tracked, and is useful for accessing the field via an interface.
 func (v *__createDeviceRelationshipTypeInput) GetTracked() bool { return v.Tracked }
 
+// GetEnriched returns __createDeviceRelationshipTypeInput.Enriched, and is useful for accessing the field via an interface.
+func (v *__createDeviceRelationshipTypeInput) GetEnriched() *bool { return v.Enriched }
+
+// GetEnrichedMetadataKeys returns __createDeviceRelationshipTypeInput.EnrichedMetadataKeys, and is useful for accessing the field via an interface.
+func (v *__createDeviceRelationshipTypeInput) GetEnrichedMetadataKeys() []string {
+	return v.EnrichedMetadataKeys
+}
+
+// GetCardinality returns __createDeviceRelationshipTypeInput.Cardinality, and is useful for accessing the field via an interface.
+func (v *__createDeviceRelationshipTypeInput) GetCardinality() *string { return v.Cardinality }
+
+// GetOnConflict returns __createDeviceRelationshipTypeInput.OnConflict, and is useful for accessing the field via an interface.
+func (v *__createDeviceRelationshipTypeInput) GetOnConflict() *string { return v.OnConflict }
+
+// GetAllowedTargetKinds returns __createDeviceRelationshipTypeInput.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *__createDeviceRelationshipTypeInput) GetAllowedTargetKinds() []string {
+	return v.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns __createDeviceRelationshipTypeInput.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *__createDeviceRelationshipTypeInput) GetAllowedSourceTypes() []string {
+	return v.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns __createDeviceRelationshipTypeInput.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *__createDeviceRelationshipTypeInput) GetAllowedTargetTypes() []string {
+	return v.AllowedTargetTypes
+}
+
 // __createDeviceTypeInput is used internally by genqlient
 type __createDeviceTypeInput struct {
-	Token           string  `json:"token"`
-	Name            *string `json:"name"`
-	Description     *string `json:"description"`
-	ImageUrl        *string `json:"imageUrl"`
-	Icon            *string `json:"icon"`
-	BackgroundColor *string `json:"backgroundColor"`
-	ForegroundColor *string `json:"foregroundColor"`
-	BorderColor     *string `json:"borderColor"`
-	Metadata        *string `json:"metadata"`
+	Token           string   `json:"token"`
+	Name            *string  `json:"name"`
+	Description     *string  `json:"description"`
+	ImageUrl        *string  `json:"imageUrl"`
+	Icon            *string  `json:"icon"`
+	BackgroundColor *string  `json:"backgroundColor"`
+	ForegroundColor *string  `json:"foregroundColor"`
+	BorderColor     *string  `json:"borderColor"`
+	Metadata        *string  `json:"metadata"`
+	RateLimit       *float64 `json:"rateLimit"`
+	RateBurst       *int     `json:"rateBurst"`
 }
 
 // GetToken returns __createDeviceTypeInput.Token, and is useful for accessing the field via an interface.
@@ -3468,6 +4006,12 @@ func (v *__createDeviceTypeInput) GetBorderColor() *string { return v.BorderColo
 // GetMetadata returns __createDeviceTypeInput.Metadata, and is useful for accessing the field via an interface.
 func (v *__createDeviceTypeInput) GetMetadata() *string { return v.Metadata }
 
+// GetRateLimit returns __createDeviceTypeInput.RateLimit, and is useful for accessing the field via an interface.
+func (v *__createDeviceTypeInput) GetRateLimit() *float64 { return v.RateLimit }
+
+// GetRateBurst returns __createDeviceTypeInput.RateBurst, and is useful for accessing the field via an interface.
+func (v *__createDeviceTypeInput) GetRateBurst() *int { return v.RateBurst }
+
 // __getAreaGroupRelationshipTypesByTokenInput is used internally by genqlient
 type __getAreaGroupRelationshipTypesByTokenInput struct {
 	Tokens []string `json:"tokens"`
@@ -4104,6 +4648,22 @@ func (v *__listDevicesInput) GetPageNumber() int { return v.PageNumber }
 // GetPageSize returns __listDevicesInput.PageSize, and is useful for accessing the field via an interface.
 func (v *__listDevicesInput) GetPageSize() int { return v.PageSize }
 
+// __verifyDeviceCredentialInput is used internally by genqlient
+type __verifyDeviceCredentialInput struct {
+	Device         *string `json:"device"`
+	CredentialType string  `json:"credentialType"`
+	Value          string  `json:"value"`
+}
+
+// GetDevice returns __verifyDeviceCredentialInput.Device, and is useful for accessing the field via an interface.
+func (v *__verifyDeviceCredentialInput) GetDevice() *string { return v.Device }
+
+// GetCredentialType returns __verifyDeviceCredentialInput.CredentialType, and is useful for accessing the field via an interface.
+func (v *__verifyDeviceCredentialInput) GetCredentialType() string { return v.CredentialType }
+
+// GetValue returns __verifyDeviceCredentialInput.Value, and is useful for accessing the field via an interface.
+func (v *__verifyDeviceCredentialInput) GetValue() string { return v.Value }
+
 // createAreaCreateArea includes the requested fields of the GraphQL type Area.
 type createAreaCreateArea struct {
 	DefaultArea `json:"-"`
@@ -4509,6 +5069,31 @@ func (v *createAreaGroupRelationshipTypeCreateAreaGroupRelationshipType) GetMeta
 	return v.DefaultAreaGroupRelationshipType.Metadata
 }
 
+// GetCardinality returns createAreaGroupRelationshipTypeCreateAreaGroupRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *createAreaGroupRelationshipTypeCreateAreaGroupRelationshipType) GetCardinality() string {
+	return v.DefaultAreaGroupRelationshipType.Cardinality
+}
+
+// GetOnConflict returns createAreaGroupRelationshipTypeCreateAreaGroupRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *createAreaGroupRelationshipTypeCreateAreaGroupRelationshipType) GetOnConflict() string {
+	return v.DefaultAreaGroupRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns createAreaGroupRelationshipTypeCreateAreaGroupRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *createAreaGroupRelationshipTypeCreateAreaGroupRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultAreaGroupRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns createAreaGroupRelationshipTypeCreateAreaGroupRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *createAreaGroupRelationshipTypeCreateAreaGroupRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultAreaGroupRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns createAreaGroupRelationshipTypeCreateAreaGroupRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *createAreaGroupRelationshipTypeCreateAreaGroupRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultAreaGroupRelationshipType.AllowedTargetTypes
+}
+
 func (v *createAreaGroupRelationshipTypeCreateAreaGroupRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -4550,6 +5135,16 @@ type __premarshalcreateAreaGroupRelationshipTypeCreateAreaGroupRelationshipType
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *createAreaGroupRelationshipTypeCreateAreaGroupRelationshipType) MarshalJSON() ([]byte, error) {
@@ -4571,6 +5166,11 @@ func (v *createAreaGroupRelationshipTypeCreateAreaGroupRelationshipType) __prema
 	retval.Name = v.DefaultAreaGroupRelationshipType.Name
 	retval.Description = v.DefaultAreaGroupRelationshipType.Description
 	retval.Metadata = v.DefaultAreaGroupRelationshipType.Metadata
+	retval.Cardinality = v.DefaultAreaGroupRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultAreaGroupRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultAreaGroupRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultAreaGroupRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultAreaGroupRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -4767,6 +5367,36 @@ func (v *createAreaRelationshipTypeCreateAreaRelationshipType) GetMetadata() *st
 	return v.DefaultAreaRelationshipType.Metadata
 }
 
+// GetTracked returns createAreaRelationshipTypeCreateAreaRelationshipType.Tracked, and is useful for accessing the field via an interface.
+func (v *createAreaRelationshipTypeCreateAreaRelationshipType) GetTracked() bool {
+	return v.DefaultAreaRelationshipType.Tracked
+}
+
+// GetCardinality returns createAreaRelationshipTypeCreateAreaRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *createAreaRelationshipTypeCreateAreaRelationshipType) GetCardinality() string {
+	return v.DefaultAreaRelationshipType.Cardinality
+}
+
+// GetOnConflict returns createAreaRelationshipTypeCreateAreaRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *createAreaRelationshipTypeCreateAreaRelationshipType) GetOnConflict() string {
+	return v.DefaultAreaRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns createAreaRelationshipTypeCreateAreaRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *createAreaRelationshipTypeCreateAreaRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultAreaRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns createAreaRelationshipTypeCreateAreaRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *createAreaRelationshipTypeCreateAreaRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultAreaRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns createAreaRelationshipTypeCreateAreaRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *createAreaRelationshipTypeCreateAreaRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultAreaRelationshipType.AllowedTargetTypes
+}
+
 func (v *createAreaRelationshipTypeCreateAreaRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -4807,7 +5437,19 @@ type __premarshalcreateAreaRelationshipTypeCreateAreaRelationshipType struct {
 
 	Description *string `json:"description"`
 
-	Metadata *string `json:"metadata"`
+	Metadata *string `json:"metadata"`
+
+	Tracked bool `json:"tracked"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *createAreaRelationshipTypeCreateAreaRelationshipType) MarshalJSON() ([]byte, error) {
@@ -4829,6 +5471,12 @@ func (v *createAreaRelationshipTypeCreateAreaRelationshipType) __premarshalJSON(
 	retval.Name = v.DefaultAreaRelationshipType.Name
 	retval.Description = v.DefaultAreaRelationshipType.Description
 	retval.Metadata = v.DefaultAreaRelationshipType.Metadata
+	retval.Tracked = v.DefaultAreaRelationshipType.Tracked
+	retval.Cardinality = v.DefaultAreaRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultAreaRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultAreaRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultAreaRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultAreaRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -5401,6 +6049,31 @@ func (v *createAssetGroupRelationshipTypeCreateAssetGroupRelationshipType) GetMe
 	return v.DefaultAssetGroupRelationshipType.Metadata
 }
 
+// GetCardinality returns createAssetGroupRelationshipTypeCreateAssetGroupRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *createAssetGroupRelationshipTypeCreateAssetGroupRelationshipType) GetCardinality() string {
+	return v.DefaultAssetGroupRelationshipType.Cardinality
+}
+
+// GetOnConflict returns createAssetGroupRelationshipTypeCreateAssetGroupRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *createAssetGroupRelationshipTypeCreateAssetGroupRelationshipType) GetOnConflict() string {
+	return v.DefaultAssetGroupRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns createAssetGroupRelationshipTypeCreateAssetGroupRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *createAssetGroupRelationshipTypeCreateAssetGroupRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultAssetGroupRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns createAssetGroupRelationshipTypeCreateAssetGroupRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *createAssetGroupRelationshipTypeCreateAssetGroupRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultAssetGroupRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns createAssetGroupRelationshipTypeCreateAssetGroupRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *createAssetGroupRelationshipTypeCreateAssetGroupRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultAssetGroupRelationshipType.AllowedTargetTypes
+}
+
 func (v *createAssetGroupRelationshipTypeCreateAssetGroupRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -5442,6 +6115,16 @@ type __premarshalcreateAssetGroupRelationshipTypeCreateAssetGroupRelationshipTyp
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *createAssetGroupRelationshipTypeCreateAssetGroupRelationshipType) MarshalJSON() ([]byte, error) {
@@ -5463,6 +6146,11 @@ func (v *createAssetGroupRelationshipTypeCreateAssetGroupRelationshipType) __pre
 	retval.Name = v.DefaultAssetGroupRelationshipType.Name
 	retval.Description = v.DefaultAssetGroupRelationshipType.Description
 	retval.Metadata = v.DefaultAssetGroupRelationshipType.Metadata
+	retval.Cardinality = v.DefaultAssetGroupRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultAssetGroupRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultAssetGroupRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultAssetGroupRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultAssetGroupRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -5659,6 +6347,36 @@ func (v *createAssetRelationshipTypeCreateAssetRelationshipType) GetMetadata() *
 	return v.DefaultAssetRelationshipType.Metadata
 }
 
+// GetTracked returns createAssetRelationshipTypeCreateAssetRelationshipType.Tracked, and is useful for accessing the field via an interface.
+func (v *createAssetRelationshipTypeCreateAssetRelationshipType) GetTracked() bool {
+	return v.DefaultAssetRelationshipType.Tracked
+}
+
+// GetCardinality returns createAssetRelationshipTypeCreateAssetRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *createAssetRelationshipTypeCreateAssetRelationshipType) GetCardinality() string {
+	return v.DefaultAssetRelationshipType.Cardinality
+}
+
+// GetOnConflict returns createAssetRelationshipTypeCreateAssetRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *createAssetRelationshipTypeCreateAssetRelationshipType) GetOnConflict() string {
+	return v.DefaultAssetRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns createAssetRelationshipTypeCreateAssetRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *createAssetRelationshipTypeCreateAssetRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultAssetRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns createAssetRelationshipTypeCreateAssetRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *createAssetRelationshipTypeCreateAssetRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultAssetRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns createAssetRelationshipTypeCreateAssetRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *createAssetRelationshipTypeCreateAssetRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultAssetRelationshipType.AllowedTargetTypes
+}
+
 func (v *createAssetRelationshipTypeCreateAssetRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -5700,6 +6418,18 @@ type __premarshalcreateAssetRelationshipTypeCreateAssetRelationshipType struct {
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Tracked bool `json:"tracked"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *createAssetRelationshipTypeCreateAssetRelationshipType) MarshalJSON() ([]byte, error) {
@@ -5721,6 +6451,12 @@ func (v *createAssetRelationshipTypeCreateAssetRelationshipType) __premarshalJSO
 	retval.Name = v.DefaultAssetRelationshipType.Name
 	retval.Description = v.DefaultAssetRelationshipType.Description
 	retval.Metadata = v.DefaultAssetRelationshipType.Metadata
+	retval.Tracked = v.DefaultAssetRelationshipType.Tracked
+	retval.Cardinality = v.DefaultAssetRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultAssetRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultAssetRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultAssetRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultAssetRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -6307,6 +7043,31 @@ func (v *createCustomerGroupRelationshipTypeCreateCustomerGroupRelationshipType)
 	return v.DefaultCustomerGroupRelationshipType.Metadata
 }
 
+// GetCardinality returns createCustomerGroupRelationshipTypeCreateCustomerGroupRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *createCustomerGroupRelationshipTypeCreateCustomerGroupRelationshipType) GetCardinality() string {
+	return v.DefaultCustomerGroupRelationshipType.Cardinality
+}
+
+// GetOnConflict returns createCustomerGroupRelationshipTypeCreateCustomerGroupRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *createCustomerGroupRelationshipTypeCreateCustomerGroupRelationshipType) GetOnConflict() string {
+	return v.DefaultCustomerGroupRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns createCustomerGroupRelationshipTypeCreateCustomerGroupRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *createCustomerGroupRelationshipTypeCreateCustomerGroupRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultCustomerGroupRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns createCustomerGroupRelationshipTypeCreateCustomerGroupRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *createCustomerGroupRelationshipTypeCreateCustomerGroupRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultCustomerGroupRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns createCustomerGroupRelationshipTypeCreateCustomerGroupRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *createCustomerGroupRelationshipTypeCreateCustomerGroupRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultCustomerGroupRelationshipType.AllowedTargetTypes
+}
+
 func (v *createCustomerGroupRelationshipTypeCreateCustomerGroupRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -6348,6 +7109,16 @@ type __premarshalcreateCustomerGroupRelationshipTypeCreateCustomerGroupRelations
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *createCustomerGroupRelationshipTypeCreateCustomerGroupRelationshipType) MarshalJSON() ([]byte, error) {
@@ -6369,6 +7140,11 @@ func (v *createCustomerGroupRelationshipTypeCreateCustomerGroupRelationshipType)
 	retval.Name = v.DefaultCustomerGroupRelationshipType.Name
 	retval.Description = v.DefaultCustomerGroupRelationshipType.Description
 	retval.Metadata = v.DefaultCustomerGroupRelationshipType.Metadata
+	retval.Cardinality = v.DefaultCustomerGroupRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultCustomerGroupRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultCustomerGroupRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultCustomerGroupRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultCustomerGroupRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -6565,6 +7341,36 @@ func (v *createCustomerRelationshipTypeCreateCustomerRelationshipType) GetMetada
 	return v.DefaultCustomerRelationshipType.Metadata
 }
 
+// GetTracked returns createCustomerRelationshipTypeCreateCustomerRelationshipType.Tracked, and is useful for accessing the field via an interface.
+func (v *createCustomerRelationshipTypeCreateCustomerRelationshipType) GetTracked() bool {
+	return v.DefaultCustomerRelationshipType.Tracked
+}
+
+// GetCardinality returns createCustomerRelationshipTypeCreateCustomerRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *createCustomerRelationshipTypeCreateCustomerRelationshipType) GetCardinality() string {
+	return v.DefaultCustomerRelationshipType.Cardinality
+}
+
+// GetOnConflict returns createCustomerRelationshipTypeCreateCustomerRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *createCustomerRelationshipTypeCreateCustomerRelationshipType) GetOnConflict() string {
+	return v.DefaultCustomerRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns createCustomerRelationshipTypeCreateCustomerRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *createCustomerRelationshipTypeCreateCustomerRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultCustomerRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns createCustomerRelationshipTypeCreateCustomerRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *createCustomerRelationshipTypeCreateCustomerRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultCustomerRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns createCustomerRelationshipTypeCreateCustomerRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *createCustomerRelationshipTypeCreateCustomerRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultCustomerRelationshipType.AllowedTargetTypes
+}
+
 func (v *createCustomerRelationshipTypeCreateCustomerRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -6606,6 +7412,18 @@ type __premarshalcreateCustomerRelationshipTypeCreateCustomerRelationshipType st
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Tracked bool `json:"tracked"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *createCustomerRelationshipTypeCreateCustomerRelationshipType) MarshalJSON() ([]byte, error) {
@@ -6627,6 +7445,12 @@ func (v *createCustomerRelationshipTypeCreateCustomerRelationshipType) __premars
 	retval.Name = v.DefaultCustomerRelationshipType.Name
 	retval.Description = v.DefaultCustomerRelationshipType.Description
 	retval.Metadata = v.DefaultCustomerRelationshipType.Metadata
+	retval.Tracked = v.DefaultCustomerRelationshipType.Tracked
+	retval.Cardinality = v.DefaultCustomerRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultCustomerRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultCustomerRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultCustomerRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultCustomerRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -6833,9 +7657,18 @@ func (v *createDeviceCreateDevice) GetDeviceType() DefaultDeviceDeviceType {
 	return v.DefaultDevice.DeviceType
 }
 
+// GetStatus returns createDeviceCreateDevice.Status, and is useful for accessing the field via an interface.
+func (v *createDeviceCreateDevice) GetStatus() string { return v.DefaultDevice.Status }
+
 // GetMetadata returns createDeviceCreateDevice.Metadata, and is useful for accessing the field via an interface.
 func (v *createDeviceCreateDevice) GetMetadata() *string { return v.DefaultDevice.Metadata }
 
+// GetRateLimit returns createDeviceCreateDevice.RateLimit, and is useful for accessing the field via an interface.
+func (v *createDeviceCreateDevice) GetRateLimit() *float64 { return v.DefaultDevice.RateLimit }
+
+// GetRateBurst returns createDeviceCreateDevice.RateBurst, and is useful for accessing the field via an interface.
+func (v *createDeviceCreateDevice) GetRateBurst() *int { return v.DefaultDevice.RateBurst }
+
 func (v *createDeviceCreateDevice) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -6878,7 +7711,13 @@ type __premarshalcreateDeviceCreateDevice struct {
 
 	DeviceType DefaultDeviceDeviceType `json:"deviceType"`
 
+	Status string `json:"status"`
+
 	Metadata *string `json:"metadata"`
+
+	RateLimit *float64 `json:"rateLimit"`
+
+	RateBurst *int `json:"rateBurst"`
 }
 
 func (v *createDeviceCreateDevice) MarshalJSON() ([]byte, error) {
@@ -6900,7 +7739,10 @@ func (v *createDeviceCreateDevice) __premarshalJSON() (*__premarshalcreateDevice
 	retval.Name = v.DefaultDevice.Name
 	retval.Description = v.DefaultDevice.Description
 	retval.DeviceType = v.DefaultDevice.DeviceType
+	retval.Status = v.DefaultDevice.Status
 	retval.Metadata = v.DefaultDevice.Metadata
+	retval.RateLimit = v.DefaultDevice.RateLimit
+	retval.RateBurst = v.DefaultDevice.RateBurst
 	return &retval, nil
 }
 
@@ -7219,6 +8061,31 @@ func (v *createDeviceGroupRelationshipTypeCreateDeviceGroupRelationshipType) Get
 	return v.DefaultDeviceGroupRelationshipType.Metadata
 }
 
+// GetCardinality returns createDeviceGroupRelationshipTypeCreateDeviceGroupRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *createDeviceGroupRelationshipTypeCreateDeviceGroupRelationshipType) GetCardinality() string {
+	return v.DefaultDeviceGroupRelationshipType.Cardinality
+}
+
+// GetOnConflict returns createDeviceGroupRelationshipTypeCreateDeviceGroupRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *createDeviceGroupRelationshipTypeCreateDeviceGroupRelationshipType) GetOnConflict() string {
+	return v.DefaultDeviceGroupRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns createDeviceGroupRelationshipTypeCreateDeviceGroupRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *createDeviceGroupRelationshipTypeCreateDeviceGroupRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultDeviceGroupRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns createDeviceGroupRelationshipTypeCreateDeviceGroupRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *createDeviceGroupRelationshipTypeCreateDeviceGroupRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultDeviceGroupRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns createDeviceGroupRelationshipTypeCreateDeviceGroupRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *createDeviceGroupRelationshipTypeCreateDeviceGroupRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultDeviceGroupRelationshipType.AllowedTargetTypes
+}
+
 func (v *createDeviceGroupRelationshipTypeCreateDeviceGroupRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -7260,6 +8127,16 @@ type __premarshalcreateDeviceGroupRelationshipTypeCreateDeviceGroupRelationshipT
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *createDeviceGroupRelationshipTypeCreateDeviceGroupRelationshipType) MarshalJSON() ([]byte, error) {
@@ -7281,6 +8158,11 @@ func (v *createDeviceGroupRelationshipTypeCreateDeviceGroupRelationshipType) __p
 	retval.Name = v.DefaultDeviceGroupRelationshipType.Name
 	retval.Description = v.DefaultDeviceGroupRelationshipType.Description
 	retval.Metadata = v.DefaultDeviceGroupRelationshipType.Metadata
+	retval.Cardinality = v.DefaultDeviceGroupRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultDeviceGroupRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultDeviceGroupRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultDeviceGroupRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultDeviceGroupRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -7482,6 +8364,41 @@ func (v *createDeviceRelationshipTypeCreateDeviceRelationshipType) GetTracked()
 	return v.DefaultDeviceRelationshipType.Tracked
 }
 
+// GetEnriched returns createDeviceRelationshipTypeCreateDeviceRelationshipType.Enriched, and is useful for accessing the field via an interface.
+func (v *createDeviceRelationshipTypeCreateDeviceRelationshipType) GetEnriched() bool {
+	return v.DefaultDeviceRelationshipType.Enriched
+}
+
+// GetEnrichedMetadataKeys returns createDeviceRelationshipTypeCreateDeviceRelationshipType.EnrichedMetadataKeys, and is useful for accessing the field via an interface.
+func (v *createDeviceRelationshipTypeCreateDeviceRelationshipType) GetEnrichedMetadataKeys() []string {
+	return v.DefaultDeviceRelationshipType.EnrichedMetadataKeys
+}
+
+// GetCardinality returns createDeviceRelationshipTypeCreateDeviceRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *createDeviceRelationshipTypeCreateDeviceRelationshipType) GetCardinality() string {
+	return v.DefaultDeviceRelationshipType.Cardinality
+}
+
+// GetOnConflict returns createDeviceRelationshipTypeCreateDeviceRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *createDeviceRelationshipTypeCreateDeviceRelationshipType) GetOnConflict() string {
+	return v.DefaultDeviceRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns createDeviceRelationshipTypeCreateDeviceRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *createDeviceRelationshipTypeCreateDeviceRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultDeviceRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns createDeviceRelationshipTypeCreateDeviceRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *createDeviceRelationshipTypeCreateDeviceRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultDeviceRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns createDeviceRelationshipTypeCreateDeviceRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *createDeviceRelationshipTypeCreateDeviceRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultDeviceRelationshipType.AllowedTargetTypes
+}
+
 func (v *createDeviceRelationshipTypeCreateDeviceRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -7525,6 +8442,20 @@ type __premarshalcreateDeviceRelationshipTypeCreateDeviceRelationshipType struct
 	Metadata *string `json:"metadata"`
 
 	Tracked bool `json:"tracked"`
+
+	Enriched bool `json:"enriched"`
+
+	EnrichedMetadataKeys []string `json:"enrichedMetadataKeys"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *createDeviceRelationshipTypeCreateDeviceRelationshipType) MarshalJSON() ([]byte, error) {
@@ -7547,6 +8478,13 @@ func (v *createDeviceRelationshipTypeCreateDeviceRelationshipType) __premarshalJ
 	retval.Description = v.DefaultDeviceRelationshipType.Description
 	retval.Metadata = v.DefaultDeviceRelationshipType.Metadata
 	retval.Tracked = v.DefaultDeviceRelationshipType.Tracked
+	retval.Enriched = v.DefaultDeviceRelationshipType.Enriched
+	retval.EnrichedMetadataKeys = v.DefaultDeviceRelationshipType.EnrichedMetadataKeys
+	retval.Cardinality = v.DefaultDeviceRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultDeviceRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultDeviceRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultDeviceRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultDeviceRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -7626,6 +8564,14 @@ func (v *createDeviceTypeCreateDeviceType) GetBorderColor() *string {
 // GetMetadata returns createDeviceTypeCreateDeviceType.Metadata, and is useful for accessing the field via an interface.
 func (v *createDeviceTypeCreateDeviceType) GetMetadata() *string { return v.DefaultDeviceType.Metadata }
 
+// GetRateLimit returns createDeviceTypeCreateDeviceType.RateLimit, and is useful for accessing the field via an interface.
+func (v *createDeviceTypeCreateDeviceType) GetRateLimit() *float64 {
+	return v.DefaultDeviceType.RateLimit
+}
+
+// GetRateBurst returns createDeviceTypeCreateDeviceType.RateBurst, and is useful for accessing the field via an interface.
+func (v *createDeviceTypeCreateDeviceType) GetRateBurst() *int { return v.DefaultDeviceType.RateBurst }
+
 func (v *createDeviceTypeCreateDeviceType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -7677,6 +8623,10 @@ type __premarshalcreateDeviceTypeCreateDeviceType struct {
 	BorderColor *string `json:"borderColor"`
 
 	Metadata *string `json:"metadata"`
+
+	RateLimit *float64 `json:"rateLimit"`
+
+	RateBurst *int `json:"rateBurst"`
 }
 
 func (v *createDeviceTypeCreateDeviceType) MarshalJSON() ([]byte, error) {
@@ -7703,6 +8653,8 @@ func (v *createDeviceTypeCreateDeviceType) __premarshalJSON() (*__premarshalcrea
 	retval.ForegroundColor = v.DefaultDeviceType.ForegroundColor
 	retval.BorderColor = v.DefaultDeviceType.BorderColor
 	retval.Metadata = v.DefaultDeviceType.Metadata
+	retval.RateLimit = v.DefaultDeviceType.RateLimit
+	retval.RateBurst = v.DefaultDeviceType.RateBurst
 	return &retval, nil
 }
 
@@ -7761,6 +8713,31 @@ func (v *getAreaGroupRelationshipTypesByTokenAreaGroupRelationshipTypesByTokenAr
 	return v.DefaultAreaGroupRelationshipType.Metadata
 }
 
+// GetCardinality returns getAreaGroupRelationshipTypesByTokenAreaGroupRelationshipTypesByTokenAreaGroupRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *getAreaGroupRelationshipTypesByTokenAreaGroupRelationshipTypesByTokenAreaGroupRelationshipType) GetCardinality() string {
+	return v.DefaultAreaGroupRelationshipType.Cardinality
+}
+
+// GetOnConflict returns getAreaGroupRelationshipTypesByTokenAreaGroupRelationshipTypesByTokenAreaGroupRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *getAreaGroupRelationshipTypesByTokenAreaGroupRelationshipTypesByTokenAreaGroupRelationshipType) GetOnConflict() string {
+	return v.DefaultAreaGroupRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns getAreaGroupRelationshipTypesByTokenAreaGroupRelationshipTypesByTokenAreaGroupRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *getAreaGroupRelationshipTypesByTokenAreaGroupRelationshipTypesByTokenAreaGroupRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultAreaGroupRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns getAreaGroupRelationshipTypesByTokenAreaGroupRelationshipTypesByTokenAreaGroupRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *getAreaGroupRelationshipTypesByTokenAreaGroupRelationshipTypesByTokenAreaGroupRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultAreaGroupRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns getAreaGroupRelationshipTypesByTokenAreaGroupRelationshipTypesByTokenAreaGroupRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *getAreaGroupRelationshipTypesByTokenAreaGroupRelationshipTypesByTokenAreaGroupRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultAreaGroupRelationshipType.AllowedTargetTypes
+}
+
 func (v *getAreaGroupRelationshipTypesByTokenAreaGroupRelationshipTypesByTokenAreaGroupRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -7802,6 +8779,16 @@ type __premarshalgetAreaGroupRelationshipTypesByTokenAreaGroupRelationshipTypesB
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *getAreaGroupRelationshipTypesByTokenAreaGroupRelationshipTypesByTokenAreaGroupRelationshipType) MarshalJSON() ([]byte, error) {
@@ -7823,6 +8810,11 @@ func (v *getAreaGroupRelationshipTypesByTokenAreaGroupRelationshipTypesByTokenAr
 	retval.Name = v.DefaultAreaGroupRelationshipType.Name
 	retval.Description = v.DefaultAreaGroupRelationshipType.Description
 	retval.Metadata = v.DefaultAreaGroupRelationshipType.Metadata
+	retval.Cardinality = v.DefaultAreaGroupRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultAreaGroupRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultAreaGroupRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultAreaGroupRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultAreaGroupRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -8167,6 +9159,36 @@ func (v *getAreaRelationshipTypesByTokenAreaRelationshipTypesByTokenAreaRelation
 	return v.DefaultAreaRelationshipType.Metadata
 }
 
+// GetTracked returns getAreaRelationshipTypesByTokenAreaRelationshipTypesByTokenAreaRelationshipType.Tracked, and is useful for accessing the field via an interface.
+func (v *getAreaRelationshipTypesByTokenAreaRelationshipTypesByTokenAreaRelationshipType) GetTracked() bool {
+	return v.DefaultAreaRelationshipType.Tracked
+}
+
+// GetCardinality returns getAreaRelationshipTypesByTokenAreaRelationshipTypesByTokenAreaRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *getAreaRelationshipTypesByTokenAreaRelationshipTypesByTokenAreaRelationshipType) GetCardinality() string {
+	return v.DefaultAreaRelationshipType.Cardinality
+}
+
+// GetOnConflict returns getAreaRelationshipTypesByTokenAreaRelationshipTypesByTokenAreaRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *getAreaRelationshipTypesByTokenAreaRelationshipTypesByTokenAreaRelationshipType) GetOnConflict() string {
+	return v.DefaultAreaRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns getAreaRelationshipTypesByTokenAreaRelationshipTypesByTokenAreaRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *getAreaRelationshipTypesByTokenAreaRelationshipTypesByTokenAreaRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultAreaRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns getAreaRelationshipTypesByTokenAreaRelationshipTypesByTokenAreaRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *getAreaRelationshipTypesByTokenAreaRelationshipTypesByTokenAreaRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultAreaRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns getAreaRelationshipTypesByTokenAreaRelationshipTypesByTokenAreaRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *getAreaRelationshipTypesByTokenAreaRelationshipTypesByTokenAreaRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultAreaRelationshipType.AllowedTargetTypes
+}
+
 func (v *getAreaRelationshipTypesByTokenAreaRelationshipTypesByTokenAreaRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -8208,6 +9230,18 @@ type __premarshalgetAreaRelationshipTypesByTokenAreaRelationshipTypesByTokenArea
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Tracked bool `json:"tracked"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *getAreaRelationshipTypesByTokenAreaRelationshipTypesByTokenAreaRelationshipType) MarshalJSON() ([]byte, error) {
@@ -8229,6 +9263,12 @@ func (v *getAreaRelationshipTypesByTokenAreaRelationshipTypesByTokenAreaRelation
 	retval.Name = v.DefaultAreaRelationshipType.Name
 	retval.Description = v.DefaultAreaRelationshipType.Description
 	retval.Metadata = v.DefaultAreaRelationshipType.Metadata
+	retval.Tracked = v.DefaultAreaRelationshipType.Tracked
+	retval.Cardinality = v.DefaultAreaRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultAreaRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultAreaRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultAreaRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultAreaRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -8685,6 +9725,31 @@ func (v *getAssetGroupRelationshipTypesByTokenAssetGroupRelationshipTypesByToken
 	return v.DefaultAssetGroupRelationshipType.Metadata
 }
 
+// GetCardinality returns getAssetGroupRelationshipTypesByTokenAssetGroupRelationshipTypesByTokenAssetGroupRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *getAssetGroupRelationshipTypesByTokenAssetGroupRelationshipTypesByTokenAssetGroupRelationshipType) GetCardinality() string {
+	return v.DefaultAssetGroupRelationshipType.Cardinality
+}
+
+// GetOnConflict returns getAssetGroupRelationshipTypesByTokenAssetGroupRelationshipTypesByTokenAssetGroupRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *getAssetGroupRelationshipTypesByTokenAssetGroupRelationshipTypesByTokenAssetGroupRelationshipType) GetOnConflict() string {
+	return v.DefaultAssetGroupRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns getAssetGroupRelationshipTypesByTokenAssetGroupRelationshipTypesByTokenAssetGroupRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *getAssetGroupRelationshipTypesByTokenAssetGroupRelationshipTypesByTokenAssetGroupRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultAssetGroupRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns getAssetGroupRelationshipTypesByTokenAssetGroupRelationshipTypesByTokenAssetGroupRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *getAssetGroupRelationshipTypesByTokenAssetGroupRelationshipTypesByTokenAssetGroupRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultAssetGroupRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns getAssetGroupRelationshipTypesByTokenAssetGroupRelationshipTypesByTokenAssetGroupRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *getAssetGroupRelationshipTypesByTokenAssetGroupRelationshipTypesByTokenAssetGroupRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultAssetGroupRelationshipType.AllowedTargetTypes
+}
+
 func (v *getAssetGroupRelationshipTypesByTokenAssetGroupRelationshipTypesByTokenAssetGroupRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -8726,6 +9791,16 @@ type __premarshalgetAssetGroupRelationshipTypesByTokenAssetGroupRelationshipType
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *getAssetGroupRelationshipTypesByTokenAssetGroupRelationshipTypesByTokenAssetGroupRelationshipType) MarshalJSON() ([]byte, error) {
@@ -8747,6 +9822,11 @@ func (v *getAssetGroupRelationshipTypesByTokenAssetGroupRelationshipTypesByToken
 	retval.Name = v.DefaultAssetGroupRelationshipType.Name
 	retval.Description = v.DefaultAssetGroupRelationshipType.Description
 	retval.Metadata = v.DefaultAssetGroupRelationshipType.Metadata
+	retval.Cardinality = v.DefaultAssetGroupRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultAssetGroupRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultAssetGroupRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultAssetGroupRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultAssetGroupRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -9093,6 +10173,36 @@ func (v *getAssetRelationshipTypesByTokenAssetRelationshipTypesByTokenAssetRelat
 	return v.DefaultAssetRelationshipType.Metadata
 }
 
+// GetTracked returns getAssetRelationshipTypesByTokenAssetRelationshipTypesByTokenAssetRelationshipType.Tracked, and is useful for accessing the field via an interface.
+func (v *getAssetRelationshipTypesByTokenAssetRelationshipTypesByTokenAssetRelationshipType) GetTracked() bool {
+	return v.DefaultAssetRelationshipType.Tracked
+}
+
+// GetCardinality returns getAssetRelationshipTypesByTokenAssetRelationshipTypesByTokenAssetRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *getAssetRelationshipTypesByTokenAssetRelationshipTypesByTokenAssetRelationshipType) GetCardinality() string {
+	return v.DefaultAssetRelationshipType.Cardinality
+}
+
+// GetOnConflict returns getAssetRelationshipTypesByTokenAssetRelationshipTypesByTokenAssetRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *getAssetRelationshipTypesByTokenAssetRelationshipTypesByTokenAssetRelationshipType) GetOnConflict() string {
+	return v.DefaultAssetRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns getAssetRelationshipTypesByTokenAssetRelationshipTypesByTokenAssetRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *getAssetRelationshipTypesByTokenAssetRelationshipTypesByTokenAssetRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultAssetRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns getAssetRelationshipTypesByTokenAssetRelationshipTypesByTokenAssetRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *getAssetRelationshipTypesByTokenAssetRelationshipTypesByTokenAssetRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultAssetRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns getAssetRelationshipTypesByTokenAssetRelationshipTypesByTokenAssetRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *getAssetRelationshipTypesByTokenAssetRelationshipTypesByTokenAssetRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultAssetRelationshipType.AllowedTargetTypes
+}
+
 func (v *getAssetRelationshipTypesByTokenAssetRelationshipTypesByTokenAssetRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -9134,6 +10244,18 @@ type __premarshalgetAssetRelationshipTypesByTokenAssetRelationshipTypesByTokenAs
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Tracked bool `json:"tracked"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *getAssetRelationshipTypesByTokenAssetRelationshipTypesByTokenAssetRelationshipType) MarshalJSON() ([]byte, error) {
@@ -9155,6 +10277,12 @@ func (v *getAssetRelationshipTypesByTokenAssetRelationshipTypesByTokenAssetRelat
 	retval.Name = v.DefaultAssetRelationshipType.Name
 	retval.Description = v.DefaultAssetRelationshipType.Description
 	retval.Metadata = v.DefaultAssetRelationshipType.Metadata
+	retval.Tracked = v.DefaultAssetRelationshipType.Tracked
+	retval.Cardinality = v.DefaultAssetRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultAssetRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultAssetRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultAssetRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultAssetRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -9613,6 +10741,31 @@ func (v *getCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipTypesB
 	return v.DefaultCustomerGroupRelationshipType.Metadata
 }
 
+// GetCardinality returns getCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *getCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipType) GetCardinality() string {
+	return v.DefaultCustomerGroupRelationshipType.Cardinality
+}
+
+// GetOnConflict returns getCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *getCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipType) GetOnConflict() string {
+	return v.DefaultCustomerGroupRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns getCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *getCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultCustomerGroupRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns getCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *getCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultCustomerGroupRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns getCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *getCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultCustomerGroupRelationshipType.AllowedTargetTypes
+}
+
 func (v *getCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -9654,6 +10807,16 @@ type __premarshalgetCustomerGroupRelationshipTypesByTokenCustomerGroupRelationsh
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *getCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipType) MarshalJSON() ([]byte, error) {
@@ -9675,6 +10838,11 @@ func (v *getCustomerGroupRelationshipTypesByTokenCustomerGroupRelationshipTypesB
 	retval.Name = v.DefaultCustomerGroupRelationshipType.Name
 	retval.Description = v.DefaultCustomerGroupRelationshipType.Description
 	retval.Metadata = v.DefaultCustomerGroupRelationshipType.Metadata
+	retval.Cardinality = v.DefaultCustomerGroupRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultCustomerGroupRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultCustomerGroupRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultCustomerGroupRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultCustomerGroupRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -10021,6 +11189,36 @@ func (v *getCustomerRelationshipTypesByTokenCustomerRelationshipTypesByTokenCust
 	return v.DefaultCustomerRelationshipType.Metadata
 }
 
+// GetTracked returns getCustomerRelationshipTypesByTokenCustomerRelationshipTypesByTokenCustomerRelationshipType.Tracked, and is useful for accessing the field via an interface.
+func (v *getCustomerRelationshipTypesByTokenCustomerRelationshipTypesByTokenCustomerRelationshipType) GetTracked() bool {
+	return v.DefaultCustomerRelationshipType.Tracked
+}
+
+// GetCardinality returns getCustomerRelationshipTypesByTokenCustomerRelationshipTypesByTokenCustomerRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *getCustomerRelationshipTypesByTokenCustomerRelationshipTypesByTokenCustomerRelationshipType) GetCardinality() string {
+	return v.DefaultCustomerRelationshipType.Cardinality
+}
+
+// GetOnConflict returns getCustomerRelationshipTypesByTokenCustomerRelationshipTypesByTokenCustomerRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *getCustomerRelationshipTypesByTokenCustomerRelationshipTypesByTokenCustomerRelationshipType) GetOnConflict() string {
+	return v.DefaultCustomerRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns getCustomerRelationshipTypesByTokenCustomerRelationshipTypesByTokenCustomerRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *getCustomerRelationshipTypesByTokenCustomerRelationshipTypesByTokenCustomerRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultCustomerRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns getCustomerRelationshipTypesByTokenCustomerRelationshipTypesByTokenCustomerRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *getCustomerRelationshipTypesByTokenCustomerRelationshipTypesByTokenCustomerRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultCustomerRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns getCustomerRelationshipTypesByTokenCustomerRelationshipTypesByTokenCustomerRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *getCustomerRelationshipTypesByTokenCustomerRelationshipTypesByTokenCustomerRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultCustomerRelationshipType.AllowedTargetTypes
+}
+
 func (v *getCustomerRelationshipTypesByTokenCustomerRelationshipTypesByTokenCustomerRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -10062,6 +11260,18 @@ type __premarshalgetCustomerRelationshipTypesByTokenCustomerRelationshipTypesByT
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Tracked bool `json:"tracked"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *getCustomerRelationshipTypesByTokenCustomerRelationshipTypesByTokenCustomerRelationshipType) MarshalJSON() ([]byte, error) {
@@ -10083,6 +11293,12 @@ func (v *getCustomerRelationshipTypesByTokenCustomerRelationshipTypesByTokenCust
 	retval.Name = v.DefaultCustomerRelationshipType.Name
 	retval.Description = v.DefaultCustomerRelationshipType.Description
 	retval.Metadata = v.DefaultCustomerRelationshipType.Metadata
+	retval.Tracked = v.DefaultCustomerRelationshipType.Tracked
+	retval.Cardinality = v.DefaultCustomerRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultCustomerRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultCustomerRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultCustomerRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultCustomerRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -10555,6 +11771,31 @@ func (v *getDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipTypesByTok
 	return v.DefaultDeviceGroupRelationshipType.Metadata
 }
 
+// GetCardinality returns getDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *getDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipType) GetCardinality() string {
+	return v.DefaultDeviceGroupRelationshipType.Cardinality
+}
+
+// GetOnConflict returns getDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *getDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipType) GetOnConflict() string {
+	return v.DefaultDeviceGroupRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns getDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *getDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultDeviceGroupRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns getDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *getDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultDeviceGroupRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns getDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *getDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultDeviceGroupRelationshipType.AllowedTargetTypes
+}
+
 func (v *getDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -10596,6 +11837,16 @@ type __premarshalgetDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipTy
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *getDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipType) MarshalJSON() ([]byte, error) {
@@ -10617,6 +11868,11 @@ func (v *getDeviceGroupRelationshipTypesByTokenDeviceGroupRelationshipTypesByTok
 	retval.Name = v.DefaultDeviceGroupRelationshipType.Name
 	retval.Description = v.DefaultDeviceGroupRelationshipType.Description
 	retval.Metadata = v.DefaultDeviceGroupRelationshipType.Metadata
+	retval.Cardinality = v.DefaultDeviceGroupRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultDeviceGroupRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultDeviceGroupRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultDeviceGroupRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultDeviceGroupRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -10968,6 +12224,41 @@ func (v *getDeviceRelationshipTypesByTokenDeviceRelationshipTypesByTokenDeviceRe
 	return v.DefaultDeviceRelationshipType.Tracked
 }
 
+// GetEnriched returns getDeviceRelationshipTypesByTokenDeviceRelationshipTypesByTokenDeviceRelationshipType.Enriched, and is useful for accessing the field via an interface.
+func (v *getDeviceRelationshipTypesByTokenDeviceRelationshipTypesByTokenDeviceRelationshipType) GetEnriched() bool {
+	return v.DefaultDeviceRelationshipType.Enriched
+}
+
+// GetEnrichedMetadataKeys returns getDeviceRelationshipTypesByTokenDeviceRelationshipTypesByTokenDeviceRelationshipType.EnrichedMetadataKeys, and is useful for accessing the field via an interface.
+func (v *getDeviceRelationshipTypesByTokenDeviceRelationshipTypesByTokenDeviceRelationshipType) GetEnrichedMetadataKeys() []string {
+	return v.DefaultDeviceRelationshipType.EnrichedMetadataKeys
+}
+
+// GetCardinality returns getDeviceRelationshipTypesByTokenDeviceRelationshipTypesByTokenDeviceRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *getDeviceRelationshipTypesByTokenDeviceRelationshipTypesByTokenDeviceRelationshipType) GetCardinality() string {
+	return v.DefaultDeviceRelationshipType.Cardinality
+}
+
+// GetOnConflict returns getDeviceRelationshipTypesByTokenDeviceRelationshipTypesByTokenDeviceRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *getDeviceRelationshipTypesByTokenDeviceRelationshipTypesByTokenDeviceRelationshipType) GetOnConflict() string {
+	return v.DefaultDeviceRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns getDeviceRelationshipTypesByTokenDeviceRelationshipTypesByTokenDeviceRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *getDeviceRelationshipTypesByTokenDeviceRelationshipTypesByTokenDeviceRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultDeviceRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns getDeviceRelationshipTypesByTokenDeviceRelationshipTypesByTokenDeviceRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *getDeviceRelationshipTypesByTokenDeviceRelationshipTypesByTokenDeviceRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultDeviceRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns getDeviceRelationshipTypesByTokenDeviceRelationshipTypesByTokenDeviceRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *getDeviceRelationshipTypesByTokenDeviceRelationshipTypesByTokenDeviceRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultDeviceRelationshipType.AllowedTargetTypes
+}
+
 func (v *getDeviceRelationshipTypesByTokenDeviceRelationshipTypesByTokenDeviceRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -11011,6 +12302,20 @@ type __premarshalgetDeviceRelationshipTypesByTokenDeviceRelationshipTypesByToken
 	Metadata *string `json:"metadata"`
 
 	Tracked bool `json:"tracked"`
+
+	Enriched bool `json:"enriched"`
+
+	EnrichedMetadataKeys []string `json:"enrichedMetadataKeys"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *getDeviceRelationshipTypesByTokenDeviceRelationshipTypesByTokenDeviceRelationshipType) MarshalJSON() ([]byte, error) {
@@ -11033,6 +12338,13 @@ func (v *getDeviceRelationshipTypesByTokenDeviceRelationshipTypesByTokenDeviceRe
 	retval.Description = v.DefaultDeviceRelationshipType.Description
 	retval.Metadata = v.DefaultDeviceRelationshipType.Metadata
 	retval.Tracked = v.DefaultDeviceRelationshipType.Tracked
+	retval.Enriched = v.DefaultDeviceRelationshipType.Enriched
+	retval.EnrichedMetadataKeys = v.DefaultDeviceRelationshipType.EnrichedMetadataKeys
+	retval.Cardinality = v.DefaultDeviceRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultDeviceRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultDeviceRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultDeviceRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultDeviceRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -11244,6 +12556,16 @@ func (v *getDeviceTypesByTokenDeviceTypesByTokenDeviceType) GetMetadata() *strin
 	return v.DefaultDeviceType.Metadata
 }
 
+// GetRateLimit returns getDeviceTypesByTokenDeviceTypesByTokenDeviceType.RateLimit, and is useful for accessing the field via an interface.
+func (v *getDeviceTypesByTokenDeviceTypesByTokenDeviceType) GetRateLimit() *float64 {
+	return v.DefaultDeviceType.RateLimit
+}
+
+// GetRateBurst returns getDeviceTypesByTokenDeviceTypesByTokenDeviceType.RateBurst, and is useful for accessing the field via an interface.
+func (v *getDeviceTypesByTokenDeviceTypesByTokenDeviceType) GetRateBurst() *int {
+	return v.DefaultDeviceType.RateBurst
+}
+
 func (v *getDeviceTypesByTokenDeviceTypesByTokenDeviceType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -11295,6 +12617,10 @@ type __premarshalgetDeviceTypesByTokenDeviceTypesByTokenDeviceType struct {
 	BorderColor *string `json:"borderColor"`
 
 	Metadata *string `json:"metadata"`
+
+	RateLimit *float64 `json:"rateLimit"`
+
+	RateBurst *int `json:"rateBurst"`
 }
 
 func (v *getDeviceTypesByTokenDeviceTypesByTokenDeviceType) MarshalJSON() ([]byte, error) {
@@ -11321,6 +12647,8 @@ func (v *getDeviceTypesByTokenDeviceTypesByTokenDeviceType) __premarshalJSON() (
 	retval.ForegroundColor = v.DefaultDeviceType.ForegroundColor
 	retval.BorderColor = v.DefaultDeviceType.BorderColor
 	retval.Metadata = v.DefaultDeviceType.Metadata
+	retval.RateLimit = v.DefaultDeviceType.RateLimit
+	retval.RateBurst = v.DefaultDeviceType.RateBurst
 	return &retval, nil
 }
 
@@ -11373,11 +12701,22 @@ func (v *getDevicesByTokenDevicesByTokenDevice) GetDeviceType() DefaultDeviceDev
 	return v.DefaultDevice.DeviceType
 }
 
+// GetStatus returns getDevicesByTokenDevicesByTokenDevice.Status, and is useful for accessing the field via an interface.
+func (v *getDevicesByTokenDevicesByTokenDevice) GetStatus() string { return v.DefaultDevice.Status }
+
 // GetMetadata returns getDevicesByTokenDevicesByTokenDevice.Metadata, and is useful for accessing the field via an interface.
 func (v *getDevicesByTokenDevicesByTokenDevice) GetMetadata() *string {
 	return v.DefaultDevice.Metadata
 }
 
+// GetRateLimit returns getDevicesByTokenDevicesByTokenDevice.RateLimit, and is useful for accessing the field via an interface.
+func (v *getDevicesByTokenDevicesByTokenDevice) GetRateLimit() *float64 {
+	return v.DefaultDevice.RateLimit
+}
+
+// GetRateBurst returns getDevicesByTokenDevicesByTokenDevice.RateBurst, and is useful for accessing the field via an interface.
+func (v *getDevicesByTokenDevicesByTokenDevice) GetRateBurst() *int { return v.DefaultDevice.RateBurst }
+
 func (v *getDevicesByTokenDevicesByTokenDevice) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -11420,7 +12759,13 @@ type __premarshalgetDevicesByTokenDevicesByTokenDevice struct {
 
 	DeviceType DefaultDeviceDeviceType `json:"deviceType"`
 
+	Status string `json:"status"`
+
 	Metadata *string `json:"metadata"`
+
+	RateLimit *float64 `json:"rateLimit"`
+
+	RateBurst *int `json:"rateBurst"`
 }
 
 func (v *getDevicesByTokenDevicesByTokenDevice) MarshalJSON() ([]byte, error) {
@@ -11442,7 +12787,10 @@ func (v *getDevicesByTokenDevicesByTokenDevice) __premarshalJSON() (*__premarsha
 	retval.Name = v.DefaultDevice.Name
 	retval.Description = v.DefaultDevice.Description
 	retval.DeviceType = v.DefaultDevice.DeviceType
+	retval.Status = v.DefaultDevice.Status
 	retval.Metadata = v.DefaultDevice.Metadata
+	retval.RateLimit = v.DefaultDevice.RateLimit
+	retval.RateBurst = v.DefaultDevice.RateBurst
 	return &retval, nil
 }
 
@@ -11587,6 +12935,31 @@ func (v *listAreaGroupRelationshipTypesAreaGroupRelationshipTypesAreaGroupRelati
 	return v.DefaultAreaGroupRelationshipType.Metadata
 }
 
+// GetCardinality returns listAreaGroupRelationshipTypesAreaGroupRelationshipTypesAreaGroupRelationshipTypeSearchResultsResultsAreaGroupRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *listAreaGroupRelationshipTypesAreaGroupRelationshipTypesAreaGroupRelationshipTypeSearchResultsResultsAreaGroupRelationshipType) GetCardinality() string {
+	return v.DefaultAreaGroupRelationshipType.Cardinality
+}
+
+// GetOnConflict returns listAreaGroupRelationshipTypesAreaGroupRelationshipTypesAreaGroupRelationshipTypeSearchResultsResultsAreaGroupRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *listAreaGroupRelationshipTypesAreaGroupRelationshipTypesAreaGroupRelationshipTypeSearchResultsResultsAreaGroupRelationshipType) GetOnConflict() string {
+	return v.DefaultAreaGroupRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns listAreaGroupRelationshipTypesAreaGroupRelationshipTypesAreaGroupRelationshipTypeSearchResultsResultsAreaGroupRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *listAreaGroupRelationshipTypesAreaGroupRelationshipTypesAreaGroupRelationshipTypeSearchResultsResultsAreaGroupRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultAreaGroupRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns listAreaGroupRelationshipTypesAreaGroupRelationshipTypesAreaGroupRelationshipTypeSearchResultsResultsAreaGroupRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *listAreaGroupRelationshipTypesAreaGroupRelationshipTypesAreaGroupRelationshipTypeSearchResultsResultsAreaGroupRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultAreaGroupRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns listAreaGroupRelationshipTypesAreaGroupRelationshipTypesAreaGroupRelationshipTypeSearchResultsResultsAreaGroupRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *listAreaGroupRelationshipTypesAreaGroupRelationshipTypesAreaGroupRelationshipTypeSearchResultsResultsAreaGroupRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultAreaGroupRelationshipType.AllowedTargetTypes
+}
+
 func (v *listAreaGroupRelationshipTypesAreaGroupRelationshipTypesAreaGroupRelationshipTypeSearchResultsResultsAreaGroupRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -11628,6 +13001,16 @@ type __premarshallistAreaGroupRelationshipTypesAreaGroupRelationshipTypesAreaGro
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *listAreaGroupRelationshipTypesAreaGroupRelationshipTypesAreaGroupRelationshipTypeSearchResultsResultsAreaGroupRelationshipType) MarshalJSON() ([]byte, error) {
@@ -11649,6 +13032,11 @@ func (v *listAreaGroupRelationshipTypesAreaGroupRelationshipTypesAreaGroupRelati
 	retval.Name = v.DefaultAreaGroupRelationshipType.Name
 	retval.Description = v.DefaultAreaGroupRelationshipType.Description
 	retval.Metadata = v.DefaultAreaGroupRelationshipType.Metadata
+	retval.Cardinality = v.DefaultAreaGroupRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultAreaGroupRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultAreaGroupRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultAreaGroupRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultAreaGroupRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -12253,6 +13641,36 @@ func (v *listAreaRelationshipTypesAreaRelationshipTypesAreaRelationshipTypeSearc
 	return v.DefaultAreaRelationshipType.Metadata
 }
 
+// GetTracked returns listAreaRelationshipTypesAreaRelationshipTypesAreaRelationshipTypeSearchResultsResultsAreaRelationshipType.Tracked, and is useful for accessing the field via an interface.
+func (v *listAreaRelationshipTypesAreaRelationshipTypesAreaRelationshipTypeSearchResultsResultsAreaRelationshipType) GetTracked() bool {
+	return v.DefaultAreaRelationshipType.Tracked
+}
+
+// GetCardinality returns listAreaRelationshipTypesAreaRelationshipTypesAreaRelationshipTypeSearchResultsResultsAreaRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *listAreaRelationshipTypesAreaRelationshipTypesAreaRelationshipTypeSearchResultsResultsAreaRelationshipType) GetCardinality() string {
+	return v.DefaultAreaRelationshipType.Cardinality
+}
+
+// GetOnConflict returns listAreaRelationshipTypesAreaRelationshipTypesAreaRelationshipTypeSearchResultsResultsAreaRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *listAreaRelationshipTypesAreaRelationshipTypesAreaRelationshipTypeSearchResultsResultsAreaRelationshipType) GetOnConflict() string {
+	return v.DefaultAreaRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns listAreaRelationshipTypesAreaRelationshipTypesAreaRelationshipTypeSearchResultsResultsAreaRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *listAreaRelationshipTypesAreaRelationshipTypesAreaRelationshipTypeSearchResultsResultsAreaRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultAreaRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns listAreaRelationshipTypesAreaRelationshipTypesAreaRelationshipTypeSearchResultsResultsAreaRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *listAreaRelationshipTypesAreaRelationshipTypesAreaRelationshipTypeSearchResultsResultsAreaRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultAreaRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns listAreaRelationshipTypesAreaRelationshipTypesAreaRelationshipTypeSearchResultsResultsAreaRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *listAreaRelationshipTypesAreaRelationshipTypesAreaRelationshipTypeSearchResultsResultsAreaRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultAreaRelationshipType.AllowedTargetTypes
+}
+
 func (v *listAreaRelationshipTypesAreaRelationshipTypesAreaRelationshipTypeSearchResultsResultsAreaRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -12294,6 +13712,18 @@ type __premarshallistAreaRelationshipTypesAreaRelationshipTypesAreaRelationshipT
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Tracked bool `json:"tracked"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *listAreaRelationshipTypesAreaRelationshipTypesAreaRelationshipTypeSearchResultsResultsAreaRelationshipType) MarshalJSON() ([]byte, error) {
@@ -12315,6 +13745,12 @@ func (v *listAreaRelationshipTypesAreaRelationshipTypesAreaRelationshipTypeSearc
 	retval.Name = v.DefaultAreaRelationshipType.Name
 	retval.Description = v.DefaultAreaRelationshipType.Description
 	retval.Metadata = v.DefaultAreaRelationshipType.Metadata
+	retval.Tracked = v.DefaultAreaRelationshipType.Tracked
+	retval.Cardinality = v.DefaultAreaRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultAreaRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultAreaRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultAreaRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultAreaRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -13125,6 +14561,31 @@ func (v *listAssetGroupRelationshipTypesAssetGroupRelationshipTypesAssetGroupRel
 	return v.DefaultAssetGroupRelationshipType.Metadata
 }
 
+// GetCardinality returns listAssetGroupRelationshipTypesAssetGroupRelationshipTypesAssetGroupRelationshipTypeSearchResultsResultsAssetGroupRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *listAssetGroupRelationshipTypesAssetGroupRelationshipTypesAssetGroupRelationshipTypeSearchResultsResultsAssetGroupRelationshipType) GetCardinality() string {
+	return v.DefaultAssetGroupRelationshipType.Cardinality
+}
+
+// GetOnConflict returns listAssetGroupRelationshipTypesAssetGroupRelationshipTypesAssetGroupRelationshipTypeSearchResultsResultsAssetGroupRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *listAssetGroupRelationshipTypesAssetGroupRelationshipTypesAssetGroupRelationshipTypeSearchResultsResultsAssetGroupRelationshipType) GetOnConflict() string {
+	return v.DefaultAssetGroupRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns listAssetGroupRelationshipTypesAssetGroupRelationshipTypesAssetGroupRelationshipTypeSearchResultsResultsAssetGroupRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *listAssetGroupRelationshipTypesAssetGroupRelationshipTypesAssetGroupRelationshipTypeSearchResultsResultsAssetGroupRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultAssetGroupRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns listAssetGroupRelationshipTypesAssetGroupRelationshipTypesAssetGroupRelationshipTypeSearchResultsResultsAssetGroupRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *listAssetGroupRelationshipTypesAssetGroupRelationshipTypesAssetGroupRelationshipTypeSearchResultsResultsAssetGroupRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultAssetGroupRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns listAssetGroupRelationshipTypesAssetGroupRelationshipTypesAssetGroupRelationshipTypeSearchResultsResultsAssetGroupRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *listAssetGroupRelationshipTypesAssetGroupRelationshipTypesAssetGroupRelationshipTypeSearchResultsResultsAssetGroupRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultAssetGroupRelationshipType.AllowedTargetTypes
+}
+
 func (v *listAssetGroupRelationshipTypesAssetGroupRelationshipTypesAssetGroupRelationshipTypeSearchResultsResultsAssetGroupRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -13166,6 +14627,16 @@ type __premarshallistAssetGroupRelationshipTypesAssetGroupRelationshipTypesAsset
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *listAssetGroupRelationshipTypesAssetGroupRelationshipTypesAssetGroupRelationshipTypeSearchResultsResultsAssetGroupRelationshipType) MarshalJSON() ([]byte, error) {
@@ -13187,6 +14658,11 @@ func (v *listAssetGroupRelationshipTypesAssetGroupRelationshipTypesAssetGroupRel
 	retval.Name = v.DefaultAssetGroupRelationshipType.Name
 	retval.Description = v.DefaultAssetGroupRelationshipType.Description
 	retval.Metadata = v.DefaultAssetGroupRelationshipType.Metadata
+	retval.Cardinality = v.DefaultAssetGroupRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultAssetGroupRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultAssetGroupRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultAssetGroupRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultAssetGroupRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -13791,6 +15267,36 @@ func (v *listAssetRelationshipTypesAssetRelationshipTypesAssetRelationshipTypeSe
 	return v.DefaultAssetRelationshipType.Metadata
 }
 
+// GetTracked returns listAssetRelationshipTypesAssetRelationshipTypesAssetRelationshipTypeSearchResultsResultsAssetRelationshipType.Tracked, and is useful for accessing the field via an interface.
+func (v *listAssetRelationshipTypesAssetRelationshipTypesAssetRelationshipTypeSearchResultsResultsAssetRelationshipType) GetTracked() bool {
+	return v.DefaultAssetRelationshipType.Tracked
+}
+
+// GetCardinality returns listAssetRelationshipTypesAssetRelationshipTypesAssetRelationshipTypeSearchResultsResultsAssetRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *listAssetRelationshipTypesAssetRelationshipTypesAssetRelationshipTypeSearchResultsResultsAssetRelationshipType) GetCardinality() string {
+	return v.DefaultAssetRelationshipType.Cardinality
+}
+
+// GetOnConflict returns listAssetRelationshipTypesAssetRelationshipTypesAssetRelationshipTypeSearchResultsResultsAssetRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *listAssetRelationshipTypesAssetRelationshipTypesAssetRelationshipTypeSearchResultsResultsAssetRelationshipType) GetOnConflict() string {
+	return v.DefaultAssetRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns listAssetRelationshipTypesAssetRelationshipTypesAssetRelationshipTypeSearchResultsResultsAssetRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *listAssetRelationshipTypesAssetRelationshipTypesAssetRelationshipTypeSearchResultsResultsAssetRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultAssetRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns listAssetRelationshipTypesAssetRelationshipTypesAssetRelationshipTypeSearchResultsResultsAssetRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *listAssetRelationshipTypesAssetRelationshipTypesAssetRelationshipTypeSearchResultsResultsAssetRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultAssetRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns listAssetRelationshipTypesAssetRelationshipTypesAssetRelationshipTypeSearchResultsResultsAssetRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *listAssetRelationshipTypesAssetRelationshipTypesAssetRelationshipTypeSearchResultsResultsAssetRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultAssetRelationshipType.AllowedTargetTypes
+}
+
 func (v *listAssetRelationshipTypesAssetRelationshipTypesAssetRelationshipTypeSearchResultsResultsAssetRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -13832,6 +15338,18 @@ type __premarshallistAssetRelationshipTypesAssetRelationshipTypesAssetRelationsh
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Tracked bool `json:"tracked"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *listAssetRelationshipTypesAssetRelationshipTypesAssetRelationshipTypeSearchResultsResultsAssetRelationshipType) MarshalJSON() ([]byte, error) {
@@ -13853,6 +15371,12 @@ func (v *listAssetRelationshipTypesAssetRelationshipTypesAssetRelationshipTypeSe
 	retval.Name = v.DefaultAssetRelationshipType.Name
 	retval.Description = v.DefaultAssetRelationshipType.Description
 	retval.Metadata = v.DefaultAssetRelationshipType.Metadata
+	retval.Tracked = v.DefaultAssetRelationshipType.Tracked
+	retval.Cardinality = v.DefaultAssetRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultAssetRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultAssetRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultAssetRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultAssetRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -14667,6 +16191,31 @@ func (v *listCustomerGroupRelationshipTypesCustomerGroupRelationshipTypesCustome
 	return v.DefaultCustomerGroupRelationshipType.Metadata
 }
 
+// GetCardinality returns listCustomerGroupRelationshipTypesCustomerGroupRelationshipTypesCustomerGroupRelationshipTypeSearchResultsResultsCustomerGroupRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *listCustomerGroupRelationshipTypesCustomerGroupRelationshipTypesCustomerGroupRelationshipTypeSearchResultsResultsCustomerGroupRelationshipType) GetCardinality() string {
+	return v.DefaultCustomerGroupRelationshipType.Cardinality
+}
+
+// GetOnConflict returns listCustomerGroupRelationshipTypesCustomerGroupRelationshipTypesCustomerGroupRelationshipTypeSearchResultsResultsCustomerGroupRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *listCustomerGroupRelationshipTypesCustomerGroupRelationshipTypesCustomerGroupRelationshipTypeSearchResultsResultsCustomerGroupRelationshipType) GetOnConflict() string {
+	return v.DefaultCustomerGroupRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns listCustomerGroupRelationshipTypesCustomerGroupRelationshipTypesCustomerGroupRelationshipTypeSearchResultsResultsCustomerGroupRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *listCustomerGroupRelationshipTypesCustomerGroupRelationshipTypesCustomerGroupRelationshipTypeSearchResultsResultsCustomerGroupRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultCustomerGroupRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns listCustomerGroupRelationshipTypesCustomerGroupRelationshipTypesCustomerGroupRelationshipTypeSearchResultsResultsCustomerGroupRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *listCustomerGroupRelationshipTypesCustomerGroupRelationshipTypesCustomerGroupRelationshipTypeSearchResultsResultsCustomerGroupRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultCustomerGroupRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns listCustomerGroupRelationshipTypesCustomerGroupRelationshipTypesCustomerGroupRelationshipTypeSearchResultsResultsCustomerGroupRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *listCustomerGroupRelationshipTypesCustomerGroupRelationshipTypesCustomerGroupRelationshipTypeSearchResultsResultsCustomerGroupRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultCustomerGroupRelationshipType.AllowedTargetTypes
+}
+
 func (v *listCustomerGroupRelationshipTypesCustomerGroupRelationshipTypesCustomerGroupRelationshipTypeSearchResultsResultsCustomerGroupRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -14708,6 +16257,16 @@ type __premarshallistCustomerGroupRelationshipTypesCustomerGroupRelationshipType
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *listCustomerGroupRelationshipTypesCustomerGroupRelationshipTypesCustomerGroupRelationshipTypeSearchResultsResultsCustomerGroupRelationshipType) MarshalJSON() ([]byte, error) {
@@ -14729,6 +16288,11 @@ func (v *listCustomerGroupRelationshipTypesCustomerGroupRelationshipTypesCustome
 	retval.Name = v.DefaultCustomerGroupRelationshipType.Name
 	retval.Description = v.DefaultCustomerGroupRelationshipType.Description
 	retval.Metadata = v.DefaultCustomerGroupRelationshipType.Metadata
+	retval.Cardinality = v.DefaultCustomerGroupRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultCustomerGroupRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultCustomerGroupRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultCustomerGroupRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultCustomerGroupRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -15333,6 +16897,36 @@ func (v *listCustomerRelationshipTypesCustomerRelationshipTypesCustomerRelations
 	return v.DefaultCustomerRelationshipType.Metadata
 }
 
+// GetTracked returns listCustomerRelationshipTypesCustomerRelationshipTypesCustomerRelationshipTypeSearchResultsResultsCustomerRelationshipType.Tracked, and is useful for accessing the field via an interface.
+func (v *listCustomerRelationshipTypesCustomerRelationshipTypesCustomerRelationshipTypeSearchResultsResultsCustomerRelationshipType) GetTracked() bool {
+	return v.DefaultCustomerRelationshipType.Tracked
+}
+
+// GetCardinality returns listCustomerRelationshipTypesCustomerRelationshipTypesCustomerRelationshipTypeSearchResultsResultsCustomerRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *listCustomerRelationshipTypesCustomerRelationshipTypesCustomerRelationshipTypeSearchResultsResultsCustomerRelationshipType) GetCardinality() string {
+	return v.DefaultCustomerRelationshipType.Cardinality
+}
+
+// GetOnConflict returns listCustomerRelationshipTypesCustomerRelationshipTypesCustomerRelationshipTypeSearchResultsResultsCustomerRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *listCustomerRelationshipTypesCustomerRelationshipTypesCustomerRelationshipTypeSearchResultsResultsCustomerRelationshipType) GetOnConflict() string {
+	return v.DefaultCustomerRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns listCustomerRelationshipTypesCustomerRelationshipTypesCustomerRelationshipTypeSearchResultsResultsCustomerRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *listCustomerRelationshipTypesCustomerRelationshipTypesCustomerRelationshipTypeSearchResultsResultsCustomerRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultCustomerRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns listCustomerRelationshipTypesCustomerRelationshipTypesCustomerRelationshipTypeSearchResultsResultsCustomerRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *listCustomerRelationshipTypesCustomerRelationshipTypesCustomerRelationshipTypeSearchResultsResultsCustomerRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultCustomerRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns listCustomerRelationshipTypesCustomerRelationshipTypesCustomerRelationshipTypeSearchResultsResultsCustomerRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *listCustomerRelationshipTypesCustomerRelationshipTypesCustomerRelationshipTypeSearchResultsResultsCustomerRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultCustomerRelationshipType.AllowedTargetTypes
+}
+
 func (v *listCustomerRelationshipTypesCustomerRelationshipTypesCustomerRelationshipTypeSearchResultsResultsCustomerRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -15374,6 +16968,18 @@ type __premarshallistCustomerRelationshipTypesCustomerRelationshipTypesCustomerR
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Tracked bool `json:"tracked"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *listCustomerRelationshipTypesCustomerRelationshipTypesCustomerRelationshipTypeSearchResultsResultsCustomerRelationshipType) MarshalJSON() ([]byte, error) {
@@ -15395,6 +17001,12 @@ func (v *listCustomerRelationshipTypesCustomerRelationshipTypesCustomerRelations
 	retval.Name = v.DefaultCustomerRelationshipType.Name
 	retval.Description = v.DefaultCustomerRelationshipType.Description
 	retval.Metadata = v.DefaultCustomerRelationshipType.Metadata
+	retval.Tracked = v.DefaultCustomerRelationshipType.Tracked
+	retval.Cardinality = v.DefaultCustomerRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultCustomerRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultCustomerRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultCustomerRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultCustomerRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -16213,6 +17825,31 @@ func (v *listDeviceGroupRelationshipTypesDeviceGroupRelationshipTypesDeviceGroup
 	return v.DefaultDeviceGroupRelationshipType.Metadata
 }
 
+// GetCardinality returns listDeviceGroupRelationshipTypesDeviceGroupRelationshipTypesDeviceGroupRelationshipTypeSearchResultsResultsDeviceGroupRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *listDeviceGroupRelationshipTypesDeviceGroupRelationshipTypesDeviceGroupRelationshipTypeSearchResultsResultsDeviceGroupRelationshipType) GetCardinality() string {
+	return v.DefaultDeviceGroupRelationshipType.Cardinality
+}
+
+// GetOnConflict returns listDeviceGroupRelationshipTypesDeviceGroupRelationshipTypesDeviceGroupRelationshipTypeSearchResultsResultsDeviceGroupRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *listDeviceGroupRelationshipTypesDeviceGroupRelationshipTypesDeviceGroupRelationshipTypeSearchResultsResultsDeviceGroupRelationshipType) GetOnConflict() string {
+	return v.DefaultDeviceGroupRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns listDeviceGroupRelationshipTypesDeviceGroupRelationshipTypesDeviceGroupRelationshipTypeSearchResultsResultsDeviceGroupRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *listDeviceGroupRelationshipTypesDeviceGroupRelationshipTypesDeviceGroupRelationshipTypeSearchResultsResultsDeviceGroupRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultDeviceGroupRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns listDeviceGroupRelationshipTypesDeviceGroupRelationshipTypesDeviceGroupRelationshipTypeSearchResultsResultsDeviceGroupRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *listDeviceGroupRelationshipTypesDeviceGroupRelationshipTypesDeviceGroupRelationshipTypeSearchResultsResultsDeviceGroupRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultDeviceGroupRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns listDeviceGroupRelationshipTypesDeviceGroupRelationshipTypesDeviceGroupRelationshipTypeSearchResultsResultsDeviceGroupRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *listDeviceGroupRelationshipTypesDeviceGroupRelationshipTypesDeviceGroupRelationshipTypeSearchResultsResultsDeviceGroupRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultDeviceGroupRelationshipType.AllowedTargetTypes
+}
+
 func (v *listDeviceGroupRelationshipTypesDeviceGroupRelationshipTypesDeviceGroupRelationshipTypeSearchResultsResultsDeviceGroupRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -16254,6 +17891,16 @@ type __premarshallistDeviceGroupRelationshipTypesDeviceGroupRelationshipTypesDev
 	Description *string `json:"description"`
 
 	Metadata *string `json:"metadata"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *listDeviceGroupRelationshipTypesDeviceGroupRelationshipTypesDeviceGroupRelationshipTypeSearchResultsResultsDeviceGroupRelationshipType) MarshalJSON() ([]byte, error) {
@@ -16275,6 +17922,11 @@ func (v *listDeviceGroupRelationshipTypesDeviceGroupRelationshipTypesDeviceGroup
 	retval.Name = v.DefaultDeviceGroupRelationshipType.Name
 	retval.Description = v.DefaultDeviceGroupRelationshipType.Description
 	retval.Metadata = v.DefaultDeviceGroupRelationshipType.Metadata
+	retval.Cardinality = v.DefaultDeviceGroupRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultDeviceGroupRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultDeviceGroupRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultDeviceGroupRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultDeviceGroupRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -16884,6 +18536,41 @@ func (v *listDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelationshipTyp
 	return v.DefaultDeviceRelationshipType.Tracked
 }
 
+// GetEnriched returns listDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelationshipTypeSearchResultsResultsDeviceRelationshipType.Enriched, and is useful for accessing the field via an interface.
+func (v *listDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelationshipTypeSearchResultsResultsDeviceRelationshipType) GetEnriched() bool {
+	return v.DefaultDeviceRelationshipType.Enriched
+}
+
+// GetEnrichedMetadataKeys returns listDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelationshipTypeSearchResultsResultsDeviceRelationshipType.EnrichedMetadataKeys, and is useful for accessing the field via an interface.
+func (v *listDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelationshipTypeSearchResultsResultsDeviceRelationshipType) GetEnrichedMetadataKeys() []string {
+	return v.DefaultDeviceRelationshipType.EnrichedMetadataKeys
+}
+
+// GetCardinality returns listDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelationshipTypeSearchResultsResultsDeviceRelationshipType.Cardinality, and is useful for accessing the field via an interface.
+func (v *listDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelationshipTypeSearchResultsResultsDeviceRelationshipType) GetCardinality() string {
+	return v.DefaultDeviceRelationshipType.Cardinality
+}
+
+// GetOnConflict returns listDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelationshipTypeSearchResultsResultsDeviceRelationshipType.OnConflict, and is useful for accessing the field via an interface.
+func (v *listDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelationshipTypeSearchResultsResultsDeviceRelationshipType) GetOnConflict() string {
+	return v.DefaultDeviceRelationshipType.OnConflict
+}
+
+// GetAllowedTargetKinds returns listDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelationshipTypeSearchResultsResultsDeviceRelationshipType.AllowedTargetKinds, and is useful for accessing the field via an interface.
+func (v *listDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelationshipTypeSearchResultsResultsDeviceRelationshipType) GetAllowedTargetKinds() []string {
+	return v.DefaultDeviceRelationshipType.AllowedTargetKinds
+}
+
+// GetAllowedSourceTypes returns listDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelationshipTypeSearchResultsResultsDeviceRelationshipType.AllowedSourceTypes, and is useful for accessing the field via an interface.
+func (v *listDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelationshipTypeSearchResultsResultsDeviceRelationshipType) GetAllowedSourceTypes() []string {
+	return v.DefaultDeviceRelationshipType.AllowedSourceTypes
+}
+
+// GetAllowedTargetTypes returns listDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelationshipTypeSearchResultsResultsDeviceRelationshipType.AllowedTargetTypes, and is useful for accessing the field via an interface.
+func (v *listDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelationshipTypeSearchResultsResultsDeviceRelationshipType) GetAllowedTargetTypes() []string {
+	return v.DefaultDeviceRelationshipType.AllowedTargetTypes
+}
+
 func (v *listDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelationshipTypeSearchResultsResultsDeviceRelationshipType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -16927,6 +18614,20 @@ type __premarshallistDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelatio
 	Metadata *string `json:"metadata"`
 
 	Tracked bool `json:"tracked"`
+
+	Enriched bool `json:"enriched"`
+
+	EnrichedMetadataKeys []string `json:"enrichedMetadataKeys"`
+
+	Cardinality string `json:"cardinality"`
+
+	OnConflict string `json:"onConflict"`
+
+	AllowedTargetKinds []string `json:"allowedTargetKinds"`
+
+	AllowedSourceTypes []string `json:"allowedSourceTypes"`
+
+	AllowedTargetTypes []string `json:"allowedTargetTypes"`
 }
 
 func (v *listDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelationshipTypeSearchResultsResultsDeviceRelationshipType) MarshalJSON() ([]byte, error) {
@@ -16949,6 +18650,13 @@ func (v *listDeviceRelationshipTypesDeviceRelationshipTypesDeviceRelationshipTyp
 	retval.Description = v.DefaultDeviceRelationshipType.Description
 	retval.Metadata = v.DefaultDeviceRelationshipType.Metadata
 	retval.Tracked = v.DefaultDeviceRelationshipType.Tracked
+	retval.Enriched = v.DefaultDeviceRelationshipType.Enriched
+	retval.EnrichedMetadataKeys = v.DefaultDeviceRelationshipType.EnrichedMetadataKeys
+	retval.Cardinality = v.DefaultDeviceRelationshipType.Cardinality
+	retval.OnConflict = v.DefaultDeviceRelationshipType.OnConflict
+	retval.AllowedTargetKinds = v.DefaultDeviceRelationshipType.AllowedTargetKinds
+	retval.AllowedSourceTypes = v.DefaultDeviceRelationshipType.AllowedSourceTypes
+	retval.AllowedTargetTypes = v.DefaultDeviceRelationshipType.AllowedTargetTypes
 	return &retval, nil
 }
 
@@ -17332,6 +19040,16 @@ func (v *listDeviceTypesDeviceTypesDeviceTypeSearchResultsResultsDeviceType) Get
 	return v.DefaultDeviceType.Metadata
 }
 
+// GetRateLimit returns listDeviceTypesDeviceTypesDeviceTypeSearchResultsResultsDeviceType.RateLimit, and is useful for accessing the field via an interface.
+func (v *listDeviceTypesDeviceTypesDeviceTypeSearchResultsResultsDeviceType) GetRateLimit() *float64 {
+	return v.DefaultDeviceType.RateLimit
+}
+
+// GetRateBurst returns listDeviceTypesDeviceTypesDeviceTypeSearchResultsResultsDeviceType.RateBurst, and is useful for accessing the field via an interface.
+func (v *listDeviceTypesDeviceTypesDeviceTypeSearchResultsResultsDeviceType) GetRateBurst() *int {
+	return v.DefaultDeviceType.RateBurst
+}
+
 func (v *listDeviceTypesDeviceTypesDeviceTypeSearchResultsResultsDeviceType) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -17383,6 +19101,10 @@ type __premarshallistDeviceTypesDeviceTypesDeviceTypeSearchResultsResultsDeviceT
 	BorderColor *string `json:"borderColor"`
 
 	Metadata *string `json:"metadata"`
+
+	RateLimit *float64 `json:"rateLimit"`
+
+	RateBurst *int `json:"rateBurst"`
 }
 
 func (v *listDeviceTypesDeviceTypesDeviceTypeSearchResultsResultsDeviceType) MarshalJSON() ([]byte, error) {
@@ -17409,6 +19131,8 @@ func (v *listDeviceTypesDeviceTypesDeviceTypeSearchResultsResultsDeviceType) __p
 	retval.ForegroundColor = v.DefaultDeviceType.ForegroundColor
 	retval.BorderColor = v.DefaultDeviceType.BorderColor
 	retval.Metadata = v.DefaultDeviceType.Metadata
+	retval.RateLimit = v.DefaultDeviceType.RateLimit
+	retval.RateBurst = v.DefaultDeviceType.RateBurst
 	return &retval, nil
 }
 
@@ -17553,11 +19277,26 @@ func (v *listDevicesDevicesDeviceSearchResultsResultsDevice) GetDeviceType() Def
 	return v.DefaultDevice.DeviceType
 }
 
+// GetStatus returns listDevicesDevicesDeviceSearchResultsResultsDevice.Status, and is useful for accessing the field via an interface.
+func (v *listDevicesDevicesDeviceSearchResultsResultsDevice) GetStatus() string {
+	return v.DefaultDevice.Status
+}
+
 // GetMetadata returns listDevicesDevicesDeviceSearchResultsResultsDevice.Metadata, and is useful for accessing the field via an interface.
 func (v *listDevicesDevicesDeviceSearchResultsResultsDevice) GetMetadata() *string {
 	return v.DefaultDevice.Metadata
 }
 
+// GetRateLimit returns listDevicesDevicesDeviceSearchResultsResultsDevice.RateLimit, and is useful for accessing the field via an interface.
+func (v *listDevicesDevicesDeviceSearchResultsResultsDevice) GetRateLimit() *float64 {
+	return v.DefaultDevice.RateLimit
+}
+
+// GetRateBurst returns listDevicesDevicesDeviceSearchResultsResultsDevice.RateBurst, and is useful for accessing the field via an interface.
+func (v *listDevicesDevicesDeviceSearchResultsResultsDevice) GetRateBurst() *int {
+	return v.DefaultDevice.RateBurst
+}
+
 func (v *listDevicesDevicesDeviceSearchResultsResultsDevice) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
@@ -17600,7 +19339,13 @@ type __premarshallistDevicesDevicesDeviceSearchResultsResultsDevice struct {
 
 	DeviceType DefaultDeviceDeviceType `json:"deviceType"`
 
+	Status string `json:"status"`
+
 	Metadata *string `json:"metadata"`
+
+	RateLimit *float64 `json:"rateLimit"`
+
+	RateBurst *int `json:"rateBurst"`
 }
 
 func (v *listDevicesDevicesDeviceSearchResultsResultsDevice) MarshalJSON() ([]byte, error) {
@@ -17622,7 +19367,10 @@ func (v *listDevicesDevicesDeviceSearchResultsResultsDevice) __premarshalJSON()
 	retval.Name = v.DefaultDevice.Name
 	retval.Description = v.DefaultDevice.Description
 	retval.DeviceType = v.DefaultDevice.DeviceType
+	retval.Status = v.DefaultDevice.Status
 	retval.Metadata = v.DefaultDevice.Metadata
+	retval.RateLimit = v.DefaultDevice.RateLimit
+	retval.RateBurst = v.DefaultDevice.RateBurst
 	return &retval, nil
 }
 
@@ -17634,6 +19382,94 @@ type listDevicesResponse struct {
 // GetDevices returns listDevicesResponse.Devices, and is useful for accessing the field via an interface.
 func (v *listDevicesResponse) GetDevices() listDevicesDevicesDeviceSearchResults { return v.Devices }
 
+// verifyDeviceCredentialResponse is returned by verifyDeviceCredential on success.
+type verifyDeviceCredentialResponse struct {
+	VerifyDeviceCredential verifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification `json:"verifyDeviceCredential"`
+}
+
+// GetVerifyDeviceCredential returns verifyDeviceCredentialResponse.VerifyDeviceCredential, and is useful for accessing the field via an interface.
+func (v *verifyDeviceCredentialResponse) GetVerifyDeviceCredential() verifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification {
+	return v.VerifyDeviceCredential
+}
+
+// verifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification includes the requested fields of the GraphQL type DeviceCredentialVerification.
+type verifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification struct {
+	DefaultDeviceCredentialVerification `json:"-"`
+}
+
+// GetValid returns verifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification.Valid, and is useful for accessing the field via an interface.
+func (v *verifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification) GetValid() bool {
+	return v.DefaultDeviceCredentialVerification.Valid
+}
+
+// GetReason returns verifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification.Reason, and is useful for accessing the field via an interface.
+func (v *verifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification) GetReason() *string {
+	return v.DefaultDeviceCredentialVerification.Reason
+}
+
+// GetDevice returns verifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification.Device, and is useful for accessing the field via an interface.
+func (v *verifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification) GetDevice() *DefaultDeviceCredentialVerificationDevice {
+	return v.DefaultDeviceCredentialVerification.Device
+}
+
+// GetCredential returns verifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification.Credential, and is useful for accessing the field via an interface.
+func (v *verifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification) GetCredential() *DefaultDeviceCredentialVerificationCredentialDeviceCredential {
+	return v.DefaultDeviceCredentialVerification.Credential
+}
+
+func (v *verifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*verifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.verifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.DefaultDeviceCredentialVerification)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalverifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification struct {
+	Valid bool `json:"valid"`
+
+	Reason *string `json:"reason"`
+
+	Device *DefaultDeviceCredentialVerificationDevice `json:"device"`
+
+	Credential *DefaultDeviceCredentialVerificationCredentialDeviceCredential `json:"credential"`
+}
+
+func (v *verifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *verifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification) __premarshalJSON() (*__premarshalverifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification, error) {
+	var retval __premarshalverifyDeviceCredentialVerifyDeviceCredentialDeviceCredentialVerification
+
+	retval.Valid = v.DefaultDeviceCredentialVerification.Valid
+	retval.Reason = v.DefaultDeviceCredentialVerification.Reason
+	retval.Device = v.DefaultDeviceCredentialVerification.Device
+	retval.Credential = v.DefaultDeviceCredentialVerification.Credential
+	return &retval, nil
+}
+
 // Create area and return identifiers.
 func createArea(
 	ctx context.Context,
@@ -17848,12 +19684,17 @@ func createAreaGroupRelationshipType(
 	name *string,
 	description *string,
 	metadata *string,
+	cardinality *string,
+	onConflict *string,
+	allowedTargetKinds []string,
+	allowedSourceTypes []string,
+	allowedTargetTypes []string,
 ) (*createAreaGroupRelationshipTypeResponse, error) {
 	req := &graphql.Request{
 		OpName: "createAreaGroupRelationshipType",
 		Query: `
-mutation createAreaGroupRelationshipType ($token: String!, $name: String, $description: String, $metadata: String) {
-	createAreaGroupRelationshipType(request: {token:$token,name:$name,description:$description,metadata:$metadata}) {
+mutation createAreaGroupRelationshipType ($token: String!, $name: String, $description: String, $metadata: String, $cardinality: String, $onConflict: String, $allowedTargetKinds: [String!], $allowedSourceTypes: [String!], $allowedTargetTypes: [String!]) {
+	createAreaGroupRelationshipType(request: {token:$token,name:$name,description:$description,metadata:$metadata,cardinality:$cardinality,onConflict:$onConflict,allowedTargetKinds:$allowedTargetKinds,allowedSourceTypes:$allowedSourceTypes,allowedTargetTypes:$allowedTargetTypes}) {
 		... DefaultAreaGroupRelationshipType
 	}
 }
@@ -17866,13 +19707,23 @@ fragment DefaultAreaGroupRelationshipType on AreaGroupRelationshipType {
 	name
 	description
 	metadata
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 `,
 		Variables: &__createAreaGroupRelationshipTypeInput{
-			Token:       token,
-			Name:        name,
-			Description: description,
-			Metadata:    metadata,
+			Token:              token,
+			Name:               name,
+			Description:        description,
+			Metadata:           metadata,
+			Cardinality:        cardinality,
+			OnConflict:         onConflict,
+			AllowedTargetKinds: allowedTargetKinds,
+			AllowedSourceTypes: allowedSourceTypes,
+			AllowedTargetTypes: allowedTargetTypes,
 		},
 	}
 	var err error
@@ -17983,12 +19834,18 @@ func createAreaRelationshipType(
 	name *string,
 	description *string,
 	metadata *string,
+	tracked *bool,
+	cardinality *string,
+	onConflict *string,
+	allowedTargetKinds []string,
+	allowedSourceTypes []string,
+	allowedTargetTypes []string,
 ) (*createAreaRelationshipTypeResponse, error) {
 	req := &graphql.Request{
 		OpName: "createAreaRelationshipType",
 		Query: `
-mutation createAreaRelationshipType ($token: String!, $name: String, $description: String, $metadata: String) {
-	createAreaRelationshipType(request: {token:$token,name:$name,description:$description,metadata:$metadata}) {
+mutation createAreaRelationshipType ($token: String!, $name: String, $description: String, $metadata: String, $tracked: Boolean, $cardinality: String, $onConflict: String, $allowedTargetKinds: [String!], $allowedSourceTypes: [String!], $allowedTargetTypes: [String!]) {
+	createAreaRelationshipType(request: {token:$token,name:$name,description:$description,metadata:$metadata,tracked:$tracked,cardinality:$cardinality,onConflict:$onConflict,allowedTargetKinds:$allowedTargetKinds,allowedSourceTypes:$allowedSourceTypes,allowedTargetTypes:$allowedTargetTypes}) {
 		... DefaultAreaRelationshipType
 	}
 }
@@ -18001,13 +19858,25 @@ fragment DefaultAreaRelationshipType on AreaRelationshipType {
 	name
 	description
 	metadata
+	tracked
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 `,
 		Variables: &__createAreaRelationshipTypeInput{
-			Token:       token,
-			Name:        name,
-			Description: description,
-			Metadata:    metadata,
+			Token:              token,
+			Name:               name,
+			Description:        description,
+			Metadata:           metadata,
+			Tracked:            tracked,
+			Cardinality:        cardinality,
+			OnConflict:         onConflict,
+			AllowedTargetKinds: allowedTargetKinds,
+			AllowedSourceTypes: allowedSourceTypes,
+			AllowedTargetTypes: allowedTargetTypes,
 		},
 	}
 	var err error
@@ -18302,12 +20171,17 @@ func createAssetGroupRelationshipType(
 	name *string,
 	description *string,
 	metadata *string,
+	cardinality *string,
+	onConflict *string,
+	allowedTargetKinds []string,
+	allowedSourceTypes []string,
+	allowedTargetTypes []string,
 ) (*createAssetGroupRelationshipTypeResponse, error) {
 	req := &graphql.Request{
 		OpName: "createAssetGroupRelationshipType",
 		Query: `
-mutation createAssetGroupRelationshipType ($token: String!, $name: String, $description: String, $metadata: String) {
-	createAssetGroupRelationshipType(request: {token:$token,name:$name,description:$description,metadata:$metadata}) {
+mutation createAssetGroupRelationshipType ($token: String!, $name: String, $description: String, $metadata: String, $cardinality: String, $onConflict: String, $allowedTargetKinds: [String!], $allowedSourceTypes: [String!], $allowedTargetTypes: [String!]) {
+	createAssetGroupRelationshipType(request: {token:$token,name:$name,description:$description,metadata:$metadata,cardinality:$cardinality,onConflict:$onConflict,allowedTargetKinds:$allowedTargetKinds,allowedSourceTypes:$allowedSourceTypes,allowedTargetTypes:$allowedTargetTypes}) {
 		... DefaultAssetGroupRelationshipType
 	}
 }
@@ -18320,13 +20194,23 @@ fragment DefaultAssetGroupRelationshipType on AssetGroupRelationshipType {
 	name
 	description
 	metadata
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 `,
 		Variables: &__createAssetGroupRelationshipTypeInput{
-			Token:       token,
-			Name:        name,
-			Description: description,
-			Metadata:    metadata,
+			Token:              token,
+			Name:               name,
+			Description:        description,
+			Metadata:           metadata,
+			Cardinality:        cardinality,
+			OnConflict:         onConflict,
+			AllowedTargetKinds: allowedTargetKinds,
+			AllowedSourceTypes: allowedSourceTypes,
+			AllowedTargetTypes: allowedTargetTypes,
 		},
 	}
 	var err error
@@ -18437,12 +20321,18 @@ func createAssetRelationshipType(
 	name *string,
 	description *string,
 	metadata *string,
+	tracked *bool,
+	cardinality *string,
+	onConflict *string,
+	allowedTargetKinds []string,
+	allowedSourceTypes []string,
+	allowedTargetTypes []string,
 ) (*createAssetRelationshipTypeResponse, error) {
 	req := &graphql.Request{
 		OpName: "createAssetRelationshipType",
 		Query: `
-mutation createAssetRelationshipType ($token: String!, $name: String, $description: String, $metadata: String) {
-	createAssetRelationshipType(request: {token:$token,name:$name,description:$description,metadata:$metadata}) {
+mutation createAssetRelationshipType ($token: String!, $name: String, $description: String, $metadata: String, $tracked: Boolean, $cardinality: String, $onConflict: String, $allowedTargetKinds: [String!], $allowedSourceTypes: [String!], $allowedTargetTypes: [String!]) {
+	createAssetRelationshipType(request: {token:$token,name:$name,description:$description,metadata:$metadata,tracked:$tracked,cardinality:$cardinality,onConflict:$onConflict,allowedTargetKinds:$allowedTargetKinds,allowedSourceTypes:$allowedSourceTypes,allowedTargetTypes:$allowedTargetTypes}) {
 		... DefaultAssetRelationshipType
 	}
 }
@@ -18455,13 +20345,25 @@ fragment DefaultAssetRelationshipType on AssetRelationshipType {
 	name
 	description
 	metadata
+	tracked
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 `,
 		Variables: &__createAssetRelationshipTypeInput{
-			Token:       token,
-			Name:        name,
-			Description: description,
-			Metadata:    metadata,
+			Token:              token,
+			Name:               name,
+			Description:        description,
+			Metadata:           metadata,
+			Tracked:            tracked,
+			Cardinality:        cardinality,
+			OnConflict:         onConflict,
+			AllowedTargetKinds: allowedTargetKinds,
+			AllowedSourceTypes: allowedSourceTypes,
+			AllowedTargetTypes: allowedTargetTypes,
 		},
 	}
 	var err error
@@ -18756,12 +20658,17 @@ func createCustomerGroupRelationshipType(
 	name *string,
 	description *string,
 	metadata *string,
+	cardinality *string,
+	onConflict *string,
+	allowedTargetKinds []string,
+	allowedSourceTypes []string,
+	allowedTargetTypes []string,
 ) (*createCustomerGroupRelationshipTypeResponse, error) {
 	req := &graphql.Request{
 		OpName: "createCustomerGroupRelationshipType",
 		Query: `
-mutation createCustomerGroupRelationshipType ($token: String!, $name: String, $description: String, $metadata: String) {
-	createCustomerGroupRelationshipType(request: {token:$token,name:$name,description:$description,metadata:$metadata}) {
+mutation createCustomerGroupRelationshipType ($token: String!, $name: String, $description: String, $metadata: String, $cardinality: String, $onConflict: String, $allowedTargetKinds: [String!], $allowedSourceTypes: [String!], $allowedTargetTypes: [String!]) {
+	createCustomerGroupRelationshipType(request: {token:$token,name:$name,description:$description,metadata:$metadata,cardinality:$cardinality,onConflict:$onConflict,allowedTargetKinds:$allowedTargetKinds,allowedSourceTypes:$allowedSourceTypes,allowedTargetTypes:$allowedTargetTypes}) {
 		... DefaultCustomerGroupRelationshipType
 	}
 }
@@ -18774,13 +20681,23 @@ fragment DefaultCustomerGroupRelationshipType on CustomerGroupRelationshipType {
 	name
 	description
 	metadata
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 `,
 		Variables: &__createCustomerGroupRelationshipTypeInput{
-			Token:       token,
-			Name:        name,
-			Description: description,
-			Metadata:    metadata,
+			Token:              token,
+			Name:               name,
+			Description:        description,
+			Metadata:           metadata,
+			Cardinality:        cardinality,
+			OnConflict:         onConflict,
+			AllowedTargetKinds: allowedTargetKinds,
+			AllowedSourceTypes: allowedSourceTypes,
+			AllowedTargetTypes: allowedTargetTypes,
 		},
 	}
 	var err error
@@ -18891,12 +20808,18 @@ func createCustomerRelationshipType(
 	name *string,
 	description *string,
 	metadata *string,
+	tracked *bool,
+	cardinality *string,
+	onConflict *string,
+	allowedTargetKinds []string,
+	allowedSourceTypes []string,
+	allowedTargetTypes []string,
 ) (*createCustomerRelationshipTypeResponse, error) {
 	req := &graphql.Request{
 		OpName: "createCustomerRelationshipType",
 		Query: `
-mutation createCustomerRelationshipType ($token: String!, $name: String, $description: String, $metadata: String) {
-	createCustomerRelationshipType(request: {token:$token,name:$name,description:$description,metadata:$metadata}) {
+mutation createCustomerRelationshipType ($token: String!, $name: String, $description: String, $metadata: String, $tracked: Boolean, $cardinality: String, $onConflict: String, $allowedTargetKinds: [String!], $allowedSourceTypes: [String!], $allowedTargetTypes: [String!]) {
+	createCustomerRelationshipType(request: {token:$token,name:$name,description:$description,metadata:$metadata,tracked:$tracked,cardinality:$cardinality,onConflict:$onConflict,allowedTargetKinds:$allowedTargetKinds,allowedSourceTypes:$allowedSourceTypes,allowedTargetTypes:$allowedTargetTypes}) {
 		... DefaultCustomerRelationshipType
 	}
 }
@@ -18909,13 +20832,25 @@ fragment DefaultCustomerRelationshipType on CustomerRelationshipType {
 	name
 	description
 	metadata
+	tracked
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 `,
 		Variables: &__createCustomerRelationshipTypeInput{
-			Token:       token,
-			Name:        name,
-			Description: description,
-			Metadata:    metadata,
+			Token:              token,
+			Name:               name,
+			Description:        description,
+			Metadata:           metadata,
+			Tracked:            tracked,
+			Cardinality:        cardinality,
+			OnConflict:         onConflict,
+			AllowedTargetKinds: allowedTargetKinds,
+			AllowedSourceTypes: allowedSourceTypes,
+			AllowedTargetTypes: allowedTargetTypes,
 		},
 	}
 	var err error
@@ -19004,13 +20939,16 @@ func createDevice(
 	deviceTypeToken string,
 	name *string,
 	description *string,
+	status *string,
 	metadata *string,
+	rateLimit *float64,
+	rateBurst *int,
 ) (*createDeviceResponse, error) {
 	req := &graphql.Request{
 		OpName: "createDevice",
 		Query: `
-mutation createDevice ($token: String!, $deviceTypeToken: String!, $name: String, $description: String, $metadata: String) {
-	createDevice(request: {token:$token,deviceTypeToken:$deviceTypeToken,name:$name,description:$description,metadata:$metadata}) {
+mutation createDevice ($token: String!, $deviceTypeToken: String!, $name: String, $description: String, $status: String, $metadata: String, $rateLimit: Float, $rateBurst: Int) {
+	createDevice(request: {token:$token,deviceTypeToken:$deviceTypeToken,name:$name,description:$description,status:$status,metadata:$metadata,rateLimit:$rateLimit,rateBurst:$rateBurst}) {
 		... DefaultDevice
 	}
 }
@@ -19027,7 +20965,10 @@ fragment DefaultDevice on Device {
 		name
 		description
 	}
+	status
 	metadata
+	rateLimit
+	rateBurst
 }
 `,
 		Variables: &__createDeviceInput{
@@ -19035,7 +20976,10 @@ fragment DefaultDevice on Device {
 			DeviceTypeToken: deviceTypeToken,
 			Name:            name,
 			Description:     description,
+			Status:          status,
 			Metadata:        metadata,
+			RateLimit:       rateLimit,
+			RateBurst:       rateBurst,
 		},
 	}
 	var err error
@@ -19210,12 +21154,17 @@ func createDeviceGroupRelationshipType(
 	name *string,
 	description *string,
 	metadata *string,
+	cardinality *string,
+	onConflict *string,
+	allowedTargetKinds []string,
+	allowedSourceTypes []string,
+	allowedTargetTypes []string,
 ) (*createDeviceGroupRelationshipTypeResponse, error) {
 	req := &graphql.Request{
 		OpName: "createDeviceGroupRelationshipType",
 		Query: `
-mutation createDeviceGroupRelationshipType ($token: String!, $name: String, $description: String, $metadata: String) {
-	createDeviceGroupRelationshipType(request: {token:$token,name:$name,description:$description,metadata:$metadata}) {
+mutation createDeviceGroupRelationshipType ($token: String!, $name: String, $description: String, $metadata: String, $cardinality: String, $onConflict: String, $allowedTargetKinds: [String!], $allowedSourceTypes: [String!], $allowedTargetTypes: [String!]) {
+	createDeviceGroupRelationshipType(request: {token:$token,name:$name,description:$description,metadata:$metadata,cardinality:$cardinality,onConflict:$onConflict,allowedTargetKinds:$allowedTargetKinds,allowedSourceTypes:$allowedSourceTypes,allowedTargetTypes:$allowedTargetTypes}) {
 		... DefaultDeviceGroupRelationshipType
 	}
 }
@@ -19228,13 +21177,23 @@ fragment DefaultDeviceGroupRelationshipType on DeviceGroupRelationshipType {
 	name
 	description
 	metadata
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 `,
 		Variables: &__createDeviceGroupRelationshipTypeInput{
-			Token:       token,
-			Name:        name,
-			Description: description,
-			Metadata:    metadata,
+			Token:              token,
+			Name:               name,
+			Description:        description,
+			Metadata:           metadata,
+			Cardinality:        cardinality,
+			OnConflict:         onConflict,
+			AllowedTargetKinds: allowedTargetKinds,
+			AllowedSourceTypes: allowedSourceTypes,
+			AllowedTargetTypes: allowedTargetTypes,
 		},
 	}
 	var err error
@@ -19346,12 +21305,19 @@ func createDeviceRelationshipType(
 	description *string,
 	metadata *string,
 	tracked bool,
+	enriched *bool,
+	enrichedMetadataKeys []string,
+	cardinality *string,
+	onConflict *string,
+	allowedTargetKinds []string,
+	allowedSourceTypes []string,
+	allowedTargetTypes []string,
 ) (*createDeviceRelationshipTypeResponse, error) {
 	req := &graphql.Request{
 		OpName: "createDeviceRelationshipType",
 		Query: `
-mutation createDeviceRelationshipType ($token: String!, $name: String, $description: String, $metadata: String, $tracked: Boolean!) {
-	createDeviceRelationshipType(request: {token:$token,name:$name,description:$description,metadata:$metadata,tracked:$tracked}) {
+mutation createDeviceRelationshipType ($token: String!, $name: String, $description: String, $metadata: String, $tracked: Boolean!, $enriched: Boolean, $enrichedMetadataKeys: [String!], $cardinality: String, $onConflict: String, $allowedTargetKinds: [String!], $allowedSourceTypes: [String!], $allowedTargetTypes: [String!]) {
+	createDeviceRelationshipType(request: {token:$token,name:$name,description:$description,metadata:$metadata,tracked:$tracked,enriched:$enriched,enrichedMetadataKeys:$enrichedMetadataKeys,cardinality:$cardinality,onConflict:$onConflict,allowedTargetKinds:$allowedTargetKinds,allowedSourceTypes:$allowedSourceTypes,allowedTargetTypes:$allowedTargetTypes}) {
 		... DefaultDeviceRelationshipType
 	}
 }
@@ -19365,14 +21331,28 @@ fragment DefaultDeviceRelationshipType on DeviceRelationshipType {
 	description
 	metadata
 	tracked
+	enriched
+	enrichedMetadataKeys
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 `,
 		Variables: &__createDeviceRelationshipTypeInput{
-			Token:       token,
-			Name:        name,
-			Description: description,
-			Metadata:    metadata,
-			Tracked:     tracked,
+			Token:                token,
+			Name:                 name,
+			Description:          description,
+			Metadata:             metadata,
+			Tracked:              tracked,
+			Enriched:             enriched,
+			EnrichedMetadataKeys: enrichedMetadataKeys,
+			Cardinality:          cardinality,
+			OnConflict:           onConflict,
+			AllowedTargetKinds:   allowedTargetKinds,
+			AllowedSourceTypes:   allowedSourceTypes,
+			AllowedTargetTypes:   allowedTargetTypes,
 		},
 	}
 	var err error
@@ -19402,12 +21382,14 @@ func createDeviceType(
 	foregroundColor *string,
 	borderColor *string,
 	metadata *string,
+	rateLimit *float64,
+	rateBurst *int,
 ) (*createDeviceTypeResponse, error) {
 	req := &graphql.Request{
 		OpName: "createDeviceType",
 		Query: `
-mutation createDeviceType ($token: String!, $name: String, $description: String, $imageUrl: String, $icon: String, $backgroundColor: String, $foregroundColor: String, $borderColor: String, $metadata: String) {
-	createDeviceType(request: {token:$token,name:$name,description:$description,imageUrl:$imageUrl,icon:$icon,backgroundColor:$backgroundColor,foregroundColor:$foregroundColor,borderColor:$borderColor,metadata:$metadata}) {
+mutation createDeviceType ($token: String!, $name: String, $description: String, $imageUrl: String, $icon: String, $backgroundColor: String, $foregroundColor: String, $borderColor: String, $metadata: String, $rateLimit: Float, $rateBurst: Int) {
+	createDeviceType(request: {token:$token,name:$name,description:$description,imageUrl:$imageUrl,icon:$icon,backgroundColor:$backgroundColor,foregroundColor:$foregroundColor,borderColor:$borderColor,metadata:$metadata,rateLimit:$rateLimit,rateBurst:$rateBurst}) {
 		... DefaultDeviceType
 	}
 }
@@ -19425,6 +21407,8 @@ fragment DefaultDeviceType on DeviceType {
 	foregroundColor
 	borderColor
 	metadata
+	rateLimit
+	rateBurst
 }
 `,
 		Variables: &__createDeviceTypeInput{
@@ -19437,6 +21421,8 @@ fragment DefaultDeviceType on DeviceType {
 			ForegroundColor: foregroundColor,
 			BorderColor:     borderColor,
 			Metadata:        metadata,
+			RateLimit:       rateLimit,
+			RateBurst:       rateBurst,
 		},
 	}
 	var err error
@@ -19476,6 +21462,11 @@ fragment DefaultAreaGroupRelationshipType on AreaGroupRelationshipType {
 	name
 	description
 	metadata
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 `,
 		Variables: &__getAreaGroupRelationshipTypesByTokenInput{
@@ -19647,6 +21638,12 @@ fragment DefaultAreaRelationshipType on AreaRelationshipType {
 	name
 	description
 	metadata
+	tracked
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 `,
 		Variables: &__getAreaRelationshipTypesByTokenInput{
@@ -19866,6 +21863,11 @@ fragment DefaultAssetGroupRelationshipType on AssetGroupRelationshipType {
 	name
 	description
 	metadata
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 `,
 		Variables: &__getAssetGroupRelationshipTypesByTokenInput{
@@ -20037,6 +22039,12 @@ fragment DefaultAssetRelationshipType on AssetRelationshipType {
 	name
 	description
 	metadata
+	tracked
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 `,
 		Variables: &__getAssetRelationshipTypesByTokenInput{
@@ -20256,6 +22264,11 @@ fragment DefaultCustomerGroupRelationshipType on CustomerGroupRelationshipType {
 	name
 	description
 	metadata
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 `,
 		Variables: &__getCustomerGroupRelationshipTypesByTokenInput{
@@ -20427,6 +22440,12 @@ fragment DefaultCustomerRelationshipType on CustomerRelationshipType {
 	name
 	description
 	metadata
+	tracked
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 `,
 		Variables: &__getCustomerRelationshipTypesByTokenInput{
@@ -20646,6 +22665,11 @@ fragment DefaultDeviceGroupRelationshipType on DeviceGroupRelationshipType {
 	name
 	description
 	metadata
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 `,
 		Variables: &__getDeviceGroupRelationshipTypesByTokenInput{
@@ -20818,6 +22842,13 @@ fragment DefaultDeviceRelationshipType on DeviceRelationshipType {
 	description
 	metadata
 	tracked
+	enriched
+	enrichedMetadataKeys
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 `,
 		Variables: &__getDeviceRelationshipTypesByTokenInput{
@@ -20946,6 +22977,8 @@ fragment DefaultDeviceType on DeviceType {
 	foregroundColor
 	borderColor
 	metadata
+	rateLimit
+	rateBurst
 }
 `,
 		Variables: &__getDeviceTypesByTokenInput{
@@ -20993,7 +23026,10 @@ fragment DefaultDevice on Device {
 		name
 		description
 	}
+	status
 	metadata
+	rateLimit
+	rateBurst
 }
 `,
 		Variables: &__getDevicesByTokenInput{
@@ -21043,6 +23079,11 @@ fragment DefaultAreaGroupRelationshipType on AreaGroupRelationshipType {
 	name
 	description
 	metadata
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 fragment DefaultPagination on SearchResultsPagination {
 	pageStart
@@ -21254,6 +23295,12 @@ fragment DefaultAreaRelationshipType on AreaRelationshipType {
 	name
 	description
 	metadata
+	tracked
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 fragment DefaultPagination on SearchResultsPagination {
 	pageStart
@@ -21525,6 +23572,11 @@ fragment DefaultAssetGroupRelationshipType on AssetGroupRelationshipType {
 	name
 	description
 	metadata
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 fragment DefaultPagination on SearchResultsPagination {
 	pageStart
@@ -21736,6 +23788,12 @@ fragment DefaultAssetRelationshipType on AssetRelationshipType {
 	name
 	description
 	metadata
+	tracked
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 fragment DefaultPagination on SearchResultsPagination {
 	pageStart
@@ -22007,6 +24065,11 @@ fragment DefaultCustomerGroupRelationshipType on CustomerGroupRelationshipType {
 	name
 	description
 	metadata
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 fragment DefaultPagination on SearchResultsPagination {
 	pageStart
@@ -22218,6 +24281,12 @@ fragment DefaultCustomerRelationshipType on CustomerRelationshipType {
 	name
 	description
 	metadata
+	tracked
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 fragment DefaultPagination on SearchResultsPagination {
 	pageStart
@@ -22489,6 +24558,11 @@ fragment DefaultDeviceGroupRelationshipType on DeviceGroupRelationshipType {
 	name
 	description
 	metadata
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 fragment DefaultPagination on SearchResultsPagination {
 	pageStart
@@ -22701,6 +24775,13 @@ fragment DefaultDeviceRelationshipType on DeviceRelationshipType {
 	description
 	metadata
 	tracked
+	enriched
+	enrichedMetadataKeys
+	cardinality
+	onConflict
+	allowedTargetKinds
+	allowedSourceTypes
+	allowedTargetTypes
 }
 fragment DefaultPagination on SearchResultsPagination {
 	pageStart
@@ -22859,6 +24940,8 @@ fragment DefaultDeviceType on DeviceType {
 	foregroundColor
 	borderColor
 	metadata
+	rateLimit
+	rateBurst
 }
 fragment DefaultPagination on SearchResultsPagination {
 	pageStart
@@ -22918,7 +25001,10 @@ fragment DefaultDevice on Device {
 		name
 		description
 	}
+	status
 	metadata
+	rateLimit
+	rateBurst
 }
 fragment DefaultPagination on SearchResultsPagination {
 	pageStart
@@ -22944,3 +25030,54 @@ fragment DefaultPagination on SearchResultsPagination {
 
 	return &data, err
 }
+
+// Verify authentication material presented by a device.
+func verifyDeviceCredential(
+	ctx context.Context,
+	client graphql.Client,
+	device *string,
+	credentialType string,
+	value string,
+) (*verifyDeviceCredentialResponse, error) {
+	req := &graphql.Request{
+		OpName: "verifyDeviceCredential",
+		Query: `
+query verifyDeviceCredential ($device: String, $credentialType: String!, $value: String!) {
+	verifyDeviceCredential(request: {device:$device,credentialType:$credentialType,value:$value}) {
+		... DefaultDeviceCredentialVerification
+	}
+}
+fragment DefaultDeviceCredentialVerification on DeviceCredentialVerification {
+	valid
+	reason
+	device {
+		token
+		name
+		description
+	}
+	credential {
+		token
+		credentialType
+		expiresAt
+	}
+}
+`,
+		Variables: &__verifyDeviceCredentialInput{
+			Device:         device,
+			CredentialType: credentialType,
+			Value:          value,
+		},
+	}
+	var err error
+
+	var data verifyDeviceCredentialResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
@@ -40,6 +40,8 @@ type IAreaRelationshipType interface {
 	ITokenReference
 	INamedEntity
 	IMetadataEntity
+	GetTracked() bool
+	IRelationshipConstraints
 }
 
 // Area relationship entity.
@@ -67,6 +69,7 @@ type IAreaGroupRelationshipType interface {
 	ITokenReference
 	INamedEntity
 	IMetadataEntity
+	IRelationshipConstraints
 }
 
 // Area group relationship entity.
@@ -40,6 +40,8 @@ type IAssetRelationshipType interface {
 	ITokenReference
 	INamedEntity
 	IMetadataEntity
+	GetTracked() bool
+	IRelationshipConstraints
 }
 
 // Asset relationship entity.
@@ -67,6 +69,7 @@ type IAssetGroupRelationshipType interface {
 	ITokenReference
 	INamedEntity
 	IMetadataEntity
+	IRelationshipConstraints
 }
 
 // Asset group relationship entity.
@@ -48,3 +48,12 @@ type IBrandedEntity interface {
 type IMetadataEntity interface {
 	GetMetadata() *string
 }
+
+// Relationship type with cardinality and allowed entity constraints.
+type IRelationshipConstraints interface {
+	GetCardinality() string
+	GetOnConflict() string
+	GetAllowedTargetKinds() []string
+	GetAllowedSourceTypes() []string
+	GetAllowedTargetTypes() []string
+}
@@ -40,6 +40,8 @@ type ICustomerRelationshipType interface {
 	ITokenReference
 	INamedEntity
 	IMetadataEntity
+	GetTracked() bool
+	IRelationshipConstraints
 }
 
 // Customer relationship entity.
@@ -67,6 +69,7 @@ type ICustomerGroupRelationshipType interface {
 	ITokenReference
 	INamedEntity
 	IMetadataEntity
+	IRelationshipConstraints
 }
 
 // Customer group relationship entity.
@@ -23,6 +23,8 @@ type IDeviceType interface {
 	INamedEntity
 	IBrandedEntity
 	IMetadataEntity
+	GetRateLimit() *float64
+	GetRateBurst() *int
 }
 
 // Device entity.
@@ -32,6 +34,9 @@ type IDevice interface {
 	INamedEntity
 	IMetadataEntity
 	GetDeviceType() DefaultDeviceDeviceType
+	GetStatus() string
+	GetRateLimit() *float64
+	GetRateBurst() *int
 }
 
 // Device relationship type entity.
@@ -40,6 +45,9 @@ type IDeviceRelationshipType interface {
 	ITokenReference
 	INamedEntity
 	IMetadataEntity
+	GetEnriched() bool
+	GetEnrichedMetadataKeys() []string
+	IRelationshipConstraints
 }
 
 // Device relationship entity.
@@ -67,6 +75,7 @@ type IDeviceGroupRelationshipType interface {
 	ITokenReference
 	INamedEntity
 	IMetadataEntity
+	IRelationshipConstraints
 }
 
 // Device group relationship entity.
@@ -78,3 +87,11 @@ type IDeviceGroupRelationship interface {
 	GetTargets() DefaultDeviceGroupRelationshipTargetsEntityRelationshipTargets
 	GetRelationshipType() DefaultDeviceGroupRelationshipRelationshipTypeDeviceGroupRelationshipType
 }
+
+// Result of verifying a device credential.
+type IDeviceCredentialVerification interface {
+	GetValid() bool
+	GetReason() *string
+	GetDevice() *DefaultDeviceCredentialVerificationDevice
+	GetCredential() *DefaultDeviceCredentialVerificationCredentialDeviceCredential
+}
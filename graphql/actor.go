@@ -0,0 +1,52 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/devicechain-io/dc-device-management/model"
+)
+
+const (
+	ACTOR_HEADER = "X-DeviceChain-Actor" // Header set by the gateway with the authenticated user
+)
+
+// Handler that records the actor named in a request so that changes made by the request are
+// attributed to it in the audit log. The gateway is trusted to set the header from the
+// authenticated user and to drop any value supplied by the client.
+type ActorHandler struct {
+	Next http.Handler
+}
+
+// Create a new actor handler wrapping the given handler.
+func NewActorHandler(next http.Handler) *ActorHandler {
+	return &ActorHandler{
+		Next: next,
+	}
+}
+
+// Add the actor to the request context before passing the request on.
+func (h *ActorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	actor := strings.TrimSpace(r.Header.Get(ACTOR_HEADER))
+	if actor != "" {
+		r = r.WithContext(context.WithValue(r.Context(), model.ContextActorKey, actor))
+	}
+	h.Next.ServeHTTP(w, r)
+}
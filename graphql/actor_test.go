@@ -0,0 +1,67 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ActorTestSuite struct {
+	suite.Suite
+	Actor   string
+	Handler *ActorHandler
+}
+
+// Perform common setup tasks.
+func (suite *ActorTestSuite) SetupTest() {
+	suite.Actor = ""
+	suite.Handler = NewActorHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Actor = model.ActorFromContext(r.Context())
+	}))
+}
+
+// Send a request with the given actor header value.
+func (suite *ActorTestSuite) send(actor string) {
+	req := httptest.NewRequest(http.MethodPost, "/graphql", nil)
+	if actor != "" {
+		req.Header.Set(ACTOR_HEADER, actor)
+	}
+	suite.Handler.ServeHTTP(httptest.NewRecorder(), req)
+}
+
+// Test actor from header is available to the wrapped handler.
+func (suite *ActorTestSuite) TestActorFromHeader() {
+	suite.send(" jane@example.com ")
+	assert.Equal(suite.T(), "jane@example.com", suite.Actor)
+}
+
+// Test requests without an actor are attributed to the system.
+func (suite *ActorTestSuite) TestNoActor() {
+	suite.send("")
+	assert.Equal(suite.T(), model.AUDIT_SYSTEM_ACTOR, suite.Actor)
+}
+
+// Run all tests.
+func TestActorTestSuite(t *testing.T) {
+	suite.Run(t, new(ActorTestSuite))
+}
@@ -18,10 +18,28 @@ package graphql
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/devicechain-io/dc-microservice/rdb"
 )
 
+// Convert nullable float to optional value.
+func nullFloat(val sql.NullFloat64) *float64 {
+	if !val.Valid {
+		return nil
+	}
+	return &val.Float64
+}
+
+// Convert nullable integer to optional value.
+func nullInt32(val sql.NullInt64) *int32 {
+	if !val.Valid {
+		return nil
+	}
+	result := int32(val.Int64)
+	return &result
+}
+
 type SearchResultsPaginationResolver struct {
 	M rdb.SearchResultsPagination
 	S *SchemaResolver
@@ -0,0 +1,62 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"encoding/pem"
+	"net/http"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	CRL_PATH = "/crl" // Path at which the certificate revocation list is published
+)
+
+// Handler that publishes the certificate revocation list for the tenant certificate authority.
+type CrlHandler struct {
+	Api *model.Api
+}
+
+// Create a new CRL handler.
+func NewCrlHandler(api *model.Api) *CrlHandler {
+	return &CrlHandler{
+		Api: api,
+	}
+}
+
+// Serve the current CRL. DER encoding is used unless PEM is requested via the format parameter.
+func (h *CrlHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	crl, err := h.Api.CertificateRevocationList(r.Context())
+	if err != nil {
+		log.Error().Err(err).Msg("Unable to build certificate revocation list.")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if r.URL.Query().Get("format") == "pem" {
+		w.Header().Set("Content-Type", "application/x-pem-file")
+		w.Write(pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crl}))
+		return
+	}
+	w.Header().Set("Content-Type", "application/pkix-crl")
+	w.Write(crl)
+}
@@ -0,0 +1,116 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/devicechain-io/dc-device-management/model"
+)
+
+// Acknowledge an open alert.
+func (r *SchemaResolver) AcknowledgeAlert(ctx context.Context, args struct {
+	Token   string
+	Request *model.AlertStateChangeRequest
+}) (*AlertResolver, error) {
+	api := r.GetApi(ctx)
+	updated, err := api.AcknowledgeAlert(ctx, args.Token, args.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := &AlertResolver{
+		M: *updated,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
+
+// Resolve an open or acknowledged alert.
+func (r *SchemaResolver) ResolveAlert(ctx context.Context, args struct {
+	Token   string
+	Request *model.AlertStateChangeRequest
+}) (*AlertResolver, error) {
+	api := r.GetApi(ctx)
+	updated, err := api.ResolveAlert(ctx, args.Token, args.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := &AlertResolver{
+		M: *updated,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
+
+// Create a new alert suppression window.
+func (r *SchemaResolver) CreateAlertSuppression(ctx context.Context, args struct {
+	Request *model.AlertSuppressionCreateRequest
+}) (*AlertSuppressionResolver, error) {
+	api := r.GetApi(ctx)
+	created, err := api.CreateAlertSuppression(ctx, args.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := &AlertSuppressionResolver{
+		M: *created,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
+
+// Update an existing alert suppression window.
+func (r *SchemaResolver) UpdateAlertSuppression(ctx context.Context, args struct {
+	Token   string
+	Request *model.AlertSuppressionCreateRequest
+}) (*AlertSuppressionResolver, error) {
+	api := r.GetApi(ctx)
+	updated, err := api.UpdateAlertSuppression(ctx, args.Token, args.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := &AlertSuppressionResolver{
+		M: *updated,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
+
+// Delete an existing alert suppression window.
+func (r *SchemaResolver) DeleteAlertSuppression(ctx context.Context, args struct {
+	Token string
+}) (*AlertSuppressionResolver, error) {
+	api := r.GetApi(ctx)
+	deleted, err := api.DeleteAlertSuppression(ctx, args.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := &AlertSuppressionResolver{
+		M: *deleted,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
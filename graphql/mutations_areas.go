@@ -105,6 +105,7 @@ func (r *SchemaResolver) CreateAreaRelationshipType(ctx context.Context, args st
 	if err != nil {
 		return nil, err
 	}
+	r.reloadTrackedRelationships(ctx)
 
 	dt := &AreaRelationshipTypeResolver{
 		M: *created,
@@ -124,6 +125,7 @@ func (r *SchemaResolver) UpdateAreaRelationshipType(ctx context.Context, args st
 	if err != nil {
 		return nil, err
 	}
+	r.reloadTrackedRelationships(ctx)
 
 	dt := &AreaRelationshipTypeResolver{
 		M: *updated,
@@ -142,6 +144,7 @@ func (r *SchemaResolver) CreateAreaRelationship(ctx context.Context, args struct
 	if err != nil {
 		return nil, err
 	}
+	r.reloadTrackedRelationships(ctx)
 
 	dt := &AreaRelationshipResolver{
 		M: *created,
@@ -105,6 +105,7 @@ func (r *SchemaResolver) CreateAssetRelationshipType(ctx context.Context, args s
 	if err != nil {
 		return nil, err
 	}
+	r.reloadTrackedRelationships(ctx)
 
 	dt := &AssetRelationshipTypeResolver{
 		M: *created,
@@ -124,6 +125,7 @@ func (r *SchemaResolver) UpdateAssetRelationshipType(ctx context.Context, args s
 	if err != nil {
 		return nil, err
 	}
+	r.reloadTrackedRelationships(ctx)
 
 	dt := &AssetRelationshipTypeResolver{
 		M: *updated,
@@ -142,6 +144,7 @@ func (r *SchemaResolver) CreateAssetRelationship(ctx context.Context, args struc
 	if err != nil {
 		return nil, err
 	}
+	r.reloadTrackedRelationships(ctx)
 
 	dt := &AssetRelationshipResolver{
 		M: *created,
@@ -0,0 +1,60 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/devicechain-io/dc-device-management/model"
+)
+
+// Issue a device certificate from a certificate signing request.
+func (r *SchemaResolver) SignDeviceCsr(ctx context.Context, args struct {
+	Request *model.DeviceCsrSignRequest
+}) (*DeviceCertificateResolver, error) {
+	api := r.GetApi(ctx)
+	created, err := api.SignDeviceCsr(ctx, args.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := &DeviceCertificateResolver{
+		M: *created,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
+
+// Revoke a device certificate.
+func (r *SchemaResolver) RevokeDeviceCertificate(ctx context.Context, args struct {
+	SerialNumber string
+	Reason       *string
+}) (*DeviceCertificateResolver, error) {
+	api := r.GetApi(ctx)
+	updated, err := api.RevokeDeviceCertificate(ctx, args.SerialNumber, args.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := &DeviceCertificateResolver{
+		M: *updated,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
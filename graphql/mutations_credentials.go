@@ -0,0 +1,78 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/devicechain-io/dc-device-management/model"
+)
+
+// Create a new device credential.
+func (r *SchemaResolver) CreateDeviceCredential(ctx context.Context, args struct {
+	Request *model.DeviceCredentialCreateRequest
+}) (*IssuedDeviceCredentialResolver, error) {
+	api := r.GetApi(ctx)
+	issued, err := api.CreateDeviceCredential(ctx, args.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := &IssuedDeviceCredentialResolver{
+		M: *issued,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
+
+// Rotate an existing device credential.
+func (r *SchemaResolver) RotateDeviceCredential(ctx context.Context, args struct {
+	Token   string
+	Request *model.DeviceCredentialRotateRequest
+}) (*IssuedDeviceCredentialResolver, error) {
+	api := r.GetApi(ctx)
+	issued, err := api.RotateDeviceCredential(ctx, args.Token, args.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := &IssuedDeviceCredentialResolver{
+		M: *issued,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
+
+// Revoke a device credential.
+func (r *SchemaResolver) RevokeDeviceCredential(ctx context.Context, args struct {
+	Token string
+}) (*DeviceCredentialResolver, error) {
+	api := r.GetApi(ctx)
+	updated, err := api.RevokeDeviceCredential(ctx, args.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := &DeviceCredentialResolver{
+		M: *updated,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
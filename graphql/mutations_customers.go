@@ -105,6 +105,7 @@ func (r *SchemaResolver) CreateCustomerRelationshipType(ctx context.Context, arg
 	if err != nil {
 		return nil, err
 	}
+	r.reloadTrackedRelationships(ctx)
 
 	dt := &CustomerRelationshipTypeResolver{
 		M: *created,
@@ -124,6 +125,7 @@ func (r *SchemaResolver) UpdateCustomerRelationshipType(ctx context.Context, arg
 	if err != nil {
 		return nil, err
 	}
+	r.reloadTrackedRelationships(ctx)
 
 	dt := &CustomerRelationshipTypeResolver{
 		M: *updated,
@@ -142,6 +144,7 @@ func (r *SchemaResolver) CreateCustomerRelationship(ctx context.Context, args st
 	if err != nil {
 		return nil, err
 	}
+	r.reloadTrackedRelationships(ctx)
 
 	dt := &CustomerRelationshipResolver{
 		M: *created,
@@ -0,0 +1,46 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/devicechain-io/dc-device-management/model"
+)
+
+// Start replaying failed events that match the request.
+func (r *SchemaResolver) ReplayFailedEvents(ctx context.Context, args struct {
+	Request *model.FailedEventReplayRequest
+}) (*FailedEventReplayJobResolver, error) {
+	fproc := r.GetFailedEventsProcessor(ctx)
+	if fproc == nil {
+		return nil, errors.New("failed events processor is not available to replay events")
+	}
+
+	job, err := fproc.StartReplay(ctx, args.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := &FailedEventReplayJobResolver{
+		M: *job,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
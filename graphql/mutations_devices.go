@@ -96,6 +96,24 @@ func (r *SchemaResolver) UpdateDevice(ctx context.Context, args struct {
 	return dt, nil
 }
 
+// Delete an existing device.
+func (r *SchemaResolver) DeleteDevice(ctx context.Context, args struct {
+	Token string
+}) (*DeviceResolver, error) {
+	api := r.GetApi(ctx)
+	deleted, err := api.DeleteDevice(ctx, args.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := &DeviceResolver{
+		M: *deleted,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
+
 // Create a new device relationship type.
 func (r *SchemaResolver) CreateDeviceRelationshipType(ctx context.Context, args struct {
 	Request *model.DeviceRelationshipTypeCreateRequest
@@ -0,0 +1,81 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/devicechain-io/dc-device-management/model"
+)
+
+// Approve a pending device.
+func (r *SchemaResolver) ApprovePendingDevice(ctx context.Context, args struct {
+	Token   string
+	Request *model.PendingDeviceApprovalRequest
+}) (*DeviceResolver, error) {
+	// Verify events can be replayed before approving.
+	iproc := r.GetInboundEventsProcessor(ctx)
+	if args.Request.ReplayEvents && iproc == nil {
+		return nil, errors.New("inbound events processor is not available to replay events")
+	}
+
+	api := r.GetApi(ctx)
+	approval, err := api.ApprovePendingDevice(ctx, args.Token, args.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	// Requeue events held while device was pending. Held events are only removed once they
+	// have been written, so approving again retries a failed requeue.
+	if len(approval.HeldEvents) > 0 {
+		err = iproc.RequeueEvents(ctx, approval.HeldEvents)
+		if err != nil {
+			return nil, fmt.Errorf("device was approved but held events could not be requeued: %w", err)
+		}
+		err = api.ReleasePendingDevice(ctx, approval.PendingDevice)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	dt := &DeviceResolver{
+		M: *approval.Device,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
+
+// Reject a pending device.
+func (r *SchemaResolver) RejectPendingDevice(ctx context.Context, args struct {
+	Token string
+}) (*PendingDeviceResolver, error) {
+	api := r.GetApi(ctx)
+	updated, err := api.RejectPendingDevice(ctx, args.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	dt := &PendingDeviceResolver{
+		M: *updated,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
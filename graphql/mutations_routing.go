@@ -0,0 +1,95 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	"github.com/rs/zerolog/log"
+)
+
+// Reload routing rules so that changes apply to events processed by this instance right away.
+// Other instances pick up changes when they next reload.
+func (r *SchemaResolver) reloadEventRoutingRules(ctx context.Context) {
+	router := r.GetEventRouter(ctx)
+	if router == nil {
+		return
+	}
+	err := router.Reload(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to reload event routing rules")
+	}
+}
+
+// Create a new event routing rule.
+func (r *SchemaResolver) CreateEventRoutingRule(ctx context.Context, args struct {
+	Request *model.EventRoutingRuleCreateRequest
+}) (*EventRoutingRuleResolver, error) {
+	api := r.GetApi(ctx)
+	created, err := api.CreateEventRoutingRule(ctx, args.Request)
+	if err != nil {
+		return nil, err
+	}
+	r.reloadEventRoutingRules(ctx)
+
+	dt := &EventRoutingRuleResolver{
+		M: *created,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
+
+// Update an existing event routing rule.
+func (r *SchemaResolver) UpdateEventRoutingRule(ctx context.Context, args struct {
+	Token   string
+	Request *model.EventRoutingRuleCreateRequest
+}) (*EventRoutingRuleResolver, error) {
+	api := r.GetApi(ctx)
+	updated, err := api.UpdateEventRoutingRule(ctx, args.Token, args.Request)
+	if err != nil {
+		return nil, err
+	}
+	r.reloadEventRoutingRules(ctx)
+
+	dt := &EventRoutingRuleResolver{
+		M: *updated,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
+
+// Delete an existing event routing rule.
+func (r *SchemaResolver) DeleteEventRoutingRule(ctx context.Context, args struct {
+	Token string
+}) (*EventRoutingRuleResolver, error) {
+	api := r.GetApi(ctx)
+	deleted, err := api.DeleteEventRoutingRule(ctx, args.Token)
+	if err != nil {
+		return nil, err
+	}
+	r.reloadEventRoutingRules(ctx)
+
+	dt := &EventRoutingRuleResolver{
+		M: *deleted,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
@@ -0,0 +1,95 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	"github.com/rs/zerolog/log"
+)
+
+// Reload transforms so that changes apply to events processed by this instance right away.
+// Other instances pick up changes when they next reload.
+func (r *SchemaResolver) reloadEventTransforms(ctx context.Context) {
+	xformer := r.GetEventTransformer(ctx)
+	if xformer == nil {
+		return
+	}
+	err := xformer.Reload(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to reload event transforms")
+	}
+}
+
+// Create a new event transform.
+func (r *SchemaResolver) CreateEventTransform(ctx context.Context, args struct {
+	Request *model.EventTransformCreateRequest
+}) (*EventTransformResolver, error) {
+	api := r.GetApi(ctx)
+	created, err := api.CreateEventTransform(ctx, args.Request)
+	if err != nil {
+		return nil, err
+	}
+	r.reloadEventTransforms(ctx)
+
+	dt := &EventTransformResolver{
+		M: *created,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
+
+// Update an existing event transform.
+func (r *SchemaResolver) UpdateEventTransform(ctx context.Context, args struct {
+	Token   string
+	Request *model.EventTransformCreateRequest
+}) (*EventTransformResolver, error) {
+	api := r.GetApi(ctx)
+	updated, err := api.UpdateEventTransform(ctx, args.Token, args.Request)
+	if err != nil {
+		return nil, err
+	}
+	r.reloadEventTransforms(ctx)
+
+	dt := &EventTransformResolver{
+		M: *updated,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
+
+// Delete an existing event transform.
+func (r *SchemaResolver) DeleteEventTransform(ctx context.Context, args struct {
+	Token string
+}) (*EventTransformResolver, error) {
+	api := r.GetApi(ctx)
+	deleted, err := api.DeleteEventTransform(ctx, args.Token)
+	if err != nil {
+		return nil, err
+	}
+	r.reloadEventTransforms(ctx)
+
+	dt := &EventTransformResolver{
+		M: *deleted,
+		S: r,
+		C: ctx,
+	}
+	return dt, nil
+}
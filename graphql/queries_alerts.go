@@ -0,0 +1,127 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/devicechain-io/dc-device-management/model"
+)
+
+// Find alerts by unique id.
+func (r *SchemaResolver) AlertsById(ctx context.Context, args struct {
+	Ids []string
+}) ([]*AlertResolver, error) {
+	api := r.GetApi(ctx)
+	ids, err := r.asUintIds(args.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := api.AlertsById(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*AlertResolver, 0)
+	for _, alert := range found {
+		result = append(result, &AlertResolver{
+			M: *alert,
+			S: r,
+			C: ctx,
+		})
+	}
+	return result, nil
+}
+
+// Find alerts by unique token.
+func (r *SchemaResolver) AlertsByToken(ctx context.Context, args struct {
+	Tokens []string
+}) ([]*AlertResolver, error) {
+	api := r.GetApi(ctx)
+	found, err := api.AlertsByToken(ctx, args.Tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*AlertResolver, 0)
+	for _, alert := range found {
+		result = append(result, &AlertResolver{
+			M: *alert,
+			S: r,
+			C: ctx,
+		})
+	}
+	return result, nil
+}
+
+// List alerts that have not been resolved and match the given criteria.
+func (r *SchemaResolver) ActiveAlerts(ctx context.Context, args struct {
+	Criteria model.AlertSearchCriteria
+}) (*AlertSearchResultsResolver, error) {
+	api := r.GetApi(ctx)
+	found, err := api.ActiveAlerts(ctx, args.Criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return as resolver.
+	return &AlertSearchResultsResolver{
+		M: *found,
+		S: r,
+		C: ctx,
+	}, nil
+}
+
+// Find alert suppression windows by unique token.
+func (r *SchemaResolver) AlertSuppressionsByToken(ctx context.Context, args struct {
+	Tokens []string
+}) ([]*AlertSuppressionResolver, error) {
+	api := r.GetApi(ctx)
+	found, err := api.AlertSuppressionsByToken(ctx, args.Tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*AlertSuppressionResolver, 0)
+	for _, suppression := range found {
+		result = append(result, &AlertSuppressionResolver{
+			M: *suppression,
+			S: r,
+			C: ctx,
+		})
+	}
+	return result, nil
+}
+
+// List alert suppression windows that match the given criteria.
+func (r *SchemaResolver) AlertSuppressions(ctx context.Context, args struct {
+	Criteria model.AlertSuppressionSearchCriteria
+}) (*AlertSuppressionSearchResultsResolver, error) {
+	api := r.GetApi(ctx)
+	found, err := api.AlertSuppressions(ctx, args.Criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return as resolver.
+	return &AlertSuppressionSearchResultsResolver{
+		M: *found,
+		S: r,
+		C: ctx,
+	}, nil
+}
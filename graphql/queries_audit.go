@@ -0,0 +1,41 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/devicechain-io/dc-device-management/model"
+)
+
+// List audit log entries that match the given criteria.
+func (r *SchemaResolver) AuditLog(ctx context.Context, args struct {
+	Criteria model.AuditEntrySearchCriteria
+}) (*AuditEntrySearchResultsResolver, error) {
+	api := r.GetApi(ctx)
+	found, err := api.AuditLog(ctx, args.Criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return as resolver.
+	return &AuditEntrySearchResultsResolver{
+		M: *found,
+		S: r,
+		C: ctx,
+	}, nil
+}
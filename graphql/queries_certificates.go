@@ -0,0 +1,73 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/devicechain-io/dc-device-management/model"
+)
+
+// Get the PEM encoded certificate of the tenant certificate authority.
+func (r *SchemaResolver) DeviceCertificateAuthority(ctx context.Context) (string, error) {
+	api := r.GetApi(ctx)
+	ca, err := api.CertificateAuthority(ctx)
+	if err != nil {
+		return "", err
+	}
+	return ca.Certificate, nil
+}
+
+// Find device certificates by serial number.
+func (r *SchemaResolver) DeviceCertificatesBySerialNumber(ctx context.Context, args struct {
+	SerialNumbers []string
+}) ([]*DeviceCertificateResolver, error) {
+	api := r.GetApi(ctx)
+	found, err := api.DeviceCertificatesBySerialNumber(ctx, args.SerialNumbers)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*DeviceCertificateResolver, 0)
+	for _, current := range found {
+		resolvers = append(resolvers,
+			&DeviceCertificateResolver{
+				M: *current,
+				S: r,
+				C: ctx,
+			})
+	}
+	return resolvers, nil
+}
+
+// List all device certificates that match the given criteria.
+func (r *SchemaResolver) DeviceCertificates(ctx context.Context, args struct {
+	Criteria model.DeviceCertificateSearchCriteria
+}) (*DeviceCertificateSearchResultsResolver, error) {
+	api := r.GetApi(ctx)
+	found, err := api.DeviceCertificates(ctx, args.Criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return as resolver.
+	return &DeviceCertificateSearchResultsResolver{
+		M: *found,
+		S: r,
+		C: ctx,
+	}, nil
+}
@@ -0,0 +1,80 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/devicechain-io/dc-device-management/model"
+)
+
+// Find device credentials by unique token.
+func (r *SchemaResolver) DeviceCredentialsByToken(ctx context.Context, args struct {
+	Tokens []string
+}) ([]*DeviceCredentialResolver, error) {
+	api := r.GetApi(ctx)
+	found, err := api.DeviceCredentialsByToken(ctx, args.Tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	resolvers := make([]*DeviceCredentialResolver, 0)
+	for _, current := range found {
+		resolvers = append(resolvers,
+			&DeviceCredentialResolver{
+				M: *current,
+				S: r,
+				C: ctx,
+			})
+	}
+	return resolvers, nil
+}
+
+// List all device credentials that match the given criteria.
+func (r *SchemaResolver) DeviceCredentials(ctx context.Context, args struct {
+	Criteria model.DeviceCredentialSearchCriteria
+}) (*DeviceCredentialSearchResultsResolver, error) {
+	api := r.GetApi(ctx)
+	found, err := api.DeviceCredentials(ctx, args.Criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return as resolver.
+	return &DeviceCredentialSearchResultsResolver{
+		M: *found,
+		S: r,
+		C: ctx,
+	}, nil
+}
+
+// Verify authentication material presented by a connecting device.
+func (r *SchemaResolver) VerifyDeviceCredential(ctx context.Context, args struct {
+	Request *model.DeviceCredentialVerifyRequest
+}) (*DeviceCredentialVerificationResolver, error) {
+	api := r.GetApi(ctx)
+	verification, err := api.VerifyDeviceCredential(ctx, args.Request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeviceCredentialVerificationResolver{
+		M: *verification,
+		S: r,
+		C: ctx,
+	}, nil
+}
@@ -0,0 +1,67 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/devicechain-io/dc-device-management/model"
+)
+
+// List dead letter events that match the given criteria.
+func (r *SchemaResolver) DeadLetterEvents(ctx context.Context, args struct {
+	Criteria model.DeadLetterEventSearchCriteria
+}) (*DeadLetterEventSearchResultsResolver, error) {
+	api := r.GetApi(ctx)
+	found, err := api.DeadLetterEvents(ctx, args.Criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return as resolver.
+	return &DeadLetterEventSearchResultsResolver{
+		M: *found,
+		S: r,
+		C: ctx,
+	}, nil
+}
+
+// Find failed event replay jobs by unique id.
+func (r *SchemaResolver) FailedEventReplayJobsById(ctx context.Context, args struct {
+	Ids []string
+}) ([]*FailedEventReplayJobResolver, error) {
+	api := r.GetApi(ctx)
+	ids, err := r.asUintIds(args.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := api.FailedEventReplayJobsById(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*FailedEventReplayJobResolver, 0)
+	for _, job := range found {
+		result = append(result, &FailedEventReplayJobResolver{
+			M: *job,
+			S: r,
+			C: ctx,
+		})
+	}
+	return result, nil
+}
@@ -0,0 +1,75 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/devicechain-io/dc-device-management/model"
+)
+
+// Get entities and relationships reachable from an entity across all relationship families.
+func (r *SchemaResolver) EntityGraph(ctx context.Context, args struct {
+	RootToken         string
+	RootKind          string
+	Depth             *int32
+	RelationshipTypes *[]string
+}) (*EntityGraphResolver, error) {
+	api := r.GetApi(ctx)
+	found, err := api.EntityGraph(ctx, model.EntityGraphCriteria{
+		RootToken:         args.RootToken,
+		RootKind:          args.RootKind,
+		Depth:             args.Depth,
+		RelationshipTypes: args.RelationshipTypes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &EntityGraphResolver{
+		M: *found,
+		S: r,
+		C: ctx,
+	}, nil
+}
+
+// Find devices whose events reach an entity through tracked relationships.
+func (r *SchemaResolver) ImpactedBy(ctx context.Context, args struct {
+	Token string
+	Kind  *string
+	Depth *int32
+}) ([]*DeviceResolver, error) {
+	api := r.GetApi(ctx)
+	found, err := api.ImpactedBy(ctx, model.ImpactedByCriteria{
+		Token: args.Token,
+		Kind:  args.Kind,
+		Depth: args.Depth,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*DeviceResolver, 0)
+	for _, dv := range found {
+		result = append(result, &DeviceResolver{
+			M: *dv,
+			S: r,
+			C: ctx,
+		})
+	}
+	return result, nil
+}
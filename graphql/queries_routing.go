@@ -0,0 +1,88 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/devicechain-io/dc-device-management/model"
+)
+
+// Find event routing rules by unique id.
+func (r *SchemaResolver) EventRoutingRulesById(ctx context.Context, args struct {
+	Ids []string
+}) ([]*EventRoutingRuleResolver, error) {
+	api := r.GetApi(ctx)
+	ids, err := r.asUintIds(args.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := api.EventRoutingRulesById(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*EventRoutingRuleResolver, 0)
+	for _, rule := range found {
+		result = append(result, &EventRoutingRuleResolver{
+			M: *rule,
+			S: r,
+			C: ctx,
+		})
+	}
+	return result, nil
+}
+
+// Find event routing rules by unique token.
+func (r *SchemaResolver) EventRoutingRulesByToken(ctx context.Context, args struct {
+	Tokens []string
+}) ([]*EventRoutingRuleResolver, error) {
+	api := r.GetApi(ctx)
+	found, err := api.EventRoutingRulesByToken(ctx, args.Tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*EventRoutingRuleResolver, 0)
+	for _, rule := range found {
+		result = append(result, &EventRoutingRuleResolver{
+			M: *rule,
+			S: r,
+			C: ctx,
+		})
+	}
+	return result, nil
+}
+
+// List event routing rules that match the given criteria.
+func (r *SchemaResolver) EventRoutingRules(ctx context.Context, args struct {
+	Criteria model.EventRoutingRuleSearchCriteria
+}) (*EventRoutingRuleSearchResultsResolver, error) {
+	api := r.GetApi(ctx)
+	found, err := api.EventRoutingRules(ctx, args.Criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return as resolver.
+	return &EventRoutingRuleSearchResultsResolver{
+		M: *found,
+		S: r,
+		C: ctx,
+	}, nil
+}
@@ -0,0 +1,138 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	"github.com/devicechain-io/dc-device-management/transform"
+	esmodel "github.com/devicechain-io/dc-event-sources/model"
+)
+
+// Find event transforms by unique id.
+func (r *SchemaResolver) EventTransformsById(ctx context.Context, args struct {
+	Ids []string
+}) ([]*EventTransformResolver, error) {
+	api := r.GetApi(ctx)
+	ids, err := r.asUintIds(args.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	found, err := api.EventTransformsById(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*EventTransformResolver, 0)
+	for _, xform := range found {
+		result = append(result, &EventTransformResolver{
+			M: *xform,
+			S: r,
+			C: ctx,
+		})
+	}
+	return result, nil
+}
+
+// Find event transforms by unique token.
+func (r *SchemaResolver) EventTransformsByToken(ctx context.Context, args struct {
+	Tokens []string
+}) ([]*EventTransformResolver, error) {
+	api := r.GetApi(ctx)
+	found, err := api.EventTransformsByToken(ctx, args.Tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*EventTransformResolver, 0)
+	for _, xform := range found {
+		result = append(result, &EventTransformResolver{
+			M: *xform,
+			S: r,
+			C: ctx,
+		})
+	}
+	return result, nil
+}
+
+// List event transforms that match the given criteria.
+func (r *SchemaResolver) EventTransforms(ctx context.Context, args struct {
+	Criteria model.EventTransformSearchCriteria
+}) (*EventTransformSearchResultsResolver, error) {
+	api := r.GetApi(ctx)
+	found, err := api.EventTransforms(ctx, args.Criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return as resolver.
+	return &EventTransformSearchResultsResolver{
+		M: *found,
+		S: r,
+		C: ctx,
+	}, nil
+}
+
+// Apply a transform script to sample measurements. Script errors are reported in the results
+// so that scripts may be tested before they are stored.
+func (r *SchemaResolver) TestTransform(ctx context.Context, args struct {
+	Request *model.EventTransformTestRequest
+}) (*EventTransformTestResultsResolver, error) {
+	results := &EventTransformTestResultsResolver{
+		M: model.EventTransformTestResults{
+			Events: make([]*esmodel.UnresolvedEvent, 0),
+		},
+		S: r,
+		C: ctx,
+	}
+	script, err := transform.Compile(args.Request.Script)
+	if err != nil {
+		msg := err.Error()
+		results.M.Error = &msg
+		return results, nil
+	}
+
+	// Build sample measurements event from request.
+	payload := &esmodel.UnresolvedMeasurementsPayload{
+		Entries: make([]esmodel.UnresolvedMeasurementsEntry, 0),
+	}
+	for _, entry := range args.Request.Entries {
+		mxs := make(map[string]string)
+		for _, mx := range entry.Measurements {
+			mxs[mx.Name] = mx.Value
+		}
+		payload.Entries = append(payload.Entries, esmodel.UnresolvedMeasurementsEntry{
+			Measurements: mxs,
+			OccurredTime: entry.OccurredTime,
+		})
+	}
+	event := &esmodel.UnresolvedEvent{
+		EventType: esmodel.Measurement,
+		Payload:   payload,
+	}
+
+	events, err := script.Apply(event)
+	if err != nil {
+		msg := err.Error()
+		results.M.Error = &msg
+		return results, nil
+	}
+	results.M.Events = events
+	return results, nil
+}
@@ -0,0 +1,157 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	util "github.com/devicechain-io/dc-microservice/graphql"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// -------------------
+// Alert rule resolver
+// -------------------
+
+type AlertRuleResolver struct {
+	M model.AlertRule
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *AlertRuleResolver) Id() gql.ID {
+	return gql.ID(fmt.Sprint(r.M.ID))
+}
+
+func (r *AlertRuleResolver) CreatedAt() *string {
+	return util.FormatTime(r.M.CreatedAt)
+}
+
+func (r *AlertRuleResolver) UpdatedAt() *string {
+	return util.FormatTime(r.M.UpdatedAt)
+}
+
+func (r *AlertRuleResolver) DeletedAt() *string {
+	return util.FormatTime(r.M.DeletedAt.Time)
+}
+
+func (r *AlertRuleResolver) Token() string {
+	return r.M.Token
+}
+
+func (r *AlertRuleResolver) Name() *string {
+	return util.NullStr(r.M.Name)
+}
+
+func (r *AlertRuleResolver) Description() *string {
+	return util.NullStr(r.M.Description)
+}
+
+func (r *AlertRuleResolver) Metadata() *string {
+	return util.MetadataStr(r.M.Metadata)
+}
+
+func (r *AlertRuleResolver) DeviceType() *DeviceTypeResolver {
+	if r.M.DeviceType != nil {
+		return &DeviceTypeResolver{
+			M: *r.M.DeviceType,
+			S: r.S,
+			C: r.C,
+		}
+	}
+	return nil
+}
+
+func (r *AlertRuleResolver) Device() *DeviceResolver {
+	if r.M.Device != nil {
+		return &DeviceResolver{
+			M: *r.M.Device,
+			S: r.S,
+			C: r.C,
+		}
+	}
+	return nil
+}
+
+func (r *AlertRuleResolver) Measurement() string {
+	return r.M.Measurement
+}
+
+func (r *AlertRuleResolver) Comparator() string {
+	return r.M.Comparator
+}
+
+func (r *AlertRuleResolver) Threshold() float64 {
+	return r.M.Threshold
+}
+
+func (r *AlertRuleResolver) DurationMs() int32 {
+	return r.M.DurationMs
+}
+
+func (r *AlertRuleResolver) Hysteresis() float64 {
+	return r.M.Hysteresis
+}
+
+func (r *AlertRuleResolver) AlertType() string {
+	return r.M.AlertType
+}
+
+func (r *AlertRuleResolver) Level() int32 {
+	return int32(r.M.Level)
+}
+
+func (r *AlertRuleResolver) Message() *string {
+	return util.NullStr(r.M.Message)
+}
+
+func (r *AlertRuleResolver) Disabled() bool {
+	return r.M.Disabled
+}
+
+// ----------------------------------
+// Alert rule search results resolver
+// ----------------------------------
+
+type AlertRuleSearchResultsResolver struct {
+	M model.AlertRuleSearchResults
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *AlertRuleSearchResultsResolver) Results() []*AlertRuleResolver {
+	resolvers := make([]*AlertRuleResolver, 0)
+	for _, current := range r.M.Results {
+		resolvers = append(resolvers,
+			&AlertRuleResolver{
+				M: current,
+				S: r.S,
+				C: r.C,
+			})
+	}
+	return resolvers
+}
+
+func (r *AlertRuleSearchResultsResolver) Pagination() *SearchResultsPaginationResolver {
+	return &SearchResultsPaginationResolver{
+		M: r.M.Pagination,
+		S: r.S,
+		C: r.C,
+	}
+}
@@ -0,0 +1,264 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	util "github.com/devicechain-io/dc-microservice/graphql"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// --------------
+// Alert resolver
+// --------------
+
+type AlertResolver struct {
+	M model.Alert
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *AlertResolver) Id() gql.ID {
+	return gql.ID(fmt.Sprint(r.M.ID))
+}
+
+func (r *AlertResolver) CreatedAt() *string {
+	return util.FormatTime(r.M.CreatedAt)
+}
+
+func (r *AlertResolver) UpdatedAt() *string {
+	return util.FormatTime(r.M.UpdatedAt)
+}
+
+func (r *AlertResolver) DeletedAt() *string {
+	return util.FormatTime(r.M.DeletedAt.Time)
+}
+
+func (r *AlertResolver) Token() string {
+	return r.M.Token
+}
+
+func (r *AlertResolver) Device() *DeviceResolver {
+	dev := model.Device{}
+	if r.M.Device != nil {
+		dev = *r.M.Device
+	}
+	return &DeviceResolver{
+		M: dev,
+		S: r.S,
+		C: r.C,
+	}
+}
+
+func (r *AlertResolver) AlertType() string {
+	return r.M.AlertType
+}
+
+func (r *AlertResolver) State() string {
+	return r.M.State
+}
+
+func (r *AlertResolver) Level() int32 {
+	return int32(r.M.Level)
+}
+
+func (r *AlertResolver) Message() *string {
+	return util.NullStr(r.M.Message)
+}
+
+func (r *AlertResolver) Source() *string {
+	return util.NullStr(r.M.Source)
+}
+
+func (r *AlertResolver) Occurrences() int32 {
+	return int32(r.M.Occurrences)
+}
+
+func (r *AlertResolver) FirstOccurred() *string {
+	return util.FormatTime(r.M.FirstOccurred)
+}
+
+func (r *AlertResolver) LastOccurred() *string {
+	return util.FormatTime(r.M.LastOccurred)
+}
+
+func (r *AlertResolver) AcknowledgedAt() *string {
+	if !r.M.AcknowledgedAt.Valid {
+		return nil
+	}
+	return util.FormatTime(r.M.AcknowledgedAt.Time)
+}
+
+func (r *AlertResolver) AcknowledgedBy() *string {
+	return util.NullStr(r.M.AcknowledgedBy)
+}
+
+func (r *AlertResolver) ResolvedAt() *string {
+	if !r.M.ResolvedAt.Valid {
+		return nil
+	}
+	return util.FormatTime(r.M.ResolvedAt.Time)
+}
+
+func (r *AlertResolver) ResolvedBy() *string {
+	return util.NullStr(r.M.ResolvedBy)
+}
+
+func (r *AlertResolver) ResolutionComment() *string {
+	return util.NullStr(r.M.ResolutionComment)
+}
+
+// -----------------------------
+// Alert search results resolver
+// -----------------------------
+
+type AlertSearchResultsResolver struct {
+	M model.AlertSearchResults
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *AlertSearchResultsResolver) Results() []*AlertResolver {
+	resolvers := make([]*AlertResolver, 0)
+	for _, current := range r.M.Results {
+		resolvers = append(resolvers,
+			&AlertResolver{
+				M: current,
+				S: r.S,
+				C: r.C,
+			})
+	}
+	return resolvers
+}
+
+func (r *AlertSearchResultsResolver) Pagination() *SearchResultsPaginationResolver {
+	return &SearchResultsPaginationResolver{
+		M: r.M.Pagination,
+		S: r.S,
+		C: r.C,
+	}
+}
+
+// --------------------------
+// Alert suppression resolver
+// --------------------------
+
+type AlertSuppressionResolver struct {
+	M model.AlertSuppression
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *AlertSuppressionResolver) Id() gql.ID {
+	return gql.ID(fmt.Sprint(r.M.ID))
+}
+
+func (r *AlertSuppressionResolver) CreatedAt() *string {
+	return util.FormatTime(r.M.CreatedAt)
+}
+
+func (r *AlertSuppressionResolver) UpdatedAt() *string {
+	return util.FormatTime(r.M.UpdatedAt)
+}
+
+func (r *AlertSuppressionResolver) DeletedAt() *string {
+	return util.FormatTime(r.M.DeletedAt.Time)
+}
+
+func (r *AlertSuppressionResolver) Token() string {
+	return r.M.Token
+}
+
+func (r *AlertSuppressionResolver) Name() *string {
+	return util.NullStr(r.M.Name)
+}
+
+func (r *AlertSuppressionResolver) Description() *string {
+	return util.NullStr(r.M.Description)
+}
+
+func (r *AlertSuppressionResolver) Metadata() *string {
+	return util.MetadataStr(r.M.Metadata)
+}
+
+func (r *AlertSuppressionResolver) Device() *DeviceResolver {
+	if r.M.Device != nil {
+		return &DeviceResolver{
+			M: *r.M.Device,
+			S: r.S,
+			C: r.C,
+		}
+	}
+	return nil
+}
+
+func (r *AlertSuppressionResolver) DeviceGroup() *DeviceGroupResolver {
+	if r.M.DeviceGroup != nil {
+		return &DeviceGroupResolver{
+			M: *r.M.DeviceGroup,
+			S: r.S,
+			C: r.C,
+		}
+	}
+	return nil
+}
+
+func (r *AlertSuppressionResolver) AlertType() *string {
+	return util.NullStr(r.M.AlertType)
+}
+
+func (r *AlertSuppressionResolver) StartTime() *string {
+	return util.FormatTime(r.M.StartTime)
+}
+
+func (r *AlertSuppressionResolver) EndTime() *string {
+	return util.FormatTime(r.M.EndTime)
+}
+
+// -----------------------------------------
+// Alert suppression search results resolver
+// -----------------------------------------
+
+type AlertSuppressionSearchResultsResolver struct {
+	M model.AlertSuppressionSearchResults
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *AlertSuppressionSearchResultsResolver) Results() []*AlertSuppressionResolver {
+	resolvers := make([]*AlertSuppressionResolver, 0)
+	for _, current := range r.M.Results {
+		resolvers = append(resolvers,
+			&AlertSuppressionResolver{
+				M: current,
+				S: r.S,
+				C: r.C,
+			})
+	}
+	return resolvers
+}
+
+func (r *AlertSuppressionSearchResultsResolver) Pagination() *SearchResultsPaginationResolver {
+	return &SearchResultsPaginationResolver{
+		M: r.M.Pagination,
+		S: r.S,
+		C: r.C,
+	}
+}
@@ -254,6 +254,30 @@ func (r *AreaRelationshipTypeResolver) Metadata() *string {
 	return util.MetadataStr(r.M.Metadata)
 }
 
+func (r *AreaRelationshipTypeResolver) Tracked() bool {
+	return r.M.Tracked
+}
+
+func (r *AreaRelationshipTypeResolver) Cardinality() string {
+	return r.M.CardinalityOrDefault()
+}
+
+func (r *AreaRelationshipTypeResolver) OnConflict() string {
+	return r.M.OnConflictOrDefault()
+}
+
+func (r *AreaRelationshipTypeResolver) AllowedTargetKinds() []string {
+	return r.M.TargetKinds()
+}
+
+func (r *AreaRelationshipTypeResolver) AllowedSourceTypes() []string {
+	return r.M.SourceTypes()
+}
+
+func (r *AreaRelationshipTypeResolver) AllowedTargetTypes() []string {
+	return r.M.TargetTypes()
+}
+
 // ----------------------------------------------
 // Area relationship type search results resolver
 // ----------------------------------------------
@@ -509,6 +533,26 @@ func (r *AreaGroupRelationshipTypeResolver) Metadata() *string {
 	return util.MetadataStr(r.M.Metadata)
 }
 
+func (r *AreaGroupRelationshipTypeResolver) Cardinality() string {
+	return r.M.CardinalityOrDefault()
+}
+
+func (r *AreaGroupRelationshipTypeResolver) OnConflict() string {
+	return r.M.OnConflictOrDefault()
+}
+
+func (r *AreaGroupRelationshipTypeResolver) AllowedTargetKinds() []string {
+	return r.M.TargetKinds()
+}
+
+func (r *AreaGroupRelationshipTypeResolver) AllowedSourceTypes() []string {
+	return r.M.SourceTypes()
+}
+
+func (r *AreaGroupRelationshipTypeResolver) AllowedTargetTypes() []string {
+	return r.M.TargetTypes()
+}
+
 // ----------------------------------------------------
 // Area group relationship type search results resolver
 // ----------------------------------------------------
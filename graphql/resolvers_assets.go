@@ -254,6 +254,30 @@ func (r *AssetRelationshipTypeResolver) Metadata() *string {
 	return util.MetadataStr(r.M.Metadata)
 }
 
+func (r *AssetRelationshipTypeResolver) Tracked() bool {
+	return r.M.Tracked
+}
+
+func (r *AssetRelationshipTypeResolver) Cardinality() string {
+	return r.M.CardinalityOrDefault()
+}
+
+func (r *AssetRelationshipTypeResolver) OnConflict() string {
+	return r.M.OnConflictOrDefault()
+}
+
+func (r *AssetRelationshipTypeResolver) AllowedTargetKinds() []string {
+	return r.M.TargetKinds()
+}
+
+func (r *AssetRelationshipTypeResolver) AllowedSourceTypes() []string {
+	return r.M.SourceTypes()
+}
+
+func (r *AssetRelationshipTypeResolver) AllowedTargetTypes() []string {
+	return r.M.TargetTypes()
+}
+
 // -----------------------------------------------
 // Asset relationship type search results resolver
 // -----------------------------------------------
@@ -509,6 +533,26 @@ func (r *AssetGroupRelationshipTypeResolver) Metadata() *string {
 	return util.MetadataStr(r.M.Metadata)
 }
 
+func (r *AssetGroupRelationshipTypeResolver) Cardinality() string {
+	return r.M.CardinalityOrDefault()
+}
+
+func (r *AssetGroupRelationshipTypeResolver) OnConflict() string {
+	return r.M.OnConflictOrDefault()
+}
+
+func (r *AssetGroupRelationshipTypeResolver) AllowedTargetKinds() []string {
+	return r.M.TargetKinds()
+}
+
+func (r *AssetGroupRelationshipTypeResolver) AllowedSourceTypes() []string {
+	return r.M.SourceTypes()
+}
+
+func (r *AssetGroupRelationshipTypeResolver) AllowedTargetTypes() []string {
+	return r.M.TargetTypes()
+}
+
 // -----------------------------------------------------
 // Asset group relationship type search results resolver
 // -----------------------------------------------------
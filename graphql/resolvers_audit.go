@@ -0,0 +1,117 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	util "github.com/devicechain-io/dc-microservice/graphql"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// --------------------
+// Audit entry resolver
+// --------------------
+
+type AuditEntryResolver struct {
+	M model.AuditEntry
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *AuditEntryResolver) Id() gql.ID {
+	return gql.ID(fmt.Sprint(r.M.ID))
+}
+
+func (r *AuditEntryResolver) CreatedAt() *string {
+	return util.FormatTime(r.M.CreatedAt)
+}
+
+func (r *AuditEntryResolver) UpdatedAt() *string {
+	return util.FormatTime(r.M.UpdatedAt)
+}
+
+func (r *AuditEntryResolver) DeletedAt() *string {
+	return util.FormatTime(r.M.DeletedAt.Time)
+}
+
+func (r *AuditEntryResolver) Actor() string {
+	return r.M.Actor
+}
+
+func (r *AuditEntryResolver) Tenant() *string {
+	if r.M.Tenant == "" {
+		return nil
+	}
+	return &r.M.Tenant
+}
+
+func (r *AuditEntryResolver) EntityKind() string {
+	return r.M.EntityKind
+}
+
+func (r *AuditEntryResolver) EntityToken() *string {
+	if r.M.EntityToken == "" {
+		return nil
+	}
+	return &r.M.EntityToken
+}
+
+func (r *AuditEntryResolver) Operation() string {
+	return r.M.Operation
+}
+
+func (r *AuditEntryResolver) Changes() *string {
+	return util.MetadataStr(&r.M.Changes)
+}
+
+func (r *AuditEntryResolver) OccurredAt() *string {
+	return util.FormatTime(r.M.OccurredAt)
+}
+
+// -----------------------------------
+// Audit entry search results resolver
+// -----------------------------------
+
+type AuditEntrySearchResultsResolver struct {
+	M model.AuditEntrySearchResults
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *AuditEntrySearchResultsResolver) Results() []*AuditEntryResolver {
+	resolvers := make([]*AuditEntryResolver, 0)
+	for _, current := range r.M.Results {
+		resolvers = append(resolvers,
+			&AuditEntryResolver{
+				M: current,
+				S: r.S,
+				C: r.C,
+			})
+	}
+	return resolvers
+}
+
+func (r *AuditEntrySearchResultsResolver) Pagination() *SearchResultsPaginationResolver {
+	return &SearchResultsPaginationResolver{
+		M: r.M.Pagination,
+		S: r.S,
+		C: r.C,
+	}
+}
@@ -0,0 +1,130 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	util "github.com/devicechain-io/dc-microservice/graphql"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// ---------------------------
+// Device certificate resolver
+// ---------------------------
+
+type DeviceCertificateResolver struct {
+	M model.DeviceCertificate
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *DeviceCertificateResolver) Id() gql.ID {
+	return gql.ID(fmt.Sprint(r.M.ID))
+}
+
+func (r *DeviceCertificateResolver) CreatedAt() *string {
+	return util.FormatTime(r.M.CreatedAt)
+}
+
+func (r *DeviceCertificateResolver) UpdatedAt() *string {
+	return util.FormatTime(r.M.UpdatedAt)
+}
+
+func (r *DeviceCertificateResolver) DeletedAt() *string {
+	return util.FormatTime(r.M.DeletedAt.Time)
+}
+
+func (r *DeviceCertificateResolver) Device() *DeviceResolver {
+	device := model.Device{}
+	if r.M.Device != nil {
+		device = *r.M.Device
+	}
+	return &DeviceResolver{
+		M: device,
+		S: r.S,
+		C: r.C,
+	}
+}
+
+func (r *DeviceCertificateResolver) SerialNumber() string {
+	return r.M.SerialNumber
+}
+
+func (r *DeviceCertificateResolver) Subject() string {
+	return r.M.Subject
+}
+
+func (r *DeviceCertificateResolver) Fingerprint() string {
+	return r.M.Fingerprint
+}
+
+func (r *DeviceCertificateResolver) Certificate() string {
+	return r.M.Certificate
+}
+
+func (r *DeviceCertificateResolver) NotBefore() *string {
+	return util.FormatTime(r.M.NotBefore)
+}
+
+func (r *DeviceCertificateResolver) NotAfter() *string {
+	return util.FormatTime(r.M.NotAfter)
+}
+
+func (r *DeviceCertificateResolver) RevokedAt() *string {
+	if !r.M.RevokedAt.Valid {
+		return nil
+	}
+	return util.FormatTime(r.M.RevokedAt.Time)
+}
+
+func (r *DeviceCertificateResolver) RevocationReason() *string {
+	return util.NullStr(r.M.RevocationReason)
+}
+
+// ------------------------------------------
+// Device certificate search results resolver
+// ------------------------------------------
+
+type DeviceCertificateSearchResultsResolver struct {
+	M model.DeviceCertificateSearchResults
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *DeviceCertificateSearchResultsResolver) Results() []*DeviceCertificateResolver {
+	resolvers := make([]*DeviceCertificateResolver, 0)
+	for _, current := range r.M.Results {
+		resolvers = append(resolvers,
+			&DeviceCertificateResolver{
+				M: current,
+				S: r.S,
+				C: r.C,
+			})
+	}
+	return resolvers
+}
+
+func (r *DeviceCertificateSearchResultsResolver) Pagination() *SearchResultsPaginationResolver {
+	return &SearchResultsPaginationResolver{
+		M: r.M.Pagination,
+		S: r.S,
+		C: r.C,
+	}
+}
@@ -0,0 +1,188 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	util "github.com/devicechain-io/dc-microservice/graphql"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// --------------------------
+// Device credential resolver
+// --------------------------
+
+type DeviceCredentialResolver struct {
+	M model.DeviceCredential
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *DeviceCredentialResolver) Id() gql.ID {
+	return gql.ID(fmt.Sprint(r.M.ID))
+}
+
+func (r *DeviceCredentialResolver) CreatedAt() *string {
+	return util.FormatTime(r.M.CreatedAt)
+}
+
+func (r *DeviceCredentialResolver) UpdatedAt() *string {
+	return util.FormatTime(r.M.UpdatedAt)
+}
+
+func (r *DeviceCredentialResolver) DeletedAt() *string {
+	return util.FormatTime(r.M.DeletedAt.Time)
+}
+
+func (r *DeviceCredentialResolver) Token() string {
+	return r.M.Token
+}
+
+func (r *DeviceCredentialResolver) Device() *DeviceResolver {
+	device := model.Device{}
+	if r.M.Device != nil {
+		device = *r.M.Device
+	}
+	return &DeviceResolver{
+		M: device,
+		S: r.S,
+		C: r.C,
+	}
+}
+
+func (r *DeviceCredentialResolver) CredentialType() string {
+	return r.M.CredentialType
+}
+
+func (r *DeviceCredentialResolver) ExpiresAt() *string {
+	if !r.M.ExpiresAt.Valid {
+		return nil
+	}
+	return util.FormatTime(r.M.ExpiresAt.Time)
+}
+
+func (r *DeviceCredentialResolver) RevokedAt() *string {
+	if !r.M.RevokedAt.Valid {
+		return nil
+	}
+	return util.FormatTime(r.M.RevokedAt.Time)
+}
+
+func (r *DeviceCredentialResolver) Active() bool {
+	return r.M.IsActive(time.Now())
+}
+
+func (r *DeviceCredentialResolver) Metadata() *string {
+	return util.MetadataStr(r.M.Metadata)
+}
+
+// ---------------------------------
+// Issued device credential resolver
+// ---------------------------------
+
+type IssuedDeviceCredentialResolver struct {
+	M model.IssuedDeviceCredential
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *IssuedDeviceCredentialResolver) Credential() *DeviceCredentialResolver {
+	return &DeviceCredentialResolver{
+		M: *r.M.Credential,
+		S: r.S,
+		C: r.C,
+	}
+}
+
+func (r *IssuedDeviceCredentialResolver) Secret() *string {
+	return r.M.Secret
+}
+
+// -----------------------------------------
+// Device credential search results resolver
+// -----------------------------------------
+
+type DeviceCredentialSearchResultsResolver struct {
+	M model.DeviceCredentialSearchResults
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *DeviceCredentialSearchResultsResolver) Results() []*DeviceCredentialResolver {
+	resolvers := make([]*DeviceCredentialResolver, 0)
+	for _, current := range r.M.Results {
+		resolvers = append(resolvers,
+			&DeviceCredentialResolver{
+				M: current,
+				S: r.S,
+				C: r.C,
+			})
+	}
+	return resolvers
+}
+
+func (r *DeviceCredentialSearchResultsResolver) Pagination() *SearchResultsPaginationResolver {
+	return &SearchResultsPaginationResolver{
+		M: r.M.Pagination,
+		S: r.S,
+		C: r.C,
+	}
+}
+
+// ---------------------------------------
+// Device credential verification resolver
+// ---------------------------------------
+
+type DeviceCredentialVerificationResolver struct {
+	M model.DeviceCredentialVerification
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *DeviceCredentialVerificationResolver) Valid() bool {
+	return r.M.Valid
+}
+
+func (r *DeviceCredentialVerificationResolver) Reason() *string {
+	return r.M.Reason
+}
+
+func (r *DeviceCredentialVerificationResolver) Device() *DeviceResolver {
+	if r.M.Device == nil {
+		return nil
+	}
+	return &DeviceResolver{
+		M: *r.M.Device,
+		S: r.S,
+		C: r.C,
+	}
+}
+
+func (r *DeviceCredentialVerificationResolver) Credential() *DeviceCredentialResolver {
+	if r.M.Credential == nil {
+		return nil
+	}
+	return &DeviceCredentialResolver{
+		M: *r.M.Credential,
+		S: r.S,
+		C: r.C,
+	}
+}
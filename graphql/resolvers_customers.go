@@ -254,6 +254,30 @@ func (r *CustomerRelationshipTypeResolver) Metadata() *string {
 	return util.MetadataStr(r.M.Metadata)
 }
 
+func (r *CustomerRelationshipTypeResolver) Tracked() bool {
+	return r.M.Tracked
+}
+
+func (r *CustomerRelationshipTypeResolver) Cardinality() string {
+	return r.M.CardinalityOrDefault()
+}
+
+func (r *CustomerRelationshipTypeResolver) OnConflict() string {
+	return r.M.OnConflictOrDefault()
+}
+
+func (r *CustomerRelationshipTypeResolver) AllowedTargetKinds() []string {
+	return r.M.TargetKinds()
+}
+
+func (r *CustomerRelationshipTypeResolver) AllowedSourceTypes() []string {
+	return r.M.SourceTypes()
+}
+
+func (r *CustomerRelationshipTypeResolver) AllowedTargetTypes() []string {
+	return r.M.TargetTypes()
+}
+
 // --------------------------------------------------
 // Customer relationship type search results resolver
 // --------------------------------------------------
@@ -509,6 +533,26 @@ func (r *CustomerGroupRelationshipTypeResolver) Metadata() *string {
 	return util.MetadataStr(r.M.Metadata)
 }
 
+func (r *CustomerGroupRelationshipTypeResolver) Cardinality() string {
+	return r.M.CardinalityOrDefault()
+}
+
+func (r *CustomerGroupRelationshipTypeResolver) OnConflict() string {
+	return r.M.OnConflictOrDefault()
+}
+
+func (r *CustomerGroupRelationshipTypeResolver) AllowedTargetKinds() []string {
+	return r.M.TargetKinds()
+}
+
+func (r *CustomerGroupRelationshipTypeResolver) AllowedSourceTypes() []string {
+	return r.M.SourceTypes()
+}
+
+func (r *CustomerGroupRelationshipTypeResolver) AllowedTargetTypes() []string {
+	return r.M.TargetTypes()
+}
+
 // -------------------------------------------------
 // Customer group relationship type results resolver
 // -------------------------------------------------
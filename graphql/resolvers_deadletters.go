@@ -0,0 +1,210 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	"github.com/devicechain-io/dc-device-management/proto"
+	util "github.com/devicechain-io/dc-microservice/graphql"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// --------------------------
+// Dead letter event resolver
+// --------------------------
+
+type DeadLetterEventResolver struct {
+	M model.DeadLetterEvent
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *DeadLetterEventResolver) Id() gql.ID {
+	return gql.ID(fmt.Sprint(r.M.ID))
+}
+
+func (r *DeadLetterEventResolver) CreatedAt() *string {
+	return util.FormatTime(r.M.CreatedAt)
+}
+
+func (r *DeadLetterEventResolver) UpdatedAt() *string {
+	return util.FormatTime(r.M.UpdatedAt)
+}
+
+func (r *DeadLetterEventResolver) DeletedAt() *string {
+	return util.FormatTime(r.M.DeletedAt.Time)
+}
+
+func (r *DeadLetterEventResolver) Reason() int32 {
+	return int32(r.M.Reason)
+}
+
+func (r *DeadLetterEventResolver) ReasonName() string {
+	return proto.FailureReason(r.M.Reason).String()
+}
+
+func (r *DeadLetterEventResolver) Service() string {
+	return r.M.Service
+}
+
+func (r *DeadLetterEventResolver) Message() *string {
+	return &r.M.Message
+}
+
+func (r *DeadLetterEventResolver) Error() *string {
+	return &r.M.Error
+}
+
+func (r *DeadLetterEventResolver) DeviceToken() *string {
+	return util.NullStr(r.M.DeviceToken)
+}
+
+func (r *DeadLetterEventResolver) FailureCount() int32 {
+	return int32(r.M.FailureCount)
+}
+
+func (r *DeadLetterEventResolver) ReplayCount() int32 {
+	return int32(r.M.ReplayCount)
+}
+
+func (r *DeadLetterEventResolver) Status() string {
+	return r.M.Status
+}
+
+func (r *DeadLetterEventResolver) FirstFailed() *string {
+	return util.FormatTime(r.M.FirstFailed)
+}
+
+func (r *DeadLetterEventResolver) LastFailed() *string {
+	return util.FormatTime(r.M.LastFailed)
+}
+
+// -----------------------------------------
+// Dead letter event search results resolver
+// -----------------------------------------
+
+type DeadLetterEventSearchResultsResolver struct {
+	M model.DeadLetterEventSearchResults
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *DeadLetterEventSearchResultsResolver) Results() []*DeadLetterEventResolver {
+	resolvers := make([]*DeadLetterEventResolver, 0)
+	for _, current := range r.M.Results {
+		resolvers = append(resolvers,
+			&DeadLetterEventResolver{
+				M: current,
+				S: r.S,
+				C: r.C,
+			})
+	}
+	return resolvers
+}
+
+func (r *DeadLetterEventSearchResultsResolver) Pagination() *SearchResultsPaginationResolver {
+	return &SearchResultsPaginationResolver{
+		M: r.M.Pagination,
+		S: r.S,
+		C: r.C,
+	}
+}
+
+// --------------------------------
+// Failed event replay job resolver
+// --------------------------------
+
+type FailedEventReplayJobResolver struct {
+	M model.FailedEventReplayJob
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *FailedEventReplayJobResolver) Id() gql.ID {
+	return gql.ID(fmt.Sprint(r.M.ID))
+}
+
+func (r *FailedEventReplayJobResolver) CreatedAt() *string {
+	return util.FormatTime(r.M.CreatedAt)
+}
+
+func (r *FailedEventReplayJobResolver) UpdatedAt() *string {
+	return util.FormatTime(r.M.UpdatedAt)
+}
+
+func (r *FailedEventReplayJobResolver) DeletedAt() *string {
+	return util.FormatTime(r.M.DeletedAt.Time)
+}
+
+func (r *FailedEventReplayJobResolver) Status() string {
+	return r.M.Status
+}
+
+func (r *FailedEventReplayJobResolver) Reason() *int32 {
+	if !r.M.Reason.Valid {
+		return nil
+	}
+	return &r.M.Reason.Int32
+}
+
+func (r *FailedEventReplayJobResolver) Service() *string {
+	return util.NullStr(r.M.Service)
+}
+
+func (r *FailedEventReplayJobResolver) DeviceToken() *string {
+	return util.NullStr(r.M.DeviceToken)
+}
+
+func (r *FailedEventReplayJobResolver) Since() *string {
+	if !r.M.Since.Valid {
+		return nil
+	}
+	return util.FormatTime(r.M.Since.Time)
+}
+
+func (r *FailedEventReplayJobResolver) Until() *string {
+	if !r.M.Until.Valid {
+		return nil
+	}
+	return util.FormatTime(r.M.Until.Time)
+}
+
+func (r *FailedEventReplayJobResolver) Total() int32 {
+	return int32(r.M.Total)
+}
+
+func (r *FailedEventReplayJobResolver) Replayed() int32 {
+	return int32(r.M.Replayed)
+}
+
+func (r *FailedEventReplayJobResolver) Quarantined() int32 {
+	return int32(r.M.Quarantined)
+}
+
+func (r *FailedEventReplayJobResolver) CompletedAt() *string {
+	if !r.M.CompletedAt.Valid {
+		return nil
+	}
+	return util.FormatTime(r.M.CompletedAt.Time)
+}
+
+func (r *FailedEventReplayJobResolver) Error() *string {
+	return util.NullStr(r.M.Error)
+}
@@ -88,6 +88,14 @@ func (r *DeviceTypeResolver) Metadata() *string {
 	return util.MetadataStr(r.M.Metadata)
 }
 
+func (r *DeviceTypeResolver) RateLimit() *float64 {
+	return nullFloat(r.M.RateLimit)
+}
+
+func (r *DeviceTypeResolver) RateBurst() *int32 {
+	return nullInt32(r.M.RateBurst)
+}
+
 // -----------------------------------
 // Device type search results resolver
 // -----------------------------------
@@ -161,6 +169,18 @@ func (r *DeviceResolver) Metadata() *string {
 	return util.MetadataStr(r.M.Metadata)
 }
 
+func (r *DeviceResolver) Status() string {
+	return r.M.Status
+}
+
+func (r *DeviceResolver) RateLimit() *float64 {
+	return nullFloat(r.M.RateLimit)
+}
+
+func (r *DeviceResolver) RateBurst() *int32 {
+	return nullInt32(r.M.RateBurst)
+}
+
 func (r *DeviceResolver) DeviceType() *DeviceTypeResolver {
 	if r.M.DeviceType != nil {
 		return &DeviceTypeResolver{
@@ -255,6 +275,34 @@ func (r *DeviceRelationshipTypeResolver) Tracked() bool {
 	return r.M.Tracked
 }
 
+func (r *DeviceRelationshipTypeResolver) Enriched() bool {
+	return r.M.Enriched
+}
+
+func (r *DeviceRelationshipTypeResolver) EnrichedMetadataKeys() []string {
+	return r.M.MetadataKeysForEnrichment()
+}
+
+func (r *DeviceRelationshipTypeResolver) Cardinality() string {
+	return r.M.CardinalityOrDefault()
+}
+
+func (r *DeviceRelationshipTypeResolver) OnConflict() string {
+	return r.M.OnConflictOrDefault()
+}
+
+func (r *DeviceRelationshipTypeResolver) AllowedTargetKinds() []string {
+	return r.M.TargetKinds()
+}
+
+func (r *DeviceRelationshipTypeResolver) AllowedSourceTypes() []string {
+	return r.M.SourceTypes()
+}
+
+func (r *DeviceRelationshipTypeResolver) AllowedTargetTypes() []string {
+	return r.M.TargetTypes()
+}
+
 // ------------------------------------------------
 // Device relationship type search results resolver
 // ------------------------------------------------
@@ -510,6 +558,26 @@ func (r *DeviceGroupRelationshipTypeResolver) Metadata() *string {
 	return util.MetadataStr(r.M.Metadata)
 }
 
+func (r *DeviceGroupRelationshipTypeResolver) Cardinality() string {
+	return r.M.CardinalityOrDefault()
+}
+
+func (r *DeviceGroupRelationshipTypeResolver) OnConflict() string {
+	return r.M.OnConflictOrDefault()
+}
+
+func (r *DeviceGroupRelationshipTypeResolver) AllowedTargetKinds() []string {
+	return r.M.TargetKinds()
+}
+
+func (r *DeviceGroupRelationshipTypeResolver) AllowedSourceTypes() []string {
+	return r.M.SourceTypes()
+}
+
+func (r *DeviceGroupRelationshipTypeResolver) AllowedTargetTypes() []string {
+	return r.M.TargetTypes()
+}
+
 // -----------------------------------------------
 // Device group relationship type results resolver
 // -----------------------------------------------
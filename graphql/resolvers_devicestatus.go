@@ -0,0 +1,111 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	util "github.com/devicechain-io/dc-microservice/graphql"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// ---------------------------------
+// Device status transition resolver
+// ---------------------------------
+
+type DeviceStatusTransitionResolver struct {
+	M model.DeviceStatusTransition
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *DeviceStatusTransitionResolver) Id() gql.ID {
+	return gql.ID(fmt.Sprint(r.M.ID))
+}
+
+func (r *DeviceStatusTransitionResolver) CreatedAt() *string {
+	return util.FormatTime(r.M.CreatedAt)
+}
+
+func (r *DeviceStatusTransitionResolver) UpdatedAt() *string {
+	return util.FormatTime(r.M.UpdatedAt)
+}
+
+func (r *DeviceStatusTransitionResolver) DeletedAt() *string {
+	return util.FormatTime(r.M.DeletedAt.Time)
+}
+
+func (r *DeviceStatusTransitionResolver) Device() *DeviceResolver {
+	device := model.Device{}
+	if r.M.Device != nil {
+		device = *r.M.Device
+	}
+	return &DeviceResolver{
+		M: device,
+		S: r.S,
+		C: r.C,
+	}
+}
+
+func (r *DeviceStatusTransitionResolver) FromStatus() string {
+	return r.M.FromStatus
+}
+
+func (r *DeviceStatusTransitionResolver) ToStatus() string {
+	return r.M.ToStatus
+}
+
+func (r *DeviceStatusTransitionResolver) Actor() *string {
+	return util.NullStr(r.M.Actor)
+}
+
+func (r *DeviceStatusTransitionResolver) Reason() *string {
+	return util.NullStr(r.M.Reason)
+}
+
+// ------------------------------------------------
+// Device status transition search results resolver
+// ------------------------------------------------
+
+type DeviceStatusTransitionSearchResultsResolver struct {
+	M model.DeviceStatusTransitionSearchResults
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *DeviceStatusTransitionSearchResultsResolver) Results() []*DeviceStatusTransitionResolver {
+	resolvers := make([]*DeviceStatusTransitionResolver, 0)
+	for _, current := range r.M.Results {
+		resolvers = append(resolvers,
+			&DeviceStatusTransitionResolver{
+				M: current,
+				S: r.S,
+				C: r.C,
+			})
+	}
+	return resolvers
+}
+
+func (r *DeviceStatusTransitionSearchResultsResolver) Pagination() *SearchResultsPaginationResolver {
+	return &SearchResultsPaginationResolver{
+		M: r.M.Pagination,
+		S: r.S,
+		C: r.C,
+	}
+}
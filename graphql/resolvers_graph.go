@@ -0,0 +1,144 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	util "github.com/devicechain-io/dc-microservice/graphql"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// --------------------------
+// Entity graph node resolver
+// --------------------------
+
+type EntityGraphNodeResolver struct {
+	M model.EntityGraphNode
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *EntityGraphNodeResolver) Kind() string {
+	return r.M.Kind
+}
+
+func (r *EntityGraphNodeResolver) Id() gql.ID {
+	return gql.ID(fmt.Sprint(r.M.Id))
+}
+
+func (r *EntityGraphNodeResolver) Token() string {
+	return r.M.Token
+}
+
+func (r *EntityGraphNodeResolver) Name() *string {
+	return util.NullStr(r.M.Name)
+}
+
+func (r *EntityGraphNodeResolver) Depth() int32 {
+	return int32(r.M.Depth)
+}
+
+// --------------------------
+// Entity graph edge resolver
+// --------------------------
+
+type EntityGraphEdgeResolver struct {
+	M model.EntityGraphEdge
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *EntityGraphEdgeResolver) RelationshipId() gql.ID {
+	return gql.ID(fmt.Sprint(r.M.RelationshipId))
+}
+
+func (r *EntityGraphEdgeResolver) RelationshipToken() string {
+	return r.M.RelationshipToken
+}
+
+func (r *EntityGraphEdgeResolver) RelationshipType() string {
+	return r.M.RelationshipType
+}
+
+func (r *EntityGraphEdgeResolver) Tracked() bool {
+	return r.M.Tracked
+}
+
+func (r *EntityGraphEdgeResolver) SourceKind() string {
+	return r.M.SourceKind
+}
+
+func (r *EntityGraphEdgeResolver) SourceToken() string {
+	return r.M.SourceToken
+}
+
+func (r *EntityGraphEdgeResolver) TargetKind() string {
+	return r.M.TargetKind
+}
+
+func (r *EntityGraphEdgeResolver) TargetToken() string {
+	return r.M.TargetToken
+}
+
+func (r *EntityGraphEdgeResolver) Depth() int32 {
+	return int32(r.M.Depth)
+}
+
+// ---------------------
+// Entity graph resolver
+// ---------------------
+
+type EntityGraphResolver struct {
+	M model.EntityGraph
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *EntityGraphResolver) Root() *EntityGraphNodeResolver {
+	return &EntityGraphNodeResolver{
+		M: r.M.Root,
+		S: r.S,
+		C: r.C,
+	}
+}
+
+func (r *EntityGraphResolver) Nodes() []*EntityGraphNodeResolver {
+	nodes := make([]*EntityGraphNodeResolver, 0)
+	for _, node := range r.M.Nodes {
+		nodes = append(nodes, &EntityGraphNodeResolver{
+			M: node,
+			S: r.S,
+			C: r.C,
+		})
+	}
+	return nodes
+}
+
+func (r *EntityGraphResolver) Edges() []*EntityGraphEdgeResolver {
+	edges := make([]*EntityGraphEdgeResolver, 0)
+	for _, edge := range r.M.Edges {
+		edges = append(edges, &EntityGraphEdgeResolver{
+			M: edge,
+			S: r.S,
+			C: r.C,
+		})
+	}
+	return edges
+}
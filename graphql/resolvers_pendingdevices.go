@@ -0,0 +1,111 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	util "github.com/devicechain-io/dc-microservice/graphql"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// -----------------------
+// Pending device resolver
+// -----------------------
+
+type PendingDeviceResolver struct {
+	M model.PendingDevice
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *PendingDeviceResolver) Id() gql.ID {
+	return gql.ID(fmt.Sprint(r.M.ID))
+}
+
+func (r *PendingDeviceResolver) CreatedAt() *string {
+	return util.FormatTime(r.M.CreatedAt)
+}
+
+func (r *PendingDeviceResolver) UpdatedAt() *string {
+	return util.FormatTime(r.M.UpdatedAt)
+}
+
+func (r *PendingDeviceResolver) DeletedAt() *string {
+	return util.FormatTime(r.M.DeletedAt.Time)
+}
+
+func (r *PendingDeviceResolver) Token() string {
+	return r.M.Token
+}
+
+func (r *PendingDeviceResolver) FirstSeen() *string {
+	return util.FormatTime(r.M.FirstSeen)
+}
+
+func (r *PendingDeviceResolver) LastSeen() *string {
+	return util.FormatTime(r.M.LastSeen)
+}
+
+func (r *PendingDeviceResolver) Source() string {
+	return r.M.Source
+}
+
+func (r *PendingDeviceResolver) SamplePayload() *string {
+	return util.NullStr(r.M.SamplePayload)
+}
+
+func (r *PendingDeviceResolver) EventCount() int32 {
+	return int32(r.M.EventCount)
+}
+
+func (r *PendingDeviceResolver) Rejected() bool {
+	return r.M.Rejected
+}
+
+// --------------------------------------
+// Pending device search results resolver
+// --------------------------------------
+
+type PendingDeviceSearchResultsResolver struct {
+	M model.PendingDeviceSearchResults
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *PendingDeviceSearchResultsResolver) Results() []*PendingDeviceResolver {
+	resolvers := make([]*PendingDeviceResolver, 0)
+	for _, current := range r.M.Results {
+		resolvers = append(resolvers,
+			&PendingDeviceResolver{
+				M: current,
+				S: r.S,
+				C: r.C,
+			})
+	}
+	return resolvers
+}
+
+func (r *PendingDeviceSearchResultsResolver) Pagination() *SearchResultsPaginationResolver {
+	return &SearchResultsPaginationResolver{
+		M: r.M.Pagination,
+		S: r.S,
+		C: r.C,
+	}
+}
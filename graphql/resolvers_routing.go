@@ -0,0 +1,155 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	util "github.com/devicechain-io/dc-microservice/graphql"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// ---------------------------
+// Event routing rule resolver
+// ---------------------------
+
+type EventRoutingRuleResolver struct {
+	M model.EventRoutingRule
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *EventRoutingRuleResolver) Id() gql.ID {
+	return gql.ID(fmt.Sprint(r.M.ID))
+}
+
+func (r *EventRoutingRuleResolver) CreatedAt() *string {
+	return util.FormatTime(r.M.CreatedAt)
+}
+
+func (r *EventRoutingRuleResolver) UpdatedAt() *string {
+	return util.FormatTime(r.M.UpdatedAt)
+}
+
+func (r *EventRoutingRuleResolver) DeletedAt() *string {
+	return util.FormatTime(r.M.DeletedAt.Time)
+}
+
+func (r *EventRoutingRuleResolver) Token() string {
+	return r.M.Token
+}
+
+func (r *EventRoutingRuleResolver) Name() *string {
+	return util.NullStr(r.M.Name)
+}
+
+func (r *EventRoutingRuleResolver) Description() *string {
+	return util.NullStr(r.M.Description)
+}
+
+func (r *EventRoutingRuleResolver) Metadata() *string {
+	return util.MetadataStr(r.M.Metadata)
+}
+
+func (r *EventRoutingRuleResolver) EventType() *string {
+	return util.NullStr(r.M.EventType)
+}
+
+func (r *EventRoutingRuleResolver) DeviceType() *DeviceTypeResolver {
+	if r.M.DeviceType != nil {
+		return &DeviceTypeResolver{
+			M: *r.M.DeviceType,
+			S: r.S,
+			C: r.C,
+		}
+	}
+	return nil
+}
+
+func (r *EventRoutingRuleResolver) RelationshipType() *DeviceRelationshipTypeResolver {
+	if r.M.RelationshipType != nil {
+		return &DeviceRelationshipTypeResolver{
+			M: *r.M.RelationshipType,
+			S: r.S,
+			C: r.C,
+		}
+	}
+	return nil
+}
+
+func (r *EventRoutingRuleResolver) Customer() *CustomerResolver {
+	if r.M.Customer != nil {
+		return &CustomerResolver{
+			M: *r.M.Customer,
+			S: r.S,
+			C: r.C,
+		}
+	}
+	return nil
+}
+
+func (r *EventRoutingRuleResolver) Area() *AreaResolver {
+	if r.M.Area != nil {
+		return &AreaResolver{
+			M: *r.M.Area,
+			S: r.S,
+			C: r.C,
+		}
+	}
+	return nil
+}
+
+func (r *EventRoutingRuleResolver) Topics() []string {
+	return r.M.TopicNames()
+}
+
+func (r *EventRoutingRuleResolver) Disabled() bool {
+	return r.M.Disabled
+}
+
+// ------------------------------------------
+// Event routing rule search results resolver
+// ------------------------------------------
+
+type EventRoutingRuleSearchResultsResolver struct {
+	M model.EventRoutingRuleSearchResults
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *EventRoutingRuleSearchResultsResolver) Results() []*EventRoutingRuleResolver {
+	resolvers := make([]*EventRoutingRuleResolver, 0)
+	for _, current := range r.M.Results {
+		resolvers = append(resolvers,
+			&EventRoutingRuleResolver{
+				M: current,
+				S: r.S,
+				C: r.C,
+			})
+	}
+	return resolvers
+}
+
+func (r *EventRoutingRuleSearchResultsResolver) Pagination() *SearchResultsPaginationResolver {
+	return &SearchResultsPaginationResolver{
+		M: r.M.Pagination,
+		S: r.S,
+		C: r.C,
+	}
+}
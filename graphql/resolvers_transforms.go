@@ -0,0 +1,223 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	esmodel "github.com/devicechain-io/dc-event-sources/model"
+	util "github.com/devicechain-io/dc-microservice/graphql"
+	gql "github.com/graph-gophers/graphql-go"
+)
+
+// ------------------------
+// Event transform resolver
+// ------------------------
+
+type EventTransformResolver struct {
+	M model.EventTransform
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *EventTransformResolver) Id() gql.ID {
+	return gql.ID(fmt.Sprint(r.M.ID))
+}
+
+func (r *EventTransformResolver) CreatedAt() *string {
+	return util.FormatTime(r.M.CreatedAt)
+}
+
+func (r *EventTransformResolver) UpdatedAt() *string {
+	return util.FormatTime(r.M.UpdatedAt)
+}
+
+func (r *EventTransformResolver) DeletedAt() *string {
+	return util.FormatTime(r.M.DeletedAt.Time)
+}
+
+func (r *EventTransformResolver) Token() string {
+	return r.M.Token
+}
+
+func (r *EventTransformResolver) Name() *string {
+	return util.NullStr(r.M.Name)
+}
+
+func (r *EventTransformResolver) Description() *string {
+	return util.NullStr(r.M.Description)
+}
+
+func (r *EventTransformResolver) Metadata() *string {
+	return util.MetadataStr(r.M.Metadata)
+}
+
+func (r *EventTransformResolver) DeviceType() *DeviceTypeResolver {
+	dt := model.DeviceType{}
+	if r.M.DeviceType != nil {
+		dt = *r.M.DeviceType
+	}
+	return &DeviceTypeResolver{
+		M: dt,
+		S: r.S,
+		C: r.C,
+	}
+}
+
+func (r *EventTransformResolver) Script() string {
+	return r.M.Script
+}
+
+func (r *EventTransformResolver) Disabled() bool {
+	return r.M.Disabled
+}
+
+// ---------------------------------------
+// Event transform search results resolver
+// ---------------------------------------
+
+type EventTransformSearchResultsResolver struct {
+	M model.EventTransformSearchResults
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *EventTransformSearchResultsResolver) Results() []*EventTransformResolver {
+	resolvers := make([]*EventTransformResolver, 0)
+	for _, current := range r.M.Results {
+		resolvers = append(resolvers,
+			&EventTransformResolver{
+				M: current,
+				S: r.S,
+				C: r.C,
+			})
+	}
+	return resolvers
+}
+
+func (r *EventTransformSearchResultsResolver) Pagination() *SearchResultsPaginationResolver {
+	return &SearchResultsPaginationResolver{
+		M: r.M.Pagination,
+		S: r.S,
+		C: r.C,
+	}
+}
+
+// -------------------------------------
+// Event transform test results resolver
+// -------------------------------------
+
+type EventTransformTestResultsResolver struct {
+	M model.EventTransformTestResults
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *EventTransformTestResultsResolver) Events() []*TransformedEventResolver {
+	resolvers := make([]*TransformedEventResolver, 0)
+	for _, current := range r.M.Events {
+		if payload, ok := current.Payload.(*esmodel.UnresolvedMeasurementsPayload); ok {
+			resolvers = append(resolvers,
+				&TransformedEventResolver{
+					M: *payload,
+					S: r.S,
+					C: r.C,
+				})
+		}
+	}
+	return resolvers
+}
+
+func (r *EventTransformTestResultsResolver) Error() *string {
+	return r.M.Error
+}
+
+// --------------------------
+// Transformed event resolver
+// --------------------------
+
+type TransformedEventResolver struct {
+	M esmodel.UnresolvedMeasurementsPayload
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *TransformedEventResolver) Entries() []*TransformedMeasurementsEntryResolver {
+	resolvers := make([]*TransformedMeasurementsEntryResolver, 0)
+	for _, current := range r.M.Entries {
+		resolvers = append(resolvers,
+			&TransformedMeasurementsEntryResolver{
+				M: current,
+				S: r.S,
+				C: r.C,
+			})
+	}
+	return resolvers
+}
+
+// ---------------------------------------
+// Transformed measurements entry resolver
+// ---------------------------------------
+
+type TransformedMeasurementsEntryResolver struct {
+	M esmodel.UnresolvedMeasurementsEntry
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *TransformedMeasurementsEntryResolver) Measurements() []*MeasurementValueResolver {
+	names := make([]string, 0)
+	for name := range r.M.Measurements {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	resolvers := make([]*MeasurementValueResolver, 0)
+	for _, name := range names {
+		resolvers = append(resolvers,
+			&MeasurementValueResolver{
+				M: model.MeasurementValue{Name: name, Value: r.M.Measurements[name]},
+				S: r.S,
+				C: r.C,
+			})
+	}
+	return resolvers
+}
+
+func (r *TransformedMeasurementsEntryResolver) OccurredTime() *string {
+	return r.M.OccurredTime
+}
+
+// ---------------------------
+// Measurement value resolver
+// ---------------------------
+
+type MeasurementValueResolver struct {
+	M model.MeasurementValue
+	S *SchemaResolver
+	C context.Context
+}
+
+func (r *MeasurementValueResolver) Name() string {
+	return r.M.Name
+}
+
+func (r *MeasurementValueResolver) Value() string {
+	return r.M.Value
+}
@@ -22,8 +22,19 @@ import (
 	"strconv"
 
 	"github.com/devicechain-io/dc-device-management/model"
+	"github.com/devicechain-io/dc-device-management/processor"
 	gqlcore "github.com/devicechain-io/dc-microservice/graphql"
 	"github.com/devicechain-io/dc-microservice/rdb"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	ContextInboundProcessorKey   gqlcore.ContextKey = "inbound-processor"
+	ContextFailedProcessorKey    gqlcore.ContextKey = "failed-processor"
+	ContextEventRouterKey        gqlcore.ContextKey = "event-router"
+	ContextEventTransformerKey   gqlcore.ContextKey = "event-transformer"
+	ContextAlertEvaluatorKey     gqlcore.ContextKey = "alert-evaluator"
+	ContextRelationshipFanOutKey gqlcore.ContextKey = "relationship-fan-out"
 )
 
 //go:embed schema.graphql
@@ -41,6 +52,67 @@ func (s *SchemaResolver) GetApi(ctx context.Context) *model.Api {
 	return ctx.Value(gqlcore.ContextApiKey).(*model.Api)
 }
 
+// Get inbound events processor from context (nil if not yet available).
+func (s *SchemaResolver) GetInboundEventsProcessor(ctx context.Context) *processor.InboundEventsProcessor {
+	if iproc, ok := ctx.Value(ContextInboundProcessorKey).(*processor.InboundEventsProcessor); ok {
+		return iproc
+	}
+	return nil
+}
+
+// Get failed events processor from context (nil if not yet available).
+func (s *SchemaResolver) GetFailedEventsProcessor(ctx context.Context) *processor.FailedEventsProcessor {
+	if fproc, ok := ctx.Value(ContextFailedProcessorKey).(*processor.FailedEventsProcessor); ok {
+		return fproc
+	}
+	return nil
+}
+
+// Get event router from context (nil if not yet available).
+func (s *SchemaResolver) GetEventRouter(ctx context.Context) *processor.EventRouter {
+	if router, ok := ctx.Value(ContextEventRouterKey).(*processor.EventRouter); ok {
+		return router
+	}
+	return nil
+}
+
+// Get event transformer from context (nil if not yet available).
+func (s *SchemaResolver) GetEventTransformer(ctx context.Context) *processor.EventTransformer {
+	if xformer, ok := ctx.Value(ContextEventTransformerKey).(*processor.EventTransformer); ok {
+		return xformer
+	}
+	return nil
+}
+
+// Get alert evaluator from context (nil if not yet available).
+func (s *SchemaResolver) GetAlertEvaluator(ctx context.Context) *processor.AlertEvaluator {
+	if alerts, ok := ctx.Value(ContextAlertEvaluatorKey).(*processor.AlertEvaluator); ok {
+		return alerts
+	}
+	return nil
+}
+
+// Get relationship fan-out from context (nil if not yet available).
+func (s *SchemaResolver) GetRelationshipFanOut(ctx context.Context) *processor.RelationshipFanOut {
+	if fanout, ok := ctx.Value(ContextRelationshipFanOutKey).(*processor.RelationshipFanOut); ok {
+		return fanout
+	}
+	return nil
+}
+
+// Reload tracked relationships so that changes apply to events processed by this instance right
+// away. Other instances pick up changes when they next reload.
+func (r *SchemaResolver) reloadTrackedRelationships(ctx context.Context) {
+	fanout := r.GetRelationshipFanOut(ctx)
+	if fanout == nil {
+		return
+	}
+	err := fanout.Reload(ctx)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to reload tracked relationships")
+	}
+}
+
 // Convert string ids to uint ids.
 func (r *SchemaResolver) asUintIds(val []string) ([]uint, error) {
 	ids := make([]uint, 0)
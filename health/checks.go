@@ -0,0 +1,186 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package health
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/devicechain-io/dc-device-management/processor"
+	kcore "github.com/devicechain-io/dc-microservice/kafka"
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"github.com/segmentio/kafka-go"
+)
+
+// Kafka reader that reports statistics.
+type readerStats interface {
+	Stats() kafka.ReaderStats
+}
+
+// Kafka writer that reports statistics.
+type writerStats interface {
+	Stats() kafka.WriterStats
+}
+
+// Check that the database accepts connections.
+func DatabaseCheck(rdbmgr *rdb.RdbManager) Check {
+	return Check{
+		Name: "database",
+		Run: func(ctx context.Context) (bool, string) {
+			sqldb, err := rdbmgr.Database.DB()
+			if err != nil {
+				return false, err.Error()
+			}
+			err = sqldb.PingContext(ctx)
+			if err != nil {
+				return false, err.Error()
+			}
+			return true, ""
+		},
+	}
+}
+
+// Check that passes once a flag has been set.
+func FlagCheck(name string, flag *Flag, unset string) Check {
+	return Check{
+		Name: name,
+		Run: func(ctx context.Context) (bool, string) {
+			if !flag.IsSet() {
+				return false, unset
+			}
+			return true, ""
+		},
+	}
+}
+
+// Check that a kafka reader is not failing and that consumer lag is below a maximum.
+// Error counts are reset each time the check runs, so it must only be used for readiness.
+func KafkaReaderCheck(name string, reader kcore.KafkaReader, maxLag int64) Check {
+	return Check{
+		Name: name,
+		Run: func(ctx context.Context) (bool, string) {
+			stats, ok := reader.(readerStats)
+			if !ok {
+				return true, "statistics not available"
+			}
+			rstats := stats.Stats()
+			detail := fmt.Sprintf("lag %d, %d errors since last check", rstats.Lag, rstats.Errors)
+			if rstats.Errors > 0 && rstats.Messages == 0 {
+				return false, detail
+			}
+			if maxLag > 0 && rstats.Lag > maxLag {
+				return false, detail
+			}
+			return true, detail
+		},
+	}
+}
+
+// Check that a kafka writer is not failing. Error counts are reset each time the check runs,
+// so it must only be used for readiness.
+func KafkaWriterCheck(name string, writer kcore.KafkaWriter) Check {
+	return Check{
+		Name: name,
+		Run: func(ctx context.Context) (bool, string) {
+			stats, ok := writer.(writerStats)
+			if !ok {
+				return true, "statistics not available"
+			}
+			wstats := stats.Stats()
+			detail := fmt.Sprintf("%d errors since last check", wstats.Errors)
+			if wstats.Errors > 0 && wstats.Messages == 0 {
+				return false, detail
+			}
+			return true, detail
+		},
+	}
+}
+
+// Check that failed events are not being lost. Fails while a failed event has been dropped within
+// the window after exhausting write retries.
+func DeliveryCheck(iproc *processor.InboundEventsProcessor, window time.Duration) Check {
+	return Check{
+		Name: "delivery",
+		Run: func(ctx context.Context) (bool, string) {
+			dropped := iproc.Status().LastDropped
+			if dropped.IsZero() {
+				return true, "no failed events dropped"
+			}
+			since := time.Since(dropped).Round(time.Millisecond)
+			detail := fmt.Sprintf("failed event dropped %s ago", since)
+			return since > window, detail
+		},
+	}
+}
+
+// Get the time since events last made progress (or since the pipeline started).
+func sinceLastProgress(status processor.PipelineStatus) time.Duration {
+	last := status.LastProgress
+	if last.IsZero() {
+		last = status.StartedAt
+	}
+	return time.Since(last)
+}
+
+// Check that the pipeline is not stuck. The pipeline is considered stuck if events are waiting
+// for resolution but none have been resolved or failed within the stall threshold. Events that
+// fail, such as those over the rate limit or those that fail while the database is unavailable,
+// still count as progress. Failure of this check fails liveness so that a stuck instance is
+// restarted.
+func PipelineCheck(iproc *processor.InboundEventsProcessor, stall time.Duration) Check {
+	return Check{
+		Name:     "pipeline",
+		Liveness: true,
+		Run: func(ctx context.Context) (bool, string) {
+			status := iproc.Status()
+			if !status.Running {
+				return true, "pipeline not running"
+			}
+			since := sinceLastProgress(status).Round(time.Millisecond)
+			detail := fmt.Sprintf("last progress %s ago", since)
+			if status.LastProgress.IsZero() {
+				detail = fmt.Sprintf("no events processed in %s since start", since)
+			}
+			waiting := status.Backlog("messages").Depth
+			if waiting > 0 && since > stall {
+				return false, fmt.Sprintf("%s with %d events waiting", detail, waiting)
+			}
+			return true, detail
+		},
+	}
+}
+
+// Check that no pipeline channel is saturated.
+func BacklogCheck(iproc *processor.InboundEventsProcessor) Check {
+	return Check{
+		Name: "backlogs",
+		Run: func(ctx context.Context) (bool, string) {
+			saturated := make([]string, 0)
+			for _, backlog := range iproc.Status().Backlogs {
+				if backlog.Saturated {
+					saturated = append(saturated, backlog.Name)
+				}
+			}
+			if len(saturated) > 0 {
+				return false, fmt.Sprintf("saturated: %s", strings.Join(saturated, ", "))
+			}
+			return true, ""
+		},
+	}
+}
@@ -0,0 +1,166 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// The health package serves liveness and readiness endpoints based on a set of checks
+// against the database, kafka and the event processing pipeline.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	LIVENESS_PATH  = "/healthz"
+	READINESS_PATH = "/readyz"
+
+	CHECK_TIMEOUT = 2 * time.Second // Maximum time allowed for each check
+)
+
+// Result of running a health check.
+type CheckResult struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+// Health check. Liveness checks cause both endpoints to fail, while other checks only
+// cause readiness to fail.
+type Check struct {
+	Name     string
+	Liveness bool
+	Run      func(context.Context) (bool, string)
+}
+
+// Response returned from health endpoints.
+type StatusResponse struct {
+	Status string        `json:"status"`
+	Checks []CheckResult `json:"checks"`
+}
+
+// Boolean flag that may be safely updated while checks are running.
+type Flag struct {
+	value int32
+}
+
+// Set flag value.
+func (flag *Flag) Set(value bool) {
+	if value {
+		atomic.StoreInt32(&flag.value, 1)
+	} else {
+		atomic.StoreInt32(&flag.value, 0)
+	}
+}
+
+// Get flag value.
+func (flag *Flag) IsSet() bool {
+	return atomic.LoadInt32(&flag.value) == 1
+}
+
+// Runs registered checks and serves the results.
+type HealthChecker struct {
+	Port int
+
+	mutex  sync.RWMutex
+	checks []Check
+	server *http.Server
+}
+
+// Create a new health checker.
+func NewHealthChecker(port int) *HealthChecker {
+	return &HealthChecker{
+		Port:   port,
+		checks: make([]Check, 0),
+	}
+}
+
+// Add a check.
+func (hc *HealthChecker) Register(check Check) {
+	hc.mutex.Lock()
+	defer hc.mutex.Unlock()
+	hc.checks = append(hc.checks, check)
+}
+
+// Run checks, which are only liveness checks when checking liveness. Checks that are not run
+// for liveness may have side effects (such as resetting kafka statistics) that must only be
+// seen by readiness. Results are healthy if no check fails.
+func (hc *HealthChecker) Run(ctx context.Context, liveness bool) (bool, []CheckResult) {
+	hc.mutex.RLock()
+	checks := append([]Check{}, hc.checks...)
+	hc.mutex.RUnlock()
+
+	healthy := true
+	results := make([]CheckResult, 0, len(checks))
+	for _, check := range checks {
+		if liveness && !check.Liveness {
+			continue
+		}
+		cctx, cancel := context.WithTimeout(ctx, CHECK_TIMEOUT)
+		ok, detail := check.Run(cctx)
+		cancel()
+		results = append(results, CheckResult{Name: check.Name, Healthy: ok, Detail: detail})
+		if !ok {
+			healthy = false
+		}
+	}
+	return healthy, results
+}
+
+// Handler that reports liveness (only liveness checks are run) or readiness (all checks must pass).
+func (hc *HealthChecker) Handler(liveness bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		healthy, results := hc.Run(r.Context(), liveness)
+		response := StatusResponse{Status: "ok", Checks: results}
+		code := http.StatusOK
+		if !healthy {
+			response.Status = "fail"
+			code = http.StatusServiceUnavailable
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode(response)
+	})
+}
+
+// Start serving health endpoints. A separate server is used so that probes are answered
+// while the rest of the microservice is still initializing.
+func (hc *HealthChecker) Start() {
+	mux := http.NewServeMux()
+	mux.Handle(LIVENESS_PATH, hc.Handler(true))
+	mux.Handle(READINESS_PATH, hc.Handler(false))
+	hc.server = &http.Server{Addr: fmt.Sprintf(":%d", hc.Port), Handler: mux}
+	go func() {
+		log.Info().Int("port", hc.Port).Msg("Starting health server.")
+		if err := hc.server.ListenAndServe(); err != http.ErrServerClosed {
+			log.Error().Err(err).Msg("Error starting health server.")
+		}
+	}()
+}
+
+// Stop serving health endpoints.
+func (hc *HealthChecker) Stop(ctx context.Context) error {
+	if hc.server == nil {
+		return nil
+	}
+	return hc.server.Shutdown(ctx)
+}
@@ -17,13 +17,25 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
 
 	gql "github.com/graph-gophers/graphql-go"
+	gqlotel "github.com/graph-gophers/graphql-go/trace/otel"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
 
 	"github.com/devicechain-io/dc-device-management/config"
 	"github.com/devicechain-io/dc-device-management/graphql"
+	"github.com/devicechain-io/dc-device-management/health"
 	"github.com/devicechain-io/dc-device-management/model"
 	"github.com/devicechain-io/dc-device-management/processor"
 	"github.com/devicechain-io/dc-device-management/schema"
@@ -32,6 +44,7 @@ import (
 	gqlcore "github.com/devicechain-io/dc-microservice/graphql"
 	kcore "github.com/devicechain-io/dc-microservice/kafka"
 	"github.com/devicechain-io/dc-microservice/rdb"
+	"github.com/rs/zerolog/log"
 )
 
 var (
@@ -47,8 +60,23 @@ var (
 
 	InboundEventsReader    kcore.KafkaReader
 	InboundEventsProcessor *processor.InboundEventsProcessor
+	FailedEventsReader     kcore.KafkaReader
+	FailedEventsProcessor  *processor.FailedEventsProcessor
 	ResolvedEventsWriter   kcore.KafkaWriter
 	FailedEventsWriter     kcore.KafkaWriter
+	ThrottleEventsWriter   kcore.KafkaWriter
+	ReplayEventsWriter     kcore.KafkaWriter
+	RateLimiter            *processor.RateLimiter
+	RelationshipFanOut     *processor.RelationshipFanOut
+	EventRouter            *processor.EventRouter
+	EventTransformer       *processor.EventTransformer
+	AlertEvaluator         *processor.AlertEvaluator
+
+	TracerProvider         *sdktrace.TracerProvider
+	HealthChecker          *health.HealthChecker
+	MigrationsComplete     health.Flag
+	KafkaStarted           health.Flag
+	StopConfigurationWatch context.CancelFunc
 )
 
 func main() {
@@ -76,7 +104,16 @@ func main() {
 
 // Parses the configuration from raw bytes.
 func parseConfiguration() error {
-	config := &config.DeviceManagementConfiguration{}
+	config := &config.DeviceManagementConfiguration{
+		Retry:         config.NewRetryConfiguration(),
+		Processor:     config.NewProcessorConfiguration(),
+		Deduplication: config.NewDeduplicationConfiguration(),
+		RateLimits:    config.NewRateLimitConfiguration(),
+		Tracing:       config.NewTracingConfiguration(),
+		Health:        config.NewHealthConfiguration(),
+		Reload:        config.NewReloadConfiguration(),
+		FanOut:        config.NewFanOutConfiguration(),
+	}
 	err := json.Unmarshal(Microservice.MicroserviceConfigurationRaw, config)
 	if err != nil {
 		return err
@@ -85,6 +122,107 @@ func parseConfiguration() error {
 	return nil
 }
 
+// Writes must be acknowledged before inbound offsets are committed, so writers block until delivery.
+func synchronousWriter(writer kcore.KafkaWriter) kcore.KafkaWriter {
+	return processor.SynchronousWriter(writer, Configuration.Processor)
+}
+
+// Periodically check for microservice configuration changes and apply processor sizing.
+func watchConfiguration(ctx context.Context) {
+	path := fmt.Sprintf("%s/%s", config.MICROSERVICE_CONFIG_PATH, os.Getenv(core.ENV_MS_FUNCTIONAL_AREA))
+	ticker := time.NewTicker(config.CONFIG_RELOAD_INTERVAL)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			content, err := os.ReadFile(path)
+			if err != nil || bytes.Equal(content, Microservice.MicroserviceConfigurationRaw) {
+				continue
+			}
+			err = reloadConfiguration()
+			if err != nil {
+				log.Error().Err(err).Msg("unable to reload microservice configuration")
+			}
+		}
+	}
+}
+
+// Reload microservice configuration and resize processing if sizing changed.
+func reloadConfiguration() error {
+	previous := Configuration
+	err := Microservice.ReloadMicroserviceConfiguration()
+	if err != nil {
+		return err
+	}
+	err = parseConfiguration()
+	if err != nil {
+		return err
+	}
+	RateLimiter.SetDefault(Configuration.RateLimits)
+	RelationshipFanOut.SetConfiguration(Configuration.FanOut)
+	if previous != nil && previous.Processor == Configuration.Processor {
+		return nil
+	}
+	return InboundEventsProcessor.Resize(Configuration.Processor)
+}
+
+// Configure trace context propagation and, if enabled, export of spans to a trace collector.
+func initializeTracing(ctx context.Context) error {
+	otel.SetTextMapPropagator(processor.NewTraceContextPropagator())
+	tcfg := Configuration.Tracing
+	if !tcfg.Enabled {
+		return nil
+	}
+
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(tcfg.Endpoint)}
+	if tcfg.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return err
+	}
+	TracerProvider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(tcfg.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceNameKey.String(Microservice.FunctionalArea),
+			semconv.ServiceInstanceIDKey.String(Microservice.InstanceId))))
+	otel.SetTracerProvider(TracerProvider)
+	return nil
+}
+
+// Create deduplicator for inbound events based on configuration (nil if disabled).
+func createDeduplicator() *processor.Deduplicator {
+	dcfg := Configuration.Deduplication
+	if !dcfg.Enabled {
+		return nil
+	}
+	window := time.Duration(dcfg.WindowMs) * time.Millisecond
+	pending := time.Duration(dcfg.PendingMs) * time.Millisecond
+	var detector processor.DuplicateDetector
+	switch dcfg.Backend {
+	case config.DEDUP_BACKEND_REDIS:
+		detector = processor.NewRedisDuplicateDetector(Microservice, window, pending)
+	default:
+		detector = processor.NewMemoryDuplicateDetector(window, pending, dcfg.CacheSize)
+	}
+	return processor.NewDeduplicator(Microservice, detector)
+}
+
+// Create a writer for a topic that resolved events are routed to.
+func createRoutedWriter(kmgr *kcore.KafkaManager) processor.RoutedWriterFactory {
+	return func(topic string) (kcore.KafkaWriter, error) {
+		writer, err := kmgr.NewWriter(kmgr.NewScopedTopic(topic))
+		if err != nil {
+			return nil, err
+		}
+		return synchronousWriter(writer), nil
+	}
+}
+
 // Create kafka components used by this microservice.
 func createKafkaComponents(kmgr *kcore.KafkaManager) error {
 	// Create reader for inbound events.
@@ -101,22 +239,101 @@ func createKafkaComponents(kmgr *kcore.KafkaManager) error {
 	if err != nil {
 		return err
 	}
-	ResolvedEventsWriter = revents
+	ResolvedEventsWriter = synchronousWriter(revents)
 
 	// Add and initialize failed events writer.
 	fevents, err := kmgr.NewWriter(kmgr.NewScopedTopic(config.KAFKA_TOPIC_FAILED_EVENTS))
 	if err != nil {
 		return err
 	}
-	FailedEventsWriter = fevents
+	FailedEventsWriter = synchronousWriter(fevents)
+
+	// Add and initialize throttle events writer.
+	tevents, err := kmgr.NewWriter(kmgr.NewScopedTopic(config.KAFKA_TOPIC_THROTTLE_EVENTS))
+	if err != nil {
+		return err
+	}
+	ThrottleEventsWriter = tevents
+
+	// Add and initialize writer used to requeue held events on the inbound topic.
+	replay, err := kmgr.NewWriter(kmgr.NewScopedTopic(esconfig.KAFKA_TOPIC_INBOUND_EVENTS))
+	if err != nil {
+		return err
+	}
+	ReplayEventsWriter = synchronousWriter(replay)
+
+	// Report health of kafka readers and writers.
+	HealthChecker.Register(health.KafkaReaderCheck("inbound-events-reader", InboundEventsReader,
+		Configuration.Health.MaxConsumerLag))
+	HealthChecker.Register(health.KafkaWriterCheck("resolved-events-writer", ResolvedEventsWriter))
+	HealthChecker.Register(health.KafkaWriterCheck("failed-events-writer", FailedEventsWriter))
 
 	// Add and initialize inbound events processor.
+	RateLimiter = processor.NewRateLimiter(Microservice, Configuration.RateLimits)
+	RelationshipFanOut = processor.NewRelationshipFanOut(Microservice, Api, Configuration.FanOut)
+	err = RelationshipFanOut.Reload(context.Background())
+	if err != nil {
+		return err
+	}
+	EventRouter = processor.NewEventRouter(Microservice, Api, createRoutedWriter(kmgr))
+	err = EventRouter.Reload(context.Background())
+	if err != nil {
+		return err
+	}
+	EventTransformer = processor.NewEventTransformer(Microservice, Api)
+	err = EventTransformer.Reload(context.Background())
+	if err != nil {
+		return err
+	}
+	AlertEvaluator = processor.NewAlertEvaluator(Microservice, Api)
+	err = AlertEvaluator.Reload(context.Background())
+	if err != nil {
+		return err
+	}
 	InboundEventsProcessor = processor.NewInboundEventsProcessor(Microservice, InboundEventsReader,
-		ResolvedEventsWriter, FailedEventsWriter, core.NewNoOpLifecycleCallbacks(), Api)
+		ResolvedEventsWriter, FailedEventsWriter, ThrottleEventsWriter, Configuration.Processor,
+		Configuration.Retry, processor.PipelineStages{
+			Dedup:       createDeduplicator(),
+			Limiter:     RateLimiter,
+			Router:      EventRouter,
+			Transformer: EventTransformer,
+			Alerts:      AlertEvaluator,
+			FanOut:      RelationshipFanOut,
+		}, core.NewNoOpLifecycleCallbacks(), Api)
+	InboundEventsProcessor.ReplayEventsWriter = ReplayEventsWriter
 	err = InboundEventsProcessor.Initialize(context.Background())
 	if err != nil {
 		return err
 	}
+	stall := time.Duration(Configuration.Health.StallThresholdMs) * time.Millisecond
+	HealthChecker.Register(health.PipelineCheck(InboundEventsProcessor, stall))
+	HealthChecker.Register(health.BacklogCheck(InboundEventsProcessor))
+	HealthChecker.Register(health.DeliveryCheck(InboundEventsProcessor, stall))
+
+	// Create reader for failed events.
+	fevreader, err := kmgr.NewReader(
+		kmgr.NewScopedConsumerGroup(config.KAFKA_TOPIC_FAILED_EVENTS),
+		kmgr.NewScopedTopic(config.KAFKA_TOPIC_FAILED_EVENTS))
+	if err != nil {
+		return err
+	}
+	FailedEventsReader = fevreader
+
+	// Add and initialize failed events processor.
+	FailedEventsProcessor = processor.NewFailedEventsProcessor(Microservice, FailedEventsReader,
+		Configuration.Retry, InboundEventsProcessor.RequeueEvents, core.NewNoOpLifecycleCallbacks(), Api)
+	err = FailedEventsProcessor.Initialize(context.Background())
+	if err != nil {
+		return err
+	}
+
+	// Make processors available to graphql resolvers.
+	GraphQLManager.ContextProviders[graphql.ContextInboundProcessorKey] = InboundEventsProcessor
+	GraphQLManager.ContextProviders[graphql.ContextFailedProcessorKey] = FailedEventsProcessor
+	GraphQLManager.ContextProviders[graphql.ContextEventRouterKey] = EventRouter
+	GraphQLManager.ContextProviders[graphql.ContextEventTransformerKey] = EventTransformer
+	GraphQLManager.ContextProviders[graphql.ContextAlertEvaluatorKey] = AlertEvaluator
+	GraphQLManager.ContextProviders[graphql.ContextRelationshipFanOutKey] = RelationshipFanOut
 
 	return nil
 }
@@ -129,6 +346,18 @@ func afterMicroserviceInitialized(ctx context.Context) error {
 		return err
 	}
 
+	// Set up tracing before components that create spans.
+	err = initializeTracing(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Serve health endpoints while remaining components initialize.
+	HealthChecker = health.NewHealthChecker(Configuration.Health.Port)
+	HealthChecker.Register(health.FlagCheck("migrations", &MigrationsComplete, "migrations running"))
+	HealthChecker.Register(health.FlagCheck("kafka", &KafkaStarted, "kafka manager not started"))
+	HealthChecker.Start()
+
 	// Create and initialize rdb manager.
 	rdbcb := core.NewNoOpLifecycleCallbacks()
 	RdbManager = rdb.NewRdbManager(Microservice, rdbcb, schema.Migrations,
@@ -137,12 +366,21 @@ func afterMicroserviceInitialized(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	MigrationsComplete.Set(true)
+	HealthChecker.Register(health.DatabaseCheck(RdbManager))
 
 	// Create RDB caches.
 	model.InitializeCaches(RdbManager)
 
 	// Wrap api around rdb manager.
-	Api = model.NewApi(RdbManager)
+	Api = model.NewApi(RdbManager, Microservice.TenantId)
+	Api.CaKeyEncryptionKey, err = config.LoadCaKeyEncryptionKey()
+	if err != nil {
+		return err
+	}
+	if Api.CaKeyEncryptionKey == nil {
+		log.Warn().Msgf("%s is not set, so device certificates can not be issued", config.ENV_CA_KEY_ENCRYPTION_KEY)
+	}
 	CachedApi = model.NewCachedApi(Api)
 
 	// Create and initialize kafka manager.
@@ -152,6 +390,9 @@ func afterMicroserviceInitialized(ctx context.Context) error {
 		return err
 	}
 
+	// Publish certificate revocation list alongside graphql endpoints.
+	http.Handle(graphql.CRL_PATH, graphql.NewCrlHandler(Api))
+
 	// Map of providers that will be injected into graphql http context.
 	providers := map[gqlcore.ContextKey]interface{}{
 		gqlcore.ContextRdbKey: RdbManager,
@@ -162,7 +403,7 @@ func afterMicroserviceInitialized(ctx context.Context) error {
 	gqlcb := core.NewNoOpLifecycleCallbacks()
 
 	schema := graphql.SchemaContent
-	parsed := gql.MustParseSchema(schema, &graphql.SchemaResolver{})
+	parsed := gql.MustParseSchema(schema, &graphql.SchemaResolver{}, gql.Tracer(gqlotel.DefaultTracer()))
 	GraphQLManager = gqlcore.NewGraphQLManager(Microservice, gqlcb, *parsed, providers)
 	err = GraphQLManager.Initialize(ctx)
 	if err != nil {
@@ -179,35 +420,82 @@ func afterMicroserviceStarted(ctx context.Context) error {
 		return err
 	}
 
-	err = GraphQLManager.Start(ctx)
+	// Start kafka manager (before graphql since it registers context providers).
+	err = KakfaManager.Start(ctx)
 	if err != nil {
 		return err
 	}
+	KafkaStarted.Set(true)
 
-	// Start kafka manager.
-	err = KakfaManager.Start(ctx)
+	// Start inbound events processor.
+	err = InboundEventsProcessor.Start(ctx)
 	if err != nil {
 		return err
 	}
 
-	// Start inbound events processor.
-	err = InboundEventsProcessor.Start(ctx)
+	// Start failed events processor.
+	err = FailedEventsProcessor.Start(ctx)
 	if err != nil {
 		return err
 	}
 
+	err = startGraphQL(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Apply configuration changes without restarting.
+	reloadctx, cancel := context.WithCancel(context.Background())
+	StopConfigurationWatch = cancel
+	go watchConfiguration(reloadctx)
+	go processor.WatchCaches(reloadctx, time.Duration(Configuration.Reload.IntervalMs)*time.Millisecond,
+		processor.WatchedCache{Name: "event routing rules", Cache: EventRouter},
+		processor.WatchedCache{Name: "event transforms", Cache: EventTransformer},
+		processor.WatchedCache{Name: "alert rules", Cache: AlertEvaluator},
+		processor.WatchedCache{Name: "tracked relationships", Cache: RelationshipFanOut})
+
+	return nil
+}
+
+// Start the graphql manager with its endpoint wrapped so that changes are attributed to the actor
+// making the request. The manager registers its handlers with the default mux, so they are
+// collected on a separate mux that the default mux delegates to.
+func startGraphQL(ctx context.Context) error {
+	root := http.DefaultServeMux
+	gqlmux := http.NewServeMux()
+	http.DefaultServeMux = gqlmux
+	err := GraphQLManager.Start(ctx)
+	http.DefaultServeMux = root
+	if err != nil {
+		return err
+	}
+	root.Handle("/graphql", graphql.NewActorHandler(gqlmux))
+	root.Handle("/graphiql", gqlmux)
+	root.Handle("/metrics", gqlmux)
 	return nil
 }
 
 // Called before microservice has been stopped.
 func beforeMicroserviceStopped(ctx context.Context) error {
+	// Stop watching for configuration changes.
+	if StopConfigurationWatch != nil {
+		StopConfigurationWatch()
+	}
+
+	// Stop failed events processor.
+	err := FailedEventsProcessor.Stop(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Stop inbound events processor.
-	err := InboundEventsProcessor.Stop(ctx)
+	err = InboundEventsProcessor.Stop(ctx)
 	if err != nil {
 		return err
 	}
 
 	// Stop kafka manager.
+	KafkaStarted.Set(false)
 	err = KakfaManager.Stop(ctx)
 	if err != nil {
 		return err
@@ -230,8 +518,14 @@ func beforeMicroserviceStopped(ctx context.Context) error {
 
 // Called before microservice has been terminated.
 func beforeMicroserviceTerminated(ctx context.Context) error {
+	// Terminate failed events processor.
+	err := FailedEventsProcessor.Terminate(ctx)
+	if err != nil {
+		return err
+	}
+
 	// Terminate inbound events processor.
-	err := InboundEventsProcessor.Terminate(ctx)
+	err = InboundEventsProcessor.Terminate(ctx)
 	if err != nil {
 		return err
 	}
@@ -254,5 +548,19 @@ func beforeMicroserviceTerminated(ctx context.Context) error {
 		return err
 	}
 
+	// Stop serving health endpoints.
+	err = HealthChecker.Stop(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Flush any spans that have not been exported.
+	if TracerProvider != nil {
+		err = TracerProvider.Shutdown(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
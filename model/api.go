@@ -20,19 +20,35 @@ import (
 	"context"
 
 	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
 )
 
 type Api struct {
-	RDB *rdb.RdbManager
+	RDB                *rdb.RdbManager
+	Tenant             string
+	CaKeyEncryptionKey []byte // AES-256 key used to encrypt certificate authority keys
 }
 
 // Create a new API instance.
-func NewApi(rdb *rdb.RdbManager) *Api {
+func NewApi(rdb *rdb.RdbManager, tenant string) *Api {
 	api := &Api{}
 	api.RDB = rdb
+	api.Tenant = tenant
 	return api
 }
 
+// Get a copy of the api that runs all database operations against the given handle, such
+// as a transaction shared by several operations.
+func (api *Api) withDatabase(db *gorm.DB) *Api {
+	manager := *api.RDB
+	manager.Database = db
+	return &Api{
+		RDB:                &manager,
+		Tenant:             api.Tenant,
+		CaKeyEncryptionKey: api.CaKeyEncryptionKey,
+	}
+}
+
 // Interface for device management API (used for mocking)
 type DeviceManagementApi interface {
 	// Device types.
@@ -50,4 +66,33 @@ type DeviceManagementApi interface {
 	DeviceRelationshipsByToken(ctx context.Context, tokens []string) ([]*DeviceRelationship, error)
 	DeviceRelationships(ctx context.Context, criteria DeviceRelationshipSearchCriteria) (*DeviceRelationshipSearchResults, error)
 	CreateDeviceRelationship(ctx context.Context, request *DeviceRelationshipCreateRequest) (*DeviceRelationship, error)
+
+	// Asset, area and customer relationships.
+	AssetRelationships(ctx context.Context, criteria AssetRelationshipSearchCriteria) (*AssetRelationshipSearchResults, error)
+	AreaRelationships(ctx context.Context, criteria AreaRelationshipSearchCriteria) (*AreaRelationshipSearchResults, error)
+	CustomerRelationships(ctx context.Context, criteria CustomerRelationshipSearchCriteria) (*CustomerRelationshipSearchResults, error)
+
+	// Pending devices.
+	RecordPendingDeviceEvent(ctx context.Context, request *PendingDeviceEventCreateRequest) (*PendingDevice, bool, error)
+
+	// Dead letter events.
+	RecordDeadLetterEvent(ctx context.Context, request *DeadLetterEventCreateRequest) (*DeadLetterEvent, error)
+	CountReplayableDeadLetterEvents(ctx context.Context, job *FailedEventReplayJob) (int64, error)
+	ReplayableDeadLetterEvents(ctx context.Context, job *FailedEventReplayJob, limit int) ([]*DeadLetterEvent, error)
+	QuarantineDeadLetterEvent(ctx context.Context, event *DeadLetterEvent) error
+	MarkDeadLetterEventsReplayed(ctx context.Context, events []*DeadLetterEvent) error
+	CreateFailedEventReplayJob(ctx context.Context, request *FailedEventReplayRequest) (*FailedEventReplayJob, error)
+	UpdateFailedEventReplayJob(ctx context.Context, job *FailedEventReplayJob) error
+
+	// Event routing rules.
+	EventRoutingRules(ctx context.Context, criteria EventRoutingRuleSearchCriteria) (*EventRoutingRuleSearchResults, error)
+
+	// Event transforms.
+	EventTransforms(ctx context.Context, criteria EventTransformSearchCriteria) (*EventTransformSearchResults, error)
+
+	// Alert rules.
+	AlertRules(ctx context.Context, criteria AlertRuleSearchCriteria) (*AlertRuleSearchResults, error)
+
+	// Alerts.
+	RecordAlertOccurrence(ctx context.Context, request *AlertOccurrenceRequest) (*Alert, bool, error)
 }
@@ -0,0 +1,224 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+)
+
+// Validate an alert rule comparator.
+func alertComparatorOf(comparator string) (string, error) {
+	for _, allowed := range AlertComparators {
+		if comparator == allowed {
+			return allowed, nil
+		}
+	}
+	return "", fmt.Errorf("unknown alert comparator: %s", comparator)
+}
+
+// Apply the values from a create request to an alert rule.
+func (api *Api) applyAlertRule(ctx context.Context, request *AlertRuleCreateRequest, rule *AlertRule) error {
+	if (request.DeviceType == nil) == (request.Device == nil) {
+		return errors.New("alert rule must reference either a device type or a device")
+	}
+	if request.Measurement == "" {
+		return errors.New("alert rule measurement is required")
+	}
+	if request.AlertType == "" {
+		return errors.New("alert rule alert type is required")
+	}
+	if request.Level < 0 {
+		return errors.New("alert rule level may not be negative")
+	}
+	if request.DurationMs != nil && *request.DurationMs < 0 {
+		return errors.New("alert rule duration may not be negative")
+	}
+	if request.Hysteresis != nil && *request.Hysteresis < 0 {
+		return errors.New("alert rule hysteresis may not be negative")
+	}
+	comparator, err := alertComparatorOf(request.Comparator)
+	if err != nil {
+		return err
+	}
+
+	rule.Token = request.Token
+	rule.Name = rdb.NullStrOf(request.Name)
+	rule.Description = rdb.NullStrOf(request.Description)
+	rule.Metadata = rdb.MetadataStrOf(request.Metadata)
+	rule.Measurement = request.Measurement
+	rule.Comparator = comparator
+	rule.Threshold = request.Threshold
+	rule.DurationMs = 0
+	if request.DurationMs != nil {
+		rule.DurationMs = *request.DurationMs
+	}
+	rule.Hysteresis = 0
+	if request.Hysteresis != nil {
+		rule.Hysteresis = *request.Hysteresis
+	}
+	rule.AlertType = request.AlertType
+	rule.Level = uint32(request.Level)
+	rule.Message = rdb.NullStrOf(request.Message)
+	rule.Disabled = request.Disabled != nil && *request.Disabled
+
+	rule.DeviceTypeId, rule.DeviceType = nil, nil
+	if request.DeviceType != nil {
+		matches, err := api.DeviceTypesByToken(ctx, []string{*request.DeviceType})
+		if err != nil {
+			return err
+		}
+		if len(matches) == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		rule.DeviceTypeId, rule.DeviceType = &matches[0].ID, matches[0]
+	}
+	rule.DeviceId, rule.Device = nil, nil
+	if request.Device != nil {
+		matches, err := api.DevicesByToken(ctx, []string{*request.Device})
+		if err != nil {
+			return err
+		}
+		if len(matches) == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		rule.DeviceId, rule.Device = &matches[0].ID, matches[0]
+	}
+	return nil
+}
+
+// Add preloads for entities referenced by alert rules.
+func preloadAlertRuleReferences(db *gorm.DB) *gorm.DB {
+	return db.Preload("DeviceType").Preload("Device")
+}
+
+// Create a new alert rule.
+func (api *Api) CreateAlertRule(ctx context.Context, request *AlertRuleCreateRequest) (*AlertRule, error) {
+	created := &AlertRule{}
+	err := api.applyAlertRule(ctx, request, created)
+	if err != nil {
+		return nil, err
+	}
+	err = api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// Update an existing alert rule.
+func (api *Api) UpdateAlertRule(ctx context.Context, token string,
+	request *AlertRuleCreateRequest) (*AlertRule, error) {
+	matches, err := api.AlertRulesByToken(ctx, []string{token})
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	updated := matches[0]
+	before := auditSnapshot(updated)
+	err = api.applyAlertRule(ctx, request, updated)
+	if err != nil {
+		return nil, err
+	}
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Delete an existing alert rule.
+func (api *Api) DeleteAlertRule(ctx context.Context, token string) (*AlertRule, error) {
+	matches, err := api.AlertRulesByToken(ctx, []string{token})
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	deleted := matches[0]
+	err = api.audited(ctx, AUDIT_OPERATION_DELETE, deleted.Token, deleted, nil, func(tx *gorm.DB) error {
+		return tx.Delete(deleted).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+// Get alert rules by id.
+func (api *Api) AlertRulesById(ctx context.Context, ids []uint) ([]*AlertRule, error) {
+	found := make([]*AlertRule, 0)
+	result := preloadAlertRuleReferences(api.RDB.Database)
+	result = result.Find(&found, ids)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return found, nil
+}
+
+// Get alert rules by token.
+func (api *Api) AlertRulesByToken(ctx context.Context, tokens []string) ([]*AlertRule, error) {
+	found := make([]*AlertRule, 0)
+	result := preloadAlertRuleReferences(api.RDB.Database)
+	result = result.Find(&found, "token in ?", tokens)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return found, nil
+}
+
+// Search for alert rules that meet criteria. Disabled rules are excluded unless requested.
+func (api *Api) AlertRules(ctx context.Context, criteria AlertRuleSearchCriteria) (*AlertRuleSearchResults, error) {
+	results := make([]AlertRule, 0)
+	db, pag := api.RDB.ListOf(&AlertRule{}, func(result *gorm.DB) *gorm.DB {
+		if criteria.DeviceType != nil {
+			result = result.Where("device_type_id = (?)",
+				api.RDB.Database.Model(&DeviceType{}).Select("id").Where("token = ?", criteria.DeviceType))
+		}
+		if criteria.Device != nil {
+			result = result.Where("device_id = (?)",
+				api.RDB.Database.Model(&Device{}).Select("id").Where("token = ?", criteria.Device))
+		}
+		if criteria.IncludeDisabled == nil || !*criteria.IncludeDisabled {
+			result = result.Where("disabled = ?", false)
+		}
+		return preloadAlertRuleReferences(result)
+	}, criteria.Pagination)
+	db.Find(&results)
+	if db.Error != nil {
+		return nil, db.Error
+	}
+
+	// Wrap as search results.
+	return &AlertRuleSearchResults{
+		Results:    results,
+		Pagination: pag,
+	}, nil
+}
@@ -0,0 +1,376 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Indicates whether an alert occurrence falls within a suppression window for the device.
+func (api *Api) alertSuppressed(request *AlertOccurrenceRequest, at time.Time) (bool, error) {
+	db := api.RDB.Database
+	targeted := db.Model(&DeviceRelationship{}).Select("target_device_group_id").
+		Where("source_device_id = ? and target_device_group_id is not null", request.DeviceId)
+	members := db.Model(&DeviceGroupRelationship{}).Select("source_device_group_id").
+		Where("target_device_id = ?", request.DeviceId)
+	count := int64(0)
+	result := db.Model(&AlertSuppression{}).
+		Where("start_time <= ? and end_time > ?", at, at).
+		Where("alert_type is null or alert_type = ?", request.AlertType).
+		Where(db.Where("device_id = ?", request.DeviceId).
+			Or("device_group_id in (?)", targeted).
+			Or("device_group_id in (?)", members)).
+		Count(&count)
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return count > 0, nil
+}
+
+// Record an occurrence of an alert for a device. The occurrence is counted against the
+// unresolved alert of the same type for the device if one exists, otherwise a new alert is
+// opened. Returns false if the occurrence was not recorded because it was suppressed.
+func (api *Api) RecordAlertOccurrence(ctx context.Context, request *AlertOccurrenceRequest) (*Alert, bool, error) {
+	occurred := request.OccurredTime
+	if occurred.IsZero() {
+		occurred = time.Now()
+	}
+	suppressed, err := api.alertSuppressed(request, occurred)
+	if err != nil {
+		return nil, false, err
+	}
+	if suppressed {
+		return nil, false, nil
+	}
+
+	alert := &Alert{}
+	err = api.RDB.Database.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("device_id = ? and alert_type = ? and state <> ?", request.DeviceId, request.AlertType,
+				ALERT_STATE_RESOLVED).
+			Limit(1).Find(alert)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			alert = &Alert{
+				TokenReference: rdb.TokenReference{
+					Token: uuid.New().String(),
+				},
+				DeviceId:      request.DeviceId,
+				AlertType:     request.AlertType,
+				State:         ALERT_STATE_OPEN,
+				FirstOccurred: occurred,
+			}
+		}
+		alert.Level = request.Level
+		alert.Message = rdb.NullStrOf(&request.Message)
+		alert.Source = rdb.NullStrOf(&request.Source)
+		alert.Occurrences++
+		if occurred.After(alert.LastOccurred) {
+			alert.LastOccurred = occurred
+		}
+		return tx.Save(alert).Error
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return alert, true, nil
+}
+
+// Move an alert to a new state if the transition is allowed.
+func (api *Api) changeAlertState(ctx context.Context, token string, state string,
+	request *AlertStateChangeRequest) (*Alert, error) {
+	matches, err := api.AlertsByToken(ctx, []string{token})
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	updated := matches[0]
+	before := auditSnapshot(updated)
+	if request == nil {
+		request = &AlertStateChangeRequest{}
+	}
+	now := time.Now()
+	switch state {
+	case ALERT_STATE_ACKNOWLEDGED:
+		if updated.State != ALERT_STATE_OPEN {
+			return nil, fmt.Errorf("alert may not be acknowledged when %s", updated.State)
+		}
+		updated.AcknowledgedAt = sql.NullTime{Time: now, Valid: true}
+		updated.AcknowledgedBy = rdb.NullStrOf(request.Actor)
+	case ALERT_STATE_RESOLVED:
+		if updated.State == ALERT_STATE_RESOLVED {
+			return nil, errors.New("alert is already resolved")
+		}
+		updated.ResolvedAt = sql.NullTime{Time: now, Valid: true}
+		updated.ResolvedBy = rdb.NullStrOf(request.Actor)
+		updated.ResolutionComment = rdb.NullStrOf(request.Comment)
+	}
+	updated.State = state
+
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Acknowledge an open alert.
+func (api *Api) AcknowledgeAlert(ctx context.Context, token string, request *AlertStateChangeRequest) (*Alert, error) {
+	return api.changeAlertState(ctx, token, ALERT_STATE_ACKNOWLEDGED, request)
+}
+
+// Resolve an open or acknowledged alert.
+func (api *Api) ResolveAlert(ctx context.Context, token string, request *AlertStateChangeRequest) (*Alert, error) {
+	return api.changeAlertState(ctx, token, ALERT_STATE_RESOLVED, request)
+}
+
+// Get alerts by id.
+func (api *Api) AlertsById(ctx context.Context, ids []uint) ([]*Alert, error) {
+	found := make([]*Alert, 0)
+	result := api.RDB.Database.Preload("Device")
+	result = result.Find(&found, ids)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return found, nil
+}
+
+// Get alerts by token.
+func (api *Api) AlertsByToken(ctx context.Context, tokens []string) ([]*Alert, error) {
+	found := make([]*Alert, 0)
+	result := api.RDB.Database.Preload("Device")
+	result = result.Find(&found, "token in ?", tokens)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return found, nil
+}
+
+// Search for alerts that have not been resolved. Customer and area criteria match alerts for
+// devices with a relationship targeting the customer or area.
+func (api *Api) ActiveAlerts(ctx context.Context, criteria AlertSearchCriteria) (*AlertSearchResults, error) {
+	results := make([]Alert, 0)
+	db, pag := api.RDB.ListOf(&Alert{}, func(result *gorm.DB) *gorm.DB {
+		result = result.Where("state <> ?", ALERT_STATE_RESOLVED)
+		if criteria.State != nil {
+			result = result.Where("state = ?", criteria.State)
+		}
+		if criteria.Device != nil {
+			result = result.Where("device_id = (?)",
+				api.RDB.Database.Model(&Device{}).Select("id").Where("token = ?", criteria.Device))
+		}
+		if criteria.Customer != nil {
+			result = result.Where("device_id in (?)",
+				api.RDB.Database.Model(&DeviceRelationship{}).Select("source_device_id").Where("target_customer_id = (?)",
+					api.RDB.Database.Model(&Customer{}).Select("id").Where("token = ?", criteria.Customer)))
+		}
+		if criteria.Area != nil {
+			result = result.Where("device_id in (?)",
+				api.RDB.Database.Model(&DeviceRelationship{}).Select("source_device_id").Where("target_area_id = (?)",
+					api.RDB.Database.Model(&Area{}).Select("id").Where("token = ?", criteria.Area)))
+		}
+		if criteria.AlertType != nil {
+			result = result.Where("alert_type = ?", criteria.AlertType)
+		}
+		if criteria.MinLevel != nil {
+			result = result.Where("level >= ?", criteria.MinLevel)
+		}
+		return result.Preload("Device")
+	}, criteria.Pagination)
+	db = db.Order("level desc").Order("last_occurred desc").Find(&results)
+	if db.Error != nil {
+		return nil, db.Error
+	}
+
+	// Wrap as search results.
+	return &AlertSearchResults{
+		Results:    results,
+		Pagination: pag,
+	}, nil
+}
+
+// Apply the values from a create request to an alert suppression window.
+func (api *Api) applyAlertSuppression(ctx context.Context, request *AlertSuppressionCreateRequest,
+	suppression *AlertSuppression) error {
+	if (request.Device == nil) == (request.DeviceGroup == nil) {
+		return errors.New("alert suppression must reference either a device or a device group")
+	}
+	start, err := time.Parse(time.RFC3339, request.StartTime)
+	if err != nil {
+		return err
+	}
+	end, err := time.Parse(time.RFC3339, request.EndTime)
+	if err != nil {
+		return err
+	}
+	if !end.After(start) {
+		return errors.New("alert suppression must end after it starts")
+	}
+
+	suppression.Token = request.Token
+	suppression.Name = rdb.NullStrOf(request.Name)
+	suppression.Description = rdb.NullStrOf(request.Description)
+	suppression.Metadata = rdb.MetadataStrOf(request.Metadata)
+	suppression.AlertType = rdb.NullStrOf(request.AlertType)
+	suppression.StartTime = start
+	suppression.EndTime = end
+
+	suppression.DeviceId, suppression.Device = nil, nil
+	if request.Device != nil {
+		matches, err := api.DevicesByToken(ctx, []string{*request.Device})
+		if err != nil {
+			return err
+		}
+		if len(matches) == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		suppression.DeviceId, suppression.Device = &matches[0].ID, matches[0]
+	}
+	suppression.DeviceGroupId, suppression.DeviceGroup = nil, nil
+	if request.DeviceGroup != nil {
+		matches, err := api.DeviceGroupsByToken(ctx, []string{*request.DeviceGroup})
+		if err != nil {
+			return err
+		}
+		if len(matches) == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		suppression.DeviceGroupId, suppression.DeviceGroup = &matches[0].ID, matches[0]
+	}
+	return nil
+}
+
+// Create a new alert suppression window.
+func (api *Api) CreateAlertSuppression(ctx context.Context,
+	request *AlertSuppressionCreateRequest) (*AlertSuppression, error) {
+	created := &AlertSuppression{}
+	err := api.applyAlertSuppression(ctx, request, created)
+	if err != nil {
+		return nil, err
+	}
+	err = api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// Update an existing alert suppression window.
+func (api *Api) UpdateAlertSuppression(ctx context.Context, token string,
+	request *AlertSuppressionCreateRequest) (*AlertSuppression, error) {
+	matches, err := api.AlertSuppressionsByToken(ctx, []string{token})
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	updated := matches[0]
+	before := auditSnapshot(updated)
+	err = api.applyAlertSuppression(ctx, request, updated)
+	if err != nil {
+		return nil, err
+	}
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Delete an existing alert suppression window.
+func (api *Api) DeleteAlertSuppression(ctx context.Context, token string) (*AlertSuppression, error) {
+	matches, err := api.AlertSuppressionsByToken(ctx, []string{token})
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	deleted := matches[0]
+	err = api.audited(ctx, AUDIT_OPERATION_DELETE, deleted.Token, deleted, nil, func(tx *gorm.DB) error {
+		return tx.Delete(deleted).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+// Get alert suppression windows by token.
+func (api *Api) AlertSuppressionsByToken(ctx context.Context, tokens []string) ([]*AlertSuppression, error) {
+	found := make([]*AlertSuppression, 0)
+	result := api.RDB.Database.Preload("Device").Preload("DeviceGroup")
+	result = result.Find(&found, "token in ?", tokens)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return found, nil
+}
+
+// Search for alert suppression windows that meet criteria. Windows that have ended are
+// excluded unless requested.
+func (api *Api) AlertSuppressions(ctx context.Context,
+	criteria AlertSuppressionSearchCriteria) (*AlertSuppressionSearchResults, error) {
+	results := make([]AlertSuppression, 0)
+	db, pag := api.RDB.ListOf(&AlertSuppression{}, func(result *gorm.DB) *gorm.DB {
+		if criteria.Device != nil {
+			result = result.Where("device_id = (?)",
+				api.RDB.Database.Model(&Device{}).Select("id").Where("token = ?", criteria.Device))
+		}
+		if criteria.DeviceGroup != nil {
+			result = result.Where("device_group_id = (?)",
+				api.RDB.Database.Model(&DeviceGroup{}).Select("id").Where("token = ?", criteria.DeviceGroup))
+		}
+		if criteria.IncludeEnded == nil || !*criteria.IncludeEnded {
+			result = result.Where("end_time > ?", time.Now())
+		}
+		return result.Preload("Device").Preload("DeviceGroup")
+	}, criteria.Pagination)
+	db = db.Order("start_time").Find(&results)
+	if db.Error != nil {
+		return nil, db.Error
+	}
+
+	// Wrap as search results.
+	return &AlertSuppressionSearchResults{
+		Results:    results,
+		Pagination: pag,
+	}, nil
+}
@@ -44,9 +44,11 @@ func (api *Api) CreateAreaType(ctx context.Context, request *AreaTypeCreateReque
 			Metadata: rdb.MetadataStrOf(request.Metadata),
 		},
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err := api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -63,6 +65,7 @@ func (api *Api) UpdateAreaType(ctx context.Context, token string,
 	}
 
 	found := matches[0]
+	before := auditSnapshot(found)
 	found.Token = request.Token
 	found.Name = rdb.NullStrOf(request.Name)
 	found.Description = rdb.NullStrOf(request.Description)
@@ -73,9 +76,11 @@ func (api *Api) UpdateAreaType(ctx context.Context, token string,
 	found.BorderColor = rdb.NullStrOf(request.BorderColor)
 	found.Metadata = rdb.MetadataStrOf(request.Metadata)
 
-	result := api.RDB.Database.Save(found)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, found.Token, before, found, func(tx *gorm.DB) error {
+		return tx.Save(found).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return found, nil
 }
@@ -139,9 +144,11 @@ func (api *Api) CreateArea(ctx context.Context, request *AreaCreateRequest) (*Ar
 		},
 		AreaType: atmatches[0],
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -158,6 +165,7 @@ func (api *Api) UpdateArea(ctx context.Context, token string, request *AreaCreat
 
 	// Update fields that changed.
 	updated := matches[0]
+	before := auditSnapshot(updated)
 	updated.Token = request.Token
 	updated.Name = rdb.NullStrOf(request.Name)
 	updated.Description = rdb.NullStrOf(request.Description)
@@ -175,9 +183,11 @@ func (api *Api) UpdateArea(ctx context.Context, token string, request *AreaCreat
 		updated.AreaType = atmatches[0]
 	}
 
-	result := api.RDB.Database.Save(updated)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return updated, nil
 }
@@ -230,6 +240,11 @@ func (api *Api) Areas(ctx context.Context, criteria AreaSearchCriteria) (*AreaSe
 
 // Create a new area relationship type.
 func (api *Api) CreateAreaRelationshipType(ctx context.Context, request *AreaRelationshipTypeCreateRequest) (*AreaRelationshipType, error) {
+	constraints, err := relationshipConstraintsOf(request.RelationshipConstraintsCreateRequest)
+	if err != nil {
+		return nil, err
+	}
+
 	created := &AreaRelationshipType{
 		TokenReference: rdb.TokenReference{
 			Token: request.Token,
@@ -241,10 +256,14 @@ func (api *Api) CreateAreaRelationshipType(ctx context.Context, request *AreaRel
 		MetadataEntity: rdb.MetadataEntity{
 			Metadata: rdb.MetadataStrOf(request.Metadata),
 		},
+		Tracked:                 request.Tracked != nil && *request.Tracked,
+		RelationshipConstraints: constraints,
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -260,15 +279,25 @@ func (api *Api) UpdateAreaRelationshipType(ctx context.Context, token string,
 		return nil, gorm.ErrRecordNotFound
 	}
 
+	constraints, err := relationshipConstraintsOf(request.RelationshipConstraintsCreateRequest)
+	if err != nil {
+		return nil, err
+	}
+
 	updated := artmatches[0]
+	before := auditSnapshot(updated)
 	updated.Token = request.Token
 	updated.Name = rdb.NullStrOf(request.Name)
 	updated.Description = rdb.NullStrOf(request.Description)
 	updated.Metadata = rdb.MetadataStrOf(request.Metadata)
+	updated.Tracked = request.Tracked != nil && *request.Tracked
+	updated.RelationshipConstraints = constraints
 
-	result := api.RDB.Database.Save(updated)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return updated, nil
 }
@@ -352,10 +381,20 @@ func (api *Api) CreateAreaRelationship(ctx context.Context, request *AreaRelatio
 		SourceArea:       *mmap[request.SourceArea],
 		RelationshipType: *artmatches[0],
 	}
-	api.resolveRelationshipTargets(ctx, request.Targets, &created.EntityRelationship)
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.resolveRelationshipTargets(ctx, request.Targets, &created.EntityRelationship)
+	if err != nil {
+		return nil, err
+	}
+	var stype *string
+	if created.SourceArea.AreaType != nil {
+		stype = &created.SourceArea.AreaType.Token
+	}
+	rtype := created.RelationshipType
+	ref := relationshipTypeRef{Id: rtype.ID, Token: rtype.Token, Constraints: rtype.RelationshipConstraints}
+	err = api.createConstrainedRelationship(ctx, ENTITY_KIND_AREA, "source_area_id",
+		created.SourceArea.ID, stype, ref, created)
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -389,6 +428,10 @@ func (api *Api) AreaRelationshipsByToken(ctx context.Context, tokens []string) (
 // Search for area relationships that meet criteria.
 func (api *Api) AreaRelationships(ctx context.Context,
 	criteria AreaRelationshipSearchCriteria) (*AreaRelationshipSearchResults, error) {
+	err := validateRelationshipTarget(criteria.EntityRelationshipTargetCriteria)
+	if err != nil {
+		return nil, err
+	}
 	results := make([]AreaRelationship, 0)
 	db, pag := api.RDB.ListOf(&AreaRelationship{}, func(result *gorm.DB) *gorm.DB {
 		if criteria.SourceArea != nil {
@@ -399,6 +442,11 @@ func (api *Api) AreaRelationships(ctx context.Context,
 			result = result.Where("relationship_type_id = (?)",
 				api.RDB.Database.Model(&AreaRelationshipType{}).Select("id").Where("token = ?", criteria.RelationshipType))
 		}
+		if criteria.Tracked != nil {
+			result = result.Where("relationship_type_id in (?)",
+				api.RDB.Database.Model(&AreaRelationshipType{}).Select("id").Where("tracked = ?", criteria.Tracked))
+		}
+		result = api.whereRelationshipTarget(result, criteria.EntityRelationshipTargetCriteria)
 		return result
 	}, criteria.Pagination)
 	db.Preload("SourceArea").Preload("RelationshipType")
@@ -436,9 +484,11 @@ func (api *Api) CreateAreaGroup(ctx context.Context, request *AreaGroupCreateReq
 			Metadata: rdb.MetadataStrOf(request.Metadata),
 		},
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err := api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -455,6 +505,7 @@ func (api *Api) UpdateAreaGroup(ctx context.Context, token string,
 	}
 
 	updated := matches[0]
+	before := auditSnapshot(updated)
 	updated.Token = request.Token
 	updated.Name = rdb.NullStrOf(request.Name)
 	updated.Description = rdb.NullStrOf(request.Description)
@@ -465,9 +516,11 @@ func (api *Api) UpdateAreaGroup(ctx context.Context, token string,
 	updated.BorderColor = rdb.NullStrOf(request.BorderColor)
 	updated.Metadata = rdb.MetadataStrOf(request.Metadata)
 
-	result := api.RDB.Database.Save(updated)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return updated, nil
 }
@@ -511,6 +564,11 @@ func (api *Api) AreaGroups(ctx context.Context, criteria AreaGroupSearchCriteria
 // Create a new area group relationship type.
 func (api *Api) CreateAreaGroupRelationshipType(ctx context.Context,
 	request *AreaGroupRelationshipTypeCreateRequest) (*AreaGroupRelationshipType, error) {
+	constraints, err := relationshipConstraintsOf(request.RelationshipConstraintsCreateRequest)
+	if err != nil {
+		return nil, err
+	}
+
 	created := &AreaGroupRelationshipType{
 		TokenReference: rdb.TokenReference{
 			Token: request.Token,
@@ -522,10 +580,13 @@ func (api *Api) CreateAreaGroupRelationshipType(ctx context.Context,
 		MetadataEntity: rdb.MetadataEntity{
 			Metadata: rdb.MetadataStrOf(request.Metadata),
 		},
+		RelationshipConstraints: constraints,
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -541,15 +602,24 @@ func (api *Api) UpdateAreaGroupRelationshipType(ctx context.Context, token strin
 		return nil, gorm.ErrRecordNotFound
 	}
 
+	constraints, err := relationshipConstraintsOf(request.RelationshipConstraintsCreateRequest)
+	if err != nil {
+		return nil, err
+	}
+
 	updated := matches[0]
+	before := auditSnapshot(updated)
 	updated.Token = request.Token
 	updated.Name = rdb.NullStrOf(request.Name)
 	updated.Description = rdb.NullStrOf(request.Description)
 	updated.Metadata = rdb.MetadataStrOf(request.Metadata)
+	updated.RelationshipConstraints = constraints
 
-	result := api.RDB.Database.Save(updated)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return updated, nil
 }
@@ -625,10 +695,16 @@ func (api *Api) CreateAreaGroupRelationship(ctx context.Context,
 		SourceAreaGroup:  *agmatches[0],
 		RelationshipType: *agrtmatches[0],
 	}
-	api.resolveRelationshipTargets(ctx, request.Targets, &created.EntityRelationship)
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.resolveRelationshipTargets(ctx, request.Targets, &created.EntityRelationship)
+	if err != nil {
+		return nil, err
+	}
+	rtype := created.RelationshipType
+	ref := relationshipTypeRef{Id: rtype.ID, Token: rtype.Token, Constraints: rtype.RelationshipConstraints}
+	err = api.createConstrainedRelationship(ctx, ENTITY_KIND_AREA_GROUP, "source_area_group_id",
+		created.SourceAreaGroup.ID, nil, ref, created)
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -662,6 +738,10 @@ func (api *Api) AreaGroupRelationshipsByToken(ctx context.Context, tokens []stri
 // Search for area group relationships that meet criteria.
 func (api *Api) AreaGroupRelationships(ctx context.Context,
 	criteria AreaGroupRelationshipSearchCriteria) (*AreaGroupRelationshipSearchResults, error) {
+	err := validateRelationshipTarget(criteria.EntityRelationshipTargetCriteria)
+	if err != nil {
+		return nil, err
+	}
 	results := make([]AreaGroupRelationship, 0)
 	db, pag := api.RDB.ListOf(&AreaGroupRelationship{}, func(result *gorm.DB) *gorm.DB {
 		if criteria.SourceAreaGroup != nil {
@@ -672,6 +752,7 @@ func (api *Api) AreaGroupRelationships(ctx context.Context,
 			result = result.Where("relationship_type_id = (?)",
 				api.RDB.Database.Model(&AreaGroupRelationshipType{}).Select("id").Where("token = ?", criteria.RelationshipType))
 		}
+		result = api.whereRelationshipTarget(result, criteria.EntityRelationshipTargetCriteria)
 		return result
 	}, criteria.Pagination)
 	db.Preload("SourceAreaGroup").Preload("RelationshipType")
@@ -44,9 +44,11 @@ func (api *Api) CreateAssetType(ctx context.Context, request *AssetTypeCreateReq
 			Metadata: rdb.MetadataStrOf(request.Metadata),
 		},
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err := api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -63,6 +65,7 @@ func (api *Api) UpdateAssetType(ctx context.Context, token string,
 	}
 
 	found := matches[0]
+	before := auditSnapshot(found)
 	found.Token = request.Token
 	found.Name = rdb.NullStrOf(request.Name)
 	found.Description = rdb.NullStrOf(request.Description)
@@ -73,9 +76,11 @@ func (api *Api) UpdateAssetType(ctx context.Context, token string,
 	found.BorderColor = rdb.NullStrOf(request.BorderColor)
 	found.Metadata = rdb.MetadataStrOf(request.Metadata)
 
-	result := api.RDB.Database.Save(found)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, found.Token, before, found, func(tx *gorm.DB) error {
+		return tx.Save(found).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return found, nil
 }
@@ -139,9 +144,11 @@ func (api *Api) CreateAsset(ctx context.Context, request *AssetCreateRequest) (*
 		},
 		AssetType: matches[0],
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -158,6 +165,7 @@ func (api *Api) UpdateAsset(ctx context.Context, token string, request *AssetCre
 
 	// Update fields that changed.
 	updated := matches[0]
+	before := auditSnapshot(updated)
 	updated.Token = request.Token
 	updated.Name = rdb.NullStrOf(request.Name)
 	updated.Description = rdb.NullStrOf(request.Description)
@@ -175,9 +183,11 @@ func (api *Api) UpdateAsset(ctx context.Context, token string, request *AssetCre
 		updated.AssetType = matches[0]
 	}
 
-	result := api.RDB.Database.Save(updated)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return updated, nil
 }
@@ -230,6 +240,11 @@ func (api *Api) Assets(ctx context.Context, criteria AssetSearchCriteria) (*Asse
 
 // Create a new asset relationship type.
 func (api *Api) CreateAssetRelationshipType(ctx context.Context, request *AssetRelationshipTypeCreateRequest) (*AssetRelationshipType, error) {
+	constraints, err := relationshipConstraintsOf(request.RelationshipConstraintsCreateRequest)
+	if err != nil {
+		return nil, err
+	}
+
 	created := &AssetRelationshipType{
 		TokenReference: rdb.TokenReference{
 			Token: request.Token,
@@ -241,10 +256,14 @@ func (api *Api) CreateAssetRelationshipType(ctx context.Context, request *AssetR
 		MetadataEntity: rdb.MetadataEntity{
 			Metadata: rdb.MetadataStrOf(request.Metadata),
 		},
+		Tracked:                 request.Tracked != nil && *request.Tracked,
+		RelationshipConstraints: constraints,
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -260,15 +279,25 @@ func (api *Api) UpdateAssetRelationshipType(ctx context.Context, token string,
 		return nil, gorm.ErrRecordNotFound
 	}
 
+	constraints, err := relationshipConstraintsOf(request.RelationshipConstraintsCreateRequest)
+	if err != nil {
+		return nil, err
+	}
+
 	updated := matches[0]
+	before := auditSnapshot(updated)
 	updated.Token = request.Token
 	updated.Name = rdb.NullStrOf(request.Name)
 	updated.Description = rdb.NullStrOf(request.Description)
 	updated.Metadata = rdb.MetadataStrOf(request.Metadata)
+	updated.Tracked = request.Tracked != nil && *request.Tracked
+	updated.RelationshipConstraints = constraints
 
-	result := api.RDB.Database.Save(updated)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return updated, nil
 }
@@ -352,10 +381,20 @@ func (api *Api) CreateAssetRelationship(ctx context.Context, request *AssetRelat
 		SourceAsset:      *mmap[request.SourceAsset],
 		RelationshipType: *artmatches[0],
 	}
-	api.resolveRelationshipTargets(ctx, request.Targets, &created.EntityRelationship)
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.resolveRelationshipTargets(ctx, request.Targets, &created.EntityRelationship)
+	if err != nil {
+		return nil, err
+	}
+	var stype *string
+	if created.SourceAsset.AssetType != nil {
+		stype = &created.SourceAsset.AssetType.Token
+	}
+	rtype := created.RelationshipType
+	ref := relationshipTypeRef{Id: rtype.ID, Token: rtype.Token, Constraints: rtype.RelationshipConstraints}
+	err = api.createConstrainedRelationship(ctx, ENTITY_KIND_ASSET, "source_asset_id",
+		created.SourceAsset.ID, stype, ref, created)
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -389,6 +428,10 @@ func (api *Api) AssetRelationshipsByToken(ctx context.Context, tokens []string)
 // Search for asset relationships that meet criteria.
 func (api *Api) AssetRelationships(ctx context.Context,
 	criteria AssetRelationshipSearchCriteria) (*AssetRelationshipSearchResults, error) {
+	err := validateRelationshipTarget(criteria.EntityRelationshipTargetCriteria)
+	if err != nil {
+		return nil, err
+	}
 	results := make([]AssetRelationship, 0)
 	db, pag := api.RDB.ListOf(&AssetRelationship{}, func(result *gorm.DB) *gorm.DB {
 		if criteria.SourceAsset != nil {
@@ -399,6 +442,11 @@ func (api *Api) AssetRelationships(ctx context.Context,
 			result = result.Where("relationship_type_id = (?)",
 				api.RDB.Database.Model(&AssetRelationshipType{}).Select("id").Where("token = ?", criteria.RelationshipType))
 		}
+		if criteria.Tracked != nil {
+			result = result.Where("relationship_type_id in (?)",
+				api.RDB.Database.Model(&AssetRelationshipType{}).Select("id").Where("tracked = ?", criteria.Tracked))
+		}
+		result = api.whereRelationshipTarget(result, criteria.EntityRelationshipTargetCriteria)
 		return result
 	}, criteria.Pagination)
 	db.Preload("SourceAsset").Preload("RelationshipType")
@@ -436,9 +484,11 @@ func (api *Api) CreateAssetGroup(ctx context.Context, request *AssetGroupCreateR
 			Metadata: rdb.MetadataStrOf(request.Metadata),
 		},
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err := api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -455,6 +505,7 @@ func (api *Api) UpdateAssetGroup(ctx context.Context, token string,
 	}
 
 	updated := matches[0]
+	before := auditSnapshot(updated)
 	updated.Token = request.Token
 	updated.Name = rdb.NullStrOf(request.Name)
 	updated.Description = rdb.NullStrOf(request.Description)
@@ -465,9 +516,11 @@ func (api *Api) UpdateAssetGroup(ctx context.Context, token string,
 	updated.BorderColor = rdb.NullStrOf(request.BorderColor)
 	updated.Metadata = rdb.MetadataStrOf(request.Metadata)
 
-	result := api.RDB.Database.Save(updated)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return updated, nil
 }
@@ -511,6 +564,11 @@ func (api *Api) AssetGroups(ctx context.Context, criteria AssetGroupSearchCriter
 // Create a new asset group relationship type.
 func (api *Api) CreateAssetGroupRelationshipType(ctx context.Context,
 	request *AssetGroupRelationshipTypeCreateRequest) (*AssetGroupRelationshipType, error) {
+	constraints, err := relationshipConstraintsOf(request.RelationshipConstraintsCreateRequest)
+	if err != nil {
+		return nil, err
+	}
+
 	created := &AssetGroupRelationshipType{
 		TokenReference: rdb.TokenReference{
 			Token: request.Token,
@@ -522,10 +580,13 @@ func (api *Api) CreateAssetGroupRelationshipType(ctx context.Context,
 		MetadataEntity: rdb.MetadataEntity{
 			Metadata: rdb.MetadataStrOf(request.Metadata),
 		},
+		RelationshipConstraints: constraints,
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -541,15 +602,24 @@ func (api *Api) UpdateAssetGroupRelationshipType(ctx context.Context, token stri
 		return nil, gorm.ErrRecordNotFound
 	}
 
+	constraints, err := relationshipConstraintsOf(request.RelationshipConstraintsCreateRequest)
+	if err != nil {
+		return nil, err
+	}
+
 	updated := matches[0]
+	before := auditSnapshot(updated)
 	updated.Token = request.Token
 	updated.Name = rdb.NullStrOf(request.Name)
 	updated.Description = rdb.NullStrOf(request.Description)
 	updated.Metadata = rdb.MetadataStrOf(request.Metadata)
+	updated.RelationshipConstraints = constraints
 
-	result := api.RDB.Database.Save(updated)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return updated, nil
 }
@@ -625,10 +695,16 @@ func (api *Api) CreateAssetGroupRelationship(ctx context.Context,
 		SourceAssetGroup: *agmatches[0],
 		RelationshipType: *agrmatches[0],
 	}
-	api.resolveRelationshipTargets(ctx, request.Targets, &created.EntityRelationship)
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.resolveRelationshipTargets(ctx, request.Targets, &created.EntityRelationship)
+	if err != nil {
+		return nil, err
+	}
+	rtype := created.RelationshipType
+	ref := relationshipTypeRef{Id: rtype.ID, Token: rtype.Token, Constraints: rtype.RelationshipConstraints}
+	err = api.createConstrainedRelationship(ctx, ENTITY_KIND_ASSET_GROUP, "source_asset_group_id",
+		created.SourceAssetGroup.ID, nil, ref, created)
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -662,6 +738,10 @@ func (api *Api) AssetGroupRelationshipsByToken(ctx context.Context, tokens []str
 // Search for asset group relationships that meet criteria.
 func (api *Api) AssetGroupRelationships(ctx context.Context,
 	criteria AssetGroupRelationshipSearchCriteria) (*AssetGroupRelationshipSearchResults, error) {
+	err := validateRelationshipTarget(criteria.EntityRelationshipTargetCriteria)
+	if err != nil {
+		return nil, err
+	}
 	results := make([]AssetGroupRelationship, 0)
 	db, pag := api.RDB.ListOf(&AssetGroupRelationship{}, func(result *gorm.DB) *gorm.DB {
 		if criteria.SourceAssetGroup != nil {
@@ -672,6 +752,7 @@ func (api *Api) AssetGroupRelationships(ctx context.Context,
 			result = result.Where("relationship_type_id = (?)",
 				api.RDB.Database.Model(&AssetGroupRelationshipType{}).Select("id").Where("token = ?", criteria.RelationshipType))
 		}
+		result = api.whereRelationshipTarget(result, criteria.EntityRelationshipTargetCriteria)
 		return result
 	}, criteria.Pagination)
 	db.Preload("SourceAssetGroup").Preload("RelationshipType")
@@ -0,0 +1,215 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"time"
+
+	gqlcore "github.com/devicechain-io/dc-microservice/graphql"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+const (
+	ContextActorKey gqlcore.ContextKey = "actor" // Request context key for the actor making changes
+)
+
+var (
+	// Bookkeeping fields never recorded in audit snapshots.
+	auditExcludedFields = map[string]bool{
+		"ID":        true,
+		"CreatedAt": true,
+		"UpdatedAt": true,
+		"DeletedAt": true,
+	}
+
+	// Fields not recorded in audit snapshots of each kind of entity, either because they hold
+	// secrets or because they are encoded certificates identified by other recorded fields.
+	auditExcludedEntityFields = map[string]map[string]bool{
+		"CertificateAuthority": {"PrivateKey": true, "Certificate": true},
+		"DeviceCertificate":    {"Certificate": true},
+		"DeviceCredential":     {"Value": true},
+	}
+)
+
+// Get the actor responsible for changes made with the given context.
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(ContextActorKey).(string); ok && actor != "" {
+		return actor
+	}
+	return AUDIT_SYSTEM_ACTOR
+}
+
+// Capture the auditable fields of an entity. Nullable values are unwrapped and
+// associated entities are omitted since their ids are captured separately.
+func auditSnapshot(entity interface{}) map[string]interface{} {
+	if entity == nil {
+		return nil
+	}
+	if snapshot, ok := entity.(map[string]interface{}); ok {
+		return snapshot
+	}
+	raw, err := json.Marshal(entity)
+	if err != nil {
+		return nil
+	}
+	fields := make(map[string]interface{})
+	err = json.Unmarshal(raw, &fields)
+	if err != nil {
+		return nil
+	}
+
+	excluded := auditExcludedEntityFields[auditKind(entity)]
+	snapshot := make(map[string]interface{})
+	for name, value := range fields {
+		if auditExcludedFields[name] || excluded[name] {
+			continue
+		}
+		switch typed := value.(type) {
+		case map[string]interface{}:
+			if valid, ok := typed["Valid"]; ok {
+				snapshot[name] = nil
+				if valid == true {
+					for key, inner := range typed {
+						if key != "Valid" {
+							snapshot[name] = inner
+						}
+					}
+				}
+			} else if _, ok := typed["ID"]; !ok {
+				snapshot[name] = typed
+			}
+		case []interface{}:
+			continue
+		default:
+			snapshot[name] = typed
+		}
+	}
+	return snapshot
+}
+
+// Compute the fields that differ between snapshots.
+func auditDiff(before map[string]interface{}, after map[string]interface{}) map[string]map[string]interface{} {
+	diff := make(map[string]map[string]interface{})
+	for name, value := range before {
+		if !reflect.DeepEqual(value, after[name]) {
+			diff[name] = map[string]interface{}{"before": value, "after": after[name]}
+		}
+	}
+	for name, value := range after {
+		if _, ok := before[name]; !ok && value != nil {
+			diff[name] = map[string]interface{}{"before": nil, "after": value}
+		}
+	}
+	return diff
+}
+
+// Get the kind of entity being audited.
+func auditKind(entities ...interface{}) string {
+	for _, entity := range entities {
+		if entity == nil {
+			continue
+		}
+		if _, ok := entity.(map[string]interface{}); ok {
+			continue
+		}
+		return reflect.Indirect(reflect.ValueOf(entity)).Type().Name()
+	}
+	return ""
+}
+
+// Record an audit entry for a change made through the API. Values for before and after may be
+// entities or snapshots taken with auditSnapshot. Entries should be recorded in the same
+// transaction as the change so that the change is rolled back if it can not be audited.
+func (api *Api) audit(ctx context.Context, operation string, token string, before interface{},
+	after interface{}) error {
+	changes, err := json.Marshal(auditDiff(auditSnapshot(before), auditSnapshot(after)))
+	if err != nil {
+		return err
+	}
+	entry := &AuditEntry{
+		Actor:       ActorFromContext(ctx),
+		Tenant:      api.Tenant,
+		EntityKind:  auditKind(after, before),
+		EntityToken: token,
+		Operation:   operation,
+		Changes:     datatypes.JSON(changes),
+		OccurredAt:  time.Now(),
+	}
+	return api.RDB.Database.Create(entry).Error
+}
+
+// Apply a change and record an audit entry for it in a single transaction. The after value is
+// captured once the change has been applied.
+func (api *Api) audited(ctx context.Context, operation string, token string, before interface{},
+	after interface{}, change func(tx *gorm.DB) error) error {
+	return api.RDB.Database.Transaction(func(tx *gorm.DB) error {
+		err := change(tx)
+		if err != nil {
+			return err
+		}
+		return api.withDatabase(tx).audit(ctx, operation, token, before, after)
+	})
+}
+
+// Search for audit entries that meet criteria.
+func (api *Api) AuditLog(ctx context.Context, criteria AuditEntrySearchCriteria) (*AuditEntrySearchResults, error) {
+	since, err := parseOptionalTime(criteria.Since)
+	if err != nil {
+		return nil, err
+	}
+	until, err := parseOptionalTime(criteria.Until)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]AuditEntry, 0)
+	db, pag := api.RDB.ListOf(&AuditEntry{}, func(result *gorm.DB) *gorm.DB {
+		if criteria.EntityKind != nil {
+			result = result.Where("entity_kind = ?", criteria.EntityKind)
+		}
+		if criteria.EntityToken != nil {
+			result = result.Where("entity_token = ?", criteria.EntityToken)
+		}
+		if criteria.Actor != nil {
+			result = result.Where("actor = ?", criteria.Actor)
+		}
+		if criteria.Operation != nil {
+			result = result.Where("operation = ?", criteria.Operation)
+		}
+		if since.Valid {
+			result = result.Where("occurred_at >= ?", since.Time)
+		}
+		if until.Valid {
+			result = result.Where("occurred_at < ?", until.Time)
+		}
+		return result
+	}, criteria.Pagination)
+	db = db.Order("occurred_at desc").Find(&results)
+	if db.Error != nil {
+		return nil, db.Error
+	}
+
+	// Wrap as search results.
+	return &AuditEntrySearchResults{
+		Results:    results,
+		Pagination: pag,
+	}, nil
+}
@@ -0,0 +1,63 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"testing"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type AuditTestSuite struct {
+	suite.Suite
+}
+
+// Test secret fields are omitted only for the entities that hold them.
+func (suite *AuditTestSuite) TestSecretsExcludedByEntity() {
+	credential := auditSnapshot(&DeviceCredential{
+		TokenReference: rdb.TokenReference{Token: "cred1"},
+		CredentialType: CREDENTIAL_TYPE_X509_FINGERPRINT,
+		Value:          "secret",
+	})
+	assert.Equal(suite.T(), "cred1", credential["Token"])
+	assert.NotContains(suite.T(), credential, "Value")
+	assert.NotContains(suite.T(), credential, "ID")
+
+	measurement := auditSnapshot(&MeasurementValue{Value: "42"})
+	assert.Equal(suite.T(), "42", measurement["Value"])
+
+	ca := auditSnapshot(&CertificateAuthority{Tenant: "tenant1", PrivateKey: "key"})
+	assert.Equal(suite.T(), "tenant1", ca["Tenant"])
+	assert.NotContains(suite.T(), ca, "PrivateKey")
+}
+
+// Test only changed fields are recorded.
+func (suite *AuditTestSuite) TestDiff() {
+	before := map[string]interface{}{"Threshold": 10.0, "Comparator": "gt"}
+	after := map[string]interface{}{"Threshold": 20.0, "Comparator": "gt"}
+	diff := auditDiff(before, after)
+	assert.Equal(suite.T(), 1, len(diff))
+	assert.Equal(suite.T(), 10.0, diff["Threshold"]["before"])
+	assert.Equal(suite.T(), 20.0, diff["Threshold"]["after"])
+}
+
+// Run all tests.
+func TestAuditTestSuite(t *testing.T) {
+	suite.Run(t, new(AuditTestSuite))
+}
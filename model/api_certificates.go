@@ -0,0 +1,470 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"database/sql"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Generate a random certificate serial number.
+func generateSerialNumber() (*big.Int, error) {
+	limit := new(big.Int).Lsh(big.NewInt(1), 128)
+	return rand.Int(rand.Reader, limit)
+}
+
+// Create the cipher used to encrypt certificate authority keys at rest.
+func (api *Api) caKeyCipher() (cipher.AEAD, error) {
+	if len(api.CaKeyEncryptionKey) == 0 {
+		return nil, ErrCaKeyEncryptionKeyMissing
+	}
+	block, err := aes.NewCipher(api.CaKeyEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Encrypt a certificate authority key. The tenant is bound to the ciphertext so that a key can
+// not be copied to another tenant's authority.
+func (api *Api) sealCaKey(tenant string, keyder []byte) (string, error) {
+	aead, err := api.caKeyCipher()
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, keyder, []byte(tenant))
+	return string(pem.EncodeToMemory(&pem.Block{Type: CA_KEY_PEM_TYPE_ENCRYPTED, Bytes: sealed})), nil
+}
+
+// Decrypt the key for a certificate authority. Keys stored before encryption was added are
+// returned as is.
+func (api *Api) openCaKey(ca *CertificateAuthority) ([]byte, error) {
+	keyblock, _ := pem.Decode([]byte(ca.PrivateKey))
+	if keyblock == nil {
+		return nil, errors.New("unable to decode certificate authority key")
+	}
+	if keyblock.Type == CA_KEY_PEM_TYPE_PLAIN {
+		return keyblock.Bytes, nil
+	}
+	if keyblock.Type != CA_KEY_PEM_TYPE_ENCRYPTED {
+		return nil, fmt.Errorf("unsupported certificate authority key type: %s", keyblock.Type)
+	}
+	aead, err := api.caKeyCipher()
+	if err != nil {
+		return nil, err
+	}
+	if len(keyblock.Bytes) < aead.NonceSize() {
+		return nil, errors.New("certificate authority key is truncated")
+	}
+	nonce, sealed := keyblock.Bytes[:aead.NonceSize()], keyblock.Bytes[aead.NonceSize():]
+	return aead.Open(nil, nonce, sealed, []byte(ca.Tenant))
+}
+
+// Generate a new self-signed certificate authority for a tenant.
+func (api *Api) generateCertificateAuthority(tenant string) (*CertificateAuthority, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	serial, err := generateSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName:   fmt.Sprintf("%s Device CA", tenant),
+			Organization: []string{tenant},
+		},
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.AddDate(CA_VALIDITY_YEARS, 0, 0),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+	keyder, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	sealed, err := api.sealCaKey(tenant, keyder)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CertificateAuthority{
+		Tenant:      tenant,
+		Certificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		PrivateKey:  sealed,
+	}, nil
+}
+
+// Parse the certificate and signing key for a certificate authority.
+func (api *Api) parseCertificateAuthority(ca *CertificateAuthority) (*x509.Certificate, crypto.Signer, error) {
+	certblock, _ := pem.Decode([]byte(ca.Certificate))
+	if certblock == nil {
+		return nil, nil, errors.New("unable to decode certificate authority certificate")
+	}
+	cert, err := x509.ParseCertificate(certblock.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyder, err := api.openCaKey(ca)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := x509.ParseECPrivateKey(keyder)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, key, nil
+}
+
+// Get the certificate authority for the tenant, creating it if it does not exist.
+func (api *Api) CertificateAuthority(ctx context.Context) (*CertificateAuthority, error) {
+	found := make([]*CertificateAuthority, 0)
+	result := api.RDB.Database.Find(&found, "tenant = ?", api.Tenant)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if len(found) > 0 {
+		return api.encryptCertificateAuthorityKey(found[0])
+	}
+
+	// Another instance may create the authority concurrently, so keep whichever was stored first.
+	created, err := api.generateCertificateAuthority(api.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	err = api.RDB.Database.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(created)
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return api.withDatabase(tx).audit(ctx, AUDIT_OPERATION_CREATE, created.Tenant, nil, created)
+	})
+	if err != nil {
+		return nil, err
+	}
+	result = api.RDB.Database.Find(&found, "tenant = ?", api.Tenant)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if len(found) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return found[0], nil
+}
+
+// Encrypt the key of a certificate authority stored before keys were encrypted at rest.
+func (api *Api) encryptCertificateAuthorityKey(ca *CertificateAuthority) (*CertificateAuthority, error) {
+	keyblock, _ := pem.Decode([]byte(ca.PrivateKey))
+	if keyblock == nil || keyblock.Type != CA_KEY_PEM_TYPE_PLAIN {
+		return ca, nil
+	}
+	sealed, err := api.sealCaKey(ca.Tenant, keyblock.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	result := api.RDB.Database.Model(ca).Update("private_key", sealed)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return ca, nil
+}
+
+// Issue a device certificate from a certificate signing request.
+func (api *Api) SignDeviceCsr(ctx context.Context, request *DeviceCsrSignRequest) (*DeviceCertificate, error) {
+	devices, err := api.DevicesByToken(ctx, []string{request.Device})
+	if err != nil {
+		return nil, err
+	}
+	if len(devices) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	device := devices[0]
+	if device.Status != DEVICE_STATUS_ACTIVE {
+		return nil, fmt.Errorf("certificates may not be issued to devices with status: %s", device.Status)
+	}
+
+	// Parse and validate the request.
+	block, _ := pem.Decode([]byte(request.Csr))
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, errors.New("csr must be a PEM encoded certificate request")
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	err = csr.CheckSignature()
+	if err != nil {
+		return nil, err
+	}
+	days := int32(CERTIFICATE_DEFAULT_VALIDITY_DAYS)
+	if request.ValidityDays != nil {
+		days = *request.ValidityDays
+	}
+	if days <= 0 || days > CERTIFICATE_MAX_VALIDITY_DAYS {
+		return nil, fmt.Errorf("validity must be between 1 and %d days", CERTIFICATE_MAX_VALIDITY_DAYS)
+	}
+
+	ca, err := api.CertificateAuthority(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cacert, cakey, err := api.parseCertificateAuthority(ca)
+	if err != nil {
+		return nil, err
+	}
+	serial, err := generateSerialNumber()
+	if err != nil {
+		return nil, err
+	}
+
+	// Subject is always derived from the device rather than the request.
+	subject := pkix.Name{CommonName: device.Token}
+	if api.Tenant != "" {
+		subject.Organization = []string{api.Tenant}
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      subject,
+		NotBefore:    now.Add(-time.Minute),
+		NotAfter:     now.AddDate(0, 0, int(days)),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, cacert, csr.PublicKey, cakey)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(der)
+
+	created := &DeviceCertificate{
+		DeviceId:     device.ID,
+		Device:       device,
+		SerialNumber: serial.Text(16),
+		Subject:      subject.String(),
+		Fingerprint:  hex.EncodeToString(sum[:]),
+		Certificate:  string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		NotBefore:    template.NotBefore,
+		NotAfter:     template.NotAfter,
+	}
+
+	// Record fingerprint as a credential so the certificate can be used to authenticate.
+	credential := &DeviceCredential{
+		TokenReference: rdb.TokenReference{
+			Token: fmt.Sprintf("cert-%s", created.SerialNumber),
+		},
+		DeviceId:       device.ID,
+		CredentialType: CREDENTIAL_TYPE_X509_FINGERPRINT,
+		Value:          created.Fingerprint,
+		ExpiresAt:      sql.NullTime{Time: created.NotAfter, Valid: true},
+	}
+	err = api.RDB.Database.Transaction(func(tx *gorm.DB) error {
+		result := tx.Omit("Device").Create(created)
+		if result.Error != nil {
+			return result.Error
+		}
+		result = tx.Create(credential)
+		if result.Error != nil {
+			return result.Error
+		}
+		txapi := api.withDatabase(tx)
+		err := txapi.audit(ctx, AUDIT_OPERATION_CREATE, created.SerialNumber, nil, created)
+		if err != nil {
+			return err
+		}
+		return txapi.audit(ctx, AUDIT_OPERATION_CREATE, credential.Token, nil, credential)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// Get device certificates by serial number.
+func (api *Api) DeviceCertificatesBySerialNumber(ctx context.Context, serials []string) ([]*DeviceCertificate, error) {
+	found := make([]*DeviceCertificate, 0)
+	result := api.RDB.Database
+	result = result.Preload("Device")
+	result = result.Find(&found, "serial_number in ?", serials)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return found, nil
+}
+
+// Search for device certificates that meet criteria.
+func (api *Api) DeviceCertificates(ctx context.Context,
+	criteria DeviceCertificateSearchCriteria) (*DeviceCertificateSearchResults, error) {
+	results := make([]DeviceCertificate, 0)
+	db, pag := api.RDB.ListOf(&DeviceCertificate{}, func(result *gorm.DB) *gorm.DB {
+		if criteria.Device != nil {
+			result = result.Where("device_id = (?)",
+				api.RDB.Database.Model(&Device{}).Select("id").Where("token = ?", criteria.Device))
+		}
+		if criteria.IncludeRevoked == nil || !*criteria.IncludeRevoked {
+			result = result.Where("revoked_at is null")
+		}
+		return result.Preload("Device")
+	}, criteria.Pagination)
+	db.Find(&results)
+	if db.Error != nil {
+		return nil, db.Error
+	}
+
+	// Wrap as search results.
+	return &DeviceCertificateSearchResults{
+		Results:    results,
+		Pagination: pag,
+	}, nil
+}
+
+// Mark certificates revoked along with the credentials recorded for them.
+func revokeCertificates(tx *gorm.DB, certs []*DeviceCertificate, reason string) error {
+	now := time.Now()
+	for _, cert := range certs {
+		if cert.RevokedAt.Valid {
+			continue
+		}
+		cert.RevokedAt = sql.NullTime{Time: now, Valid: true}
+		cert.RevocationReason = sql.NullString{String: reason, Valid: true}
+		result := tx.Omit("Device").Save(cert)
+		if result.Error != nil {
+			return result.Error
+		}
+		result = tx.Model(&DeviceCredential{}).
+			Where("device_id = ? and credential_type = ? and value = ? and revoked_at is null",
+				cert.DeviceId, CREDENTIAL_TYPE_X509_FINGERPRINT, cert.Fingerprint).
+			Update("revoked_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+	return nil
+}
+
+// Revoke a device certificate.
+func (api *Api) RevokeDeviceCertificate(ctx context.Context, serial string, reason *string) (*DeviceCertificate, error) {
+	matches, err := api.DeviceCertificatesBySerialNumber(ctx, []string{serial})
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	why := "revoked"
+	if reason != nil {
+		why = *reason
+	}
+	before := auditSnapshot(matches[0])
+	err = api.RDB.Database.Transaction(func(tx *gorm.DB) error {
+		err := revokeCertificates(tx, matches, why)
+		if err != nil {
+			return err
+		}
+		return api.withDatabase(tx).audit(ctx, AUDIT_OPERATION_UPDATE, matches[0].SerialNumber, before, matches[0])
+	})
+	if err != nil {
+		return nil, err
+	}
+	return matches[0], nil
+}
+
+// Revoke all certificates issued to a device.
+func revokeDeviceCertificates(tx *gorm.DB, deviceId uint, reason string) error {
+	certs := make([]*DeviceCertificate, 0)
+	result := tx.Where("device_id = ? and revoked_at is null", deviceId).Find(&certs)
+	if result.Error != nil {
+		return result.Error
+	}
+	return revokeCertificates(tx, certs, reason)
+}
+
+// Build a DER encoded certificate revocation list for the tenant certificate authority.
+func (api *Api) CertificateRevocationList(ctx context.Context) ([]byte, error) {
+	ca, err := api.CertificateAuthority(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cacert, cakey, err := api.parseCertificateAuthority(ca)
+	if err != nil {
+		return nil, err
+	}
+
+	// Certificates past expiry no longer need to be listed.
+	now := time.Now()
+	revoked := make([]DeviceCertificate, 0)
+	result := api.RDB.Database.Where("revoked_at is not null and not_after > ?", now).
+		Order("revoked_at").Find(&revoked)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	entries := make([]pkix.RevokedCertificate, 0)
+	for _, cert := range revoked {
+		serial, ok := new(big.Int).SetString(cert.SerialNumber, 16)
+		if !ok {
+			return nil, fmt.Errorf("invalid certificate serial number: %s", cert.SerialNumber)
+		}
+		entries = append(entries, pkix.RevokedCertificate{
+			SerialNumber:   serial,
+			RevocationTime: cert.RevokedAt.Time,
+		})
+	}
+
+	template := &x509.RevocationList{
+		Number:              big.NewInt(now.Unix()),
+		ThisUpdate:          now,
+		NextUpdate:          now.Add(CRL_VALIDITY_HOURS * time.Hour),
+		RevokedCertificates: entries,
+	}
+	return x509.CreateRevocationList(rand.Reader, template, cacert, cakey)
+}
@@ -0,0 +1,89 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CertificatesTestSuite struct {
+	suite.Suite
+	API *Api
+}
+
+// Perform common setup tasks.
+func (suite *CertificatesTestSuite) SetupTest() {
+	suite.API = NewApi(nil, "tenant1")
+	suite.API.CaKeyEncryptionKey = []byte("0123456789abcdef0123456789abcdef")
+}
+
+// Test generated keys are encrypted and can be used to sign.
+func (suite *CertificatesTestSuite) TestKeyEncrypted() {
+	ca, err := suite.API.generateCertificateAuthority("tenant1")
+	assert.Nil(suite.T(), err)
+	block, _ := pem.Decode([]byte(ca.PrivateKey))
+	assert.Equal(suite.T(), CA_KEY_PEM_TYPE_ENCRYPTED, block.Type)
+	_, err = x509.ParseECPrivateKey(block.Bytes)
+	assert.NotNil(suite.T(), err)
+
+	cert, key, err := suite.API.parseCertificateAuthority(ca)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "tenant1 Device CA", cert.Subject.CommonName)
+	assert.NotNil(suite.T(), key)
+}
+
+// Test keys can not be decrypted for another tenant or with another key.
+func (suite *CertificatesTestSuite) TestKeyBoundToTenantAndKey() {
+	ca, err := suite.API.generateCertificateAuthority("tenant1")
+	assert.Nil(suite.T(), err)
+
+	ca.Tenant = "tenant2"
+	_, _, err = suite.API.parseCertificateAuthority(ca)
+	assert.NotNil(suite.T(), err)
+
+	ca.Tenant = "tenant1"
+	other := NewApi(nil, "tenant1")
+	other.CaKeyEncryptionKey = []byte("fedcba9876543210fedcba9876543210")
+	_, _, err = other.parseCertificateAuthority(ca)
+	assert.NotNil(suite.T(), err)
+}
+
+// Test certificate authorities can not be created without a key encryption key.
+func (suite *CertificatesTestSuite) TestKeyEncryptionKeyMissing() {
+	suite.API.CaKeyEncryptionKey = nil
+	_, err := suite.API.generateCertificateAuthority("tenant1")
+	assert.ErrorIs(suite.T(), err, ErrCaKeyEncryptionKeyMissing)
+}
+
+// Test tokens of deleted entities are unique and fit the token column.
+func (suite *CertificatesTestSuite) TestDeletedToken() {
+	assert.Equal(suite.T(), "deleted-12-dev1", deletedToken(12, "dev1"))
+	long := deletedToken(12, strings.Repeat("x", DELETED_TOKEN_MAX_LENGTH))
+	assert.Equal(suite.T(), DELETED_TOKEN_MAX_LENGTH, len(long))
+	assert.True(suite.T(), strings.HasPrefix(long, "deleted-12-"))
+}
+
+// Run all tests.
+func TestCertificatesTestSuite(t *testing.T) {
+	suite.Run(t, new(CertificatesTestSuite))
+}
@@ -18,6 +18,8 @@ package model
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"gorm.io/gorm"
 )
@@ -107,8 +109,46 @@ func (api *Api) resolveRelationshipTargets(ctx context.Context, req EntityRelati
 	return nil
 }
 
+// Verify that relationship target criteria reference a known kind of entity.
+func validateRelationshipTarget(criteria EntityRelationshipTargetCriteria) error {
+	if criteria.TargetKind != nil && !IsEntityKind(*criteria.TargetKind) {
+		return fmt.Errorf("unknown entity kind: %s", *criteria.TargetKind)
+	}
+	return nil
+}
+
+// Add conditions that limit relationships to those with a matching target.
+func (api *Api) whereRelationshipTarget(db *gorm.DB, criteria EntityRelationshipTargetCriteria) *gorm.DB {
+	if criteria.TargetKind == nil && criteria.TargetToken == nil {
+		return db
+	}
+	kinds := EntityKinds
+	if criteria.TargetKind != nil {
+		kinds = []string{*criteria.TargetKind}
+	}
+	conditions := make([]string, 0)
+	args := make([]interface{}, 0)
+	for _, kind := range kinds {
+		column := fmt.Sprintf("target_%s_id", entityKindColumns[kind])
+		if criteria.TargetToken != nil {
+			conditions = append(conditions, fmt.Sprintf("%s in (?)", column))
+			args = append(args, api.RDB.Database.Model(entityModelOf(kind)).Select("id").Where("token = ?", criteria.TargetToken))
+		} else {
+			conditions = append(conditions, fmt.Sprintf("%s is not null", column))
+		}
+	}
+	return db.Where(fmt.Sprintf("(%s)", strings.Join(conditions, " or ")), args...)
+}
+
 func preloadRelationshipTargets(db *gorm.DB) *gorm.DB {
 	db = db.Preload("TargetDevice").Preload("TargetDeviceGroup").Preload("TargetAsset").Preload("TargetAssetGroup")
 	db = db.Preload("TargetArea").Preload("TargetAreaGroup").Preload("TargetCustomer").Preload("TargetCustomerGroup")
 	return db
 }
+
+// Add preloads for the types of relationship targets (used for event enrichment).
+func preloadRelationshipTargetTypes(db *gorm.DB) *gorm.DB {
+	db = db.Preload("TargetDevice.DeviceType").Preload("TargetAsset.AssetType")
+	db = db.Preload("TargetArea.AreaType").Preload("TargetCustomer.CustomerType")
+	return db
+}
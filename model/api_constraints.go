@@ -0,0 +1,241 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Create relationship type constraints from a request, verifying that values are known.
+func relationshipConstraintsOf(request RelationshipConstraintsCreateRequest) (RelationshipConstraints, error) {
+	constraints := RelationshipConstraints{
+		Cardinality:        RELATIONSHIP_CARDINALITY_MANY_TO_MANY,
+		OnConflict:         RELATIONSHIP_CONFLICT_REJECT,
+		AllowedTargetKinds: stringListOf(request.AllowedTargetKinds),
+		AllowedSourceTypes: stringListOf(request.AllowedSourceTypes),
+		AllowedTargetTypes: stringListOf(request.AllowedTargetTypes),
+	}
+	if request.Cardinality != nil {
+		if !containsString(RelationshipCardinalities, *request.Cardinality) {
+			return constraints, fmt.Errorf("unknown relationship cardinality: %s", *request.Cardinality)
+		}
+		constraints.Cardinality = *request.Cardinality
+	}
+	if request.OnConflict != nil {
+		if !containsString(RelationshipConflictPolicies, *request.OnConflict) {
+			return constraints, fmt.Errorf("unknown relationship conflict handling: %s", *request.OnConflict)
+		}
+		constraints.OnConflict = *request.OnConflict
+	}
+	for _, kind := range constraints.TargetKinds() {
+		if !IsEntityKind(kind) {
+			return constraints, fmt.Errorf("unknown entity kind: %s", kind)
+		}
+	}
+	return constraints, nil
+}
+
+// Get type tokens for the targets of a relationship that have types.
+func relationshipTargetTypes(rel *EntityRelationship) []string {
+	types := make([]string, 0)
+	if rel.TargetDevice != nil && rel.TargetDevice.DeviceType != nil {
+		types = append(types, rel.TargetDevice.DeviceType.Token)
+	}
+	if rel.TargetAsset != nil && rel.TargetAsset.AssetType != nil {
+		types = append(types, rel.TargetAsset.AssetType.Token)
+	}
+	if rel.TargetArea != nil && rel.TargetArea.AreaType != nil {
+		types = append(types, rel.TargetArea.AreaType.Token)
+	}
+	if rel.TargetCustomer != nil && rel.TargetCustomer.CustomerType != nil {
+		types = append(types, rel.TargetCustomer.CustomerType.Token)
+	}
+	return types
+}
+
+// Verify that the kinds and types of entities in a new relationship are allowed by its type.
+// The source type is nil for sources that do not have types.
+func validateRelationshipConstraints(constraints RelationshipConstraints, sourceType *string,
+	rel *EntityRelationship) error {
+	if kinds := constraints.TargetKinds(); len(kinds) > 0 {
+		for _, target := range entityGraphTargets(rel, 0) {
+			if !containsString(kinds, target.Kind) {
+				return fmt.Errorf("relationship type does not allow targets of kind %s", target.Kind)
+			}
+		}
+	}
+	if types := constraints.SourceTypes(); len(types) > 0 && sourceType != nil {
+		if !containsString(types, *sourceType) {
+			return fmt.Errorf("relationship type does not allow sources of type %s", *sourceType)
+		}
+	}
+	if types := constraints.TargetTypes(); len(types) > 0 {
+		for _, ttype := range relationshipTargetTypes(rel) {
+			if !containsString(types, ttype) {
+				return fmt.Errorf("relationship type does not allow targets of type %s", ttype)
+			}
+		}
+	}
+	return nil
+}
+
+// Limit a query to existing relationships of a type that conflict with a new relationship.
+// Identical relationships always conflict, along with those that would exceed the cardinality.
+func whereRelationshipConflicts(db *gorm.DB, sourceColumn string, sourceId uint, typeId uint,
+	constraints RelationshipConstraints, rel *EntityRelationship) *gorm.DB {
+	targets := make(map[string]uint)
+	for _, target := range entityGraphTargets(rel, 0) {
+		targets[target.Kind] = target.Id
+	}
+
+	identical := []string{fmt.Sprintf("%s = ?", sourceColumn)}
+	args := []interface{}{sourceId}
+	for _, kind := range EntityKinds {
+		column := fmt.Sprintf("target_%s_id", entityKindColumns[kind])
+		if id, ok := targets[kind]; ok {
+			identical = append(identical, fmt.Sprintf("%s = ?", column))
+			args = append(args, id)
+		} else {
+			identical = append(identical, fmt.Sprintf("%s is null", column))
+		}
+	}
+	conditions := []string{fmt.Sprintf("(%s)", strings.Join(identical, " and "))}
+	if constraints.SingleTarget() {
+		conditions = append(conditions, fmt.Sprintf("%s = ?", sourceColumn))
+		args = append(args, sourceId)
+	}
+	if constraints.SingleSource() {
+		for _, kind := range EntityKinds {
+			if id, ok := targets[kind]; ok {
+				conditions = append(conditions, fmt.Sprintf("target_%s_id = ?", entityKindColumns[kind]))
+				args = append(args, id)
+			}
+		}
+	}
+	db = db.Where("relationship_type_id = ?", typeId)
+	return db.Where(fmt.Sprintf("(%s)", strings.Join(conditions, " or ")), args...)
+}
+
+// Lock the source of a new relationship, along with its targets if the relationship type only
+// allows a single source per target, so that concurrent requests can not both pass the conflict
+// check. Rows are locked in a fixed order to avoid deadlocks and held until the transaction ends.
+func lockRelationshipEntities(tx *gorm.DB, sourceKind string, sourceId uint,
+	constraints RelationshipConstraints, rel *EntityRelationship) error {
+	nodes := []EntityGraphNode{{Kind: sourceKind, Id: sourceId}}
+	if constraints.SingleSource() {
+		nodes = append(nodes, entityGraphTargets(rel, 0)...)
+	}
+	order := make(map[string]int)
+	for i, kind := range EntityKinds {
+		order[kind] = i
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].Kind != nodes[j].Kind {
+			return order[nodes[i].Kind] < order[nodes[j].Kind]
+		}
+		return nodes[i].Id < nodes[j].Id
+	})
+	for _, node := range nodes {
+		locked := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id")
+		result := locked.First(entityModelOf(node.Kind), node.Id)
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+	return nil
+}
+
+// Apply the conflict handling of a relationship type, either rejecting the new relationship or
+// ending the conflicting relationships so that it may be created.
+func endConflictingRelationships(tx *gorm.DB, rtype string, constraints RelationshipConstraints,
+	count int, conflicts interface{}) error {
+	if count == 0 {
+		return nil
+	}
+	if constraints.OnConflictOrDefault() != RELATIONSHIP_CONFLICT_REPLACE {
+		return fmt.Errorf("relationship conflicts with %d existing relationship(s) of type %s", count, rtype)
+	}
+	return tx.Delete(conflicts).Error
+}
+
+// Identifies the type of a relationship being created along with its constraints.
+type relationshipTypeRef struct {
+	Id          uint
+	Token       string
+	Constraints RelationshipConstraints
+}
+
+// Implemented by relationship models, which embed EntityRelationship.
+type constrainedRelationship interface {
+	entityRelationship() *EntityRelationship
+}
+
+// Get the common relationship fields.
+func (rel *EntityRelationship) entityRelationship() *EntityRelationship {
+	return rel
+}
+
+// Create a relationship after checking it against the constraints of its type. Existing
+// relationships that conflict with the new one are ended, or the new one is rejected, and audit
+// entries are recorded for each change in the same transaction. The source type is nil for
+// sources that do not have types.
+func (api *Api) createConstrainedRelationship(ctx context.Context, sourceKind string, sourceColumn string,
+	sourceId uint, sourceType *string, rtype relationshipTypeRef, created constrainedRelationship) error {
+	rel := created.entityRelationship()
+	err := validateRelationshipConstraints(rtype.Constraints, sourceType, rel)
+	if err != nil {
+		return err
+	}
+
+	// End or reject existing relationships that conflict with the new one.
+	ended := reflect.New(reflect.SliceOf(reflect.TypeOf(created).Elem()))
+	return api.RDB.Database.Transaction(func(tx *gorm.DB) error {
+		err := lockRelationshipEntities(tx, sourceKind, sourceId, rtype.Constraints, rel)
+		if err != nil {
+			return err
+		}
+		conflicts := whereRelationshipConflicts(tx, sourceColumn, sourceId, rtype.Id, rtype.Constraints, rel)
+		if err := conflicts.Find(ended.Interface()).Error; err != nil {
+			return err
+		}
+		count := ended.Elem().Len()
+		err = endConflictingRelationships(tx, rtype.Token, rtype.Constraints, count, ended.Interface())
+		if err != nil {
+			return err
+		}
+		err = tx.Create(created).Error
+		if err != nil {
+			return err
+		}
+		txapi := api.withDatabase(tx)
+		for i := 0; i < count; i++ {
+			conflict := ended.Elem().Index(i).Addr().Interface().(constrainedRelationship)
+			err = txapi.audit(ctx, AUDIT_OPERATION_DELETE, conflict.entityRelationship().Token, conflict, nil)
+			if err != nil {
+				return err
+			}
+		}
+		return txapi.audit(ctx, AUDIT_OPERATION_CREATE, rel.Token, nil, created)
+	})
+}
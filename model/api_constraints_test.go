@@ -0,0 +1,128 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type ConstraintsTestSuite struct {
+	suite.Suite
+}
+
+// Build a relationship targeting an asset of the given type.
+func assetRelationshipOf(atype string) *EntityRelationship {
+	return &EntityRelationship{
+		TargetAsset: &Asset{
+			Model:          gorm.Model{ID: 1},
+			TokenReference: rdb.TokenReference{Token: "asset1"},
+			AssetType:      &AssetType{TokenReference: rdb.TokenReference{Token: atype}},
+		},
+	}
+}
+
+// Test cardinalities limit sources and targets as expected.
+func (suite *ConstraintsTestSuite) TestCardinality() {
+	cases := []struct {
+		cardinality  string
+		singleTarget bool
+		singleSource bool
+	}{
+		{"", false, false},
+		{RELATIONSHIP_CARDINALITY_MANY_TO_MANY, false, false},
+		{RELATIONSHIP_CARDINALITY_MANY_TO_ONE, true, false},
+		{RELATIONSHIP_CARDINALITY_ONE_TO_MANY, false, true},
+		{RELATIONSHIP_CARDINALITY_ONE_TO_ONE, true, true},
+	}
+	for _, c := range cases {
+		constraints := RelationshipConstraints{Cardinality: c.cardinality}
+		assert.Equal(suite.T(), c.singleTarget, constraints.SingleTarget(), c.cardinality)
+		assert.Equal(suite.T(), c.singleSource, constraints.SingleSource(), c.cardinality)
+	}
+}
+
+// Test constraints default when not specified and reject unknown values.
+func (suite *ConstraintsTestSuite) TestConstraintsOf() {
+	constraints, err := relationshipConstraintsOf(RelationshipConstraintsCreateRequest{})
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), RELATIONSHIP_CARDINALITY_MANY_TO_MANY, constraints.CardinalityOrDefault())
+	assert.Equal(suite.T(), RELATIONSHIP_CONFLICT_REJECT, constraints.OnConflictOrDefault())
+	assert.Empty(suite.T(), constraints.TargetKinds())
+
+	unknown := "SomeToSome"
+	_, err = relationshipConstraintsOf(RelationshipConstraintsCreateRequest{Cardinality: &unknown})
+	assert.NotNil(suite.T(), err)
+	_, err = relationshipConstraintsOf(RelationshipConstraintsCreateRequest{OnConflict: &unknown})
+	assert.NotNil(suite.T(), err)
+	kinds := []string{ENTITY_KIND_ASSET, "planet"}
+	_, err = relationshipConstraintsOf(RelationshipConstraintsCreateRequest{AllowedTargetKinds: &kinds})
+	assert.NotNil(suite.T(), err)
+}
+
+// Test only allowed kinds of targets are accepted.
+func (suite *ConstraintsTestSuite) TestAllowedTargetKinds() {
+	kinds := []string{ENTITY_KIND_AREA, ENTITY_KIND_ASSET}
+	constraints, err := relationshipConstraintsOf(RelationshipConstraintsCreateRequest{AllowedTargetKinds: &kinds})
+	assert.Nil(suite.T(), err)
+	assert.Nil(suite.T(), validateRelationshipConstraints(constraints, nil, assetRelationshipOf("truck")))
+
+	kinds = []string{ENTITY_KIND_AREA}
+	constraints, err = relationshipConstraintsOf(RelationshipConstraintsCreateRequest{AllowedTargetKinds: &kinds})
+	assert.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), validateRelationshipConstraints(constraints, nil, assetRelationshipOf("truck")))
+}
+
+// Test only allowed types of sources and targets are accepted.
+func (suite *ConstraintsTestSuite) TestAllowedTypes() {
+	sources := []string{"tracker"}
+	targets := []string{"truck"}
+	constraints, err := relationshipConstraintsOf(RelationshipConstraintsCreateRequest{
+		AllowedSourceTypes: &sources,
+		AllowedTargetTypes: &targets,
+	})
+	assert.Nil(suite.T(), err)
+
+	tracker, sensor := "tracker", "sensor"
+	assert.Nil(suite.T(), validateRelationshipConstraints(constraints, &tracker, assetRelationshipOf("truck")))
+	assert.Nil(suite.T(), validateRelationshipConstraints(constraints, nil, assetRelationshipOf("truck")))
+	assert.NotNil(suite.T(), validateRelationshipConstraints(constraints, &sensor, assetRelationshipOf("truck")))
+	assert.NotNil(suite.T(), validateRelationshipConstraints(constraints, &tracker, assetRelationshipOf("trailer")))
+}
+
+// Test relationships that violate their constraints are rejected before any changes are made.
+func (suite *ConstraintsTestSuite) TestCreateRejectedByConstraints() {
+	kinds := []string{ENTITY_KIND_AREA}
+	constraints, err := relationshipConstraintsOf(RelationshipConstraintsCreateRequest{AllowedTargetKinds: &kinds})
+	assert.Nil(suite.T(), err)
+
+	created := &DeviceRelationship{EntityRelationship: *assetRelationshipOf("truck")}
+	ref := relationshipTypeRef{Id: 1, Token: "tracks", Constraints: constraints}
+	err = NewApi(nil, "tenant1").createConstrainedRelationship(context.Background(), ENTITY_KIND_DEVICE,
+		"source_device_id", 1, nil, ref, created)
+	assert.NotNil(suite.T(), err)
+}
+
+// Run all tests.
+func TestConstraintsTestSuite(t *testing.T) {
+	suite.Run(t, new(ConstraintsTestSuite))
+}
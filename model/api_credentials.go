@@ -0,0 +1,377 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+)
+
+const (
+	CREDENTIAL_GENERATED_SECRET_BYTES = 32 // Number of random bytes in generated keys
+)
+
+// Generate a random secret encoded as hex.
+func generateCredentialSecret() (string, error) {
+	raw := make([]byte, CREDENTIAL_GENERATED_SECRET_BYTES)
+	_, err := rand.Read(raw)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(raw), nil
+}
+
+// Hash an API key or pre-shared key for storage.
+func hashApiKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Normalize a certificate fingerprint to lowercase hex without separators.
+func normalizeFingerprint(fingerprint string) string {
+	normalized := strings.ToLower(strings.TrimSpace(fingerprint))
+	normalized = strings.ReplaceAll(normalized, ":", "")
+	return strings.ReplaceAll(normalized, " ", "")
+}
+
+// Parse an optional RFC3339 timestamp.
+func parseOptionalTime(value *string) (sql.NullTime, error) {
+	if value == nil || *value == "" {
+		return sql.NullTime{}, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return sql.NullTime{}, err
+	}
+	return sql.NullTime{Time: parsed, Valid: true}, nil
+}
+
+// Compute the stored value for a credential. Returns the value and any secret that was generated.
+func storedCredentialValue(credentialType string, value *string) (string, *string, error) {
+	switch credentialType {
+	case CREDENTIAL_TYPE_API_KEY:
+		if value != nil {
+			return "", nil, fmt.Errorf("api keys are generated and may not be supplied")
+		}
+		secret, err := generateCredentialSecret()
+		if err != nil {
+			return "", nil, err
+		}
+		return hashApiKey(secret), &secret, nil
+	case CREDENTIAL_TYPE_X509_FINGERPRINT:
+		if value == nil || *value == "" {
+			return "", nil, fmt.Errorf("certificate fingerprint is required")
+		}
+		fingerprint := normalizeFingerprint(*value)
+		_, err := hex.DecodeString(fingerprint)
+		if err != nil {
+			return "", nil, fmt.Errorf("certificate fingerprint is not valid hex: %s", *value)
+		}
+		return fingerprint, nil, nil
+	case CREDENTIAL_TYPE_PRE_SHARED_KEY:
+		if value != nil && *value != "" {
+			return hashApiKey(*value), nil, nil
+		}
+		secret, err := generateCredentialSecret()
+		if err != nil {
+			return "", nil, err
+		}
+		return hashApiKey(secret), &secret, nil
+	default:
+		return "", nil, fmt.Errorf("unknown credential type: %s", credentialType)
+	}
+}
+
+// Indicates whether a credential is revoked or expired at the given time.
+func (cred *DeviceCredential) IsActive(now time.Time) bool {
+	if cred.RevokedAt.Valid {
+		return false
+	}
+	if cred.ExpiresAt.Valid && !now.Before(cred.ExpiresAt.Time) {
+		return false
+	}
+	return true
+}
+
+// Create a new device credential.
+func (api *Api) CreateDeviceCredential(ctx context.Context, request *DeviceCredentialCreateRequest) (*IssuedDeviceCredential, error) {
+	devices, err := api.DevicesByToken(ctx, []string{request.Device})
+	if err != nil {
+		return nil, err
+	}
+	if len(devices) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	stored, secret, err := storedCredentialValue(request.CredentialType, request.Value)
+	if err != nil {
+		return nil, err
+	}
+	expires, err := parseOptionalTime(request.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	created := &DeviceCredential{
+		TokenReference: rdb.TokenReference{
+			Token: request.Token,
+		},
+		MetadataEntity: rdb.MetadataEntity{
+			Metadata: rdb.MetadataStrOf(request.Metadata),
+		},
+		DeviceId:       devices[0].ID,
+		Device:         devices[0],
+		CredentialType: request.CredentialType,
+		Value:          stored,
+		ExpiresAt:      expires,
+	}
+	err = api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &IssuedDeviceCredential{
+		Credential: created,
+		Secret:     secret,
+	}, nil
+}
+
+// Rotate a device credential. A replacement of the same type is issued and the existing
+// credential expires after the grace period.
+func (api *Api) RotateDeviceCredential(ctx context.Context, token string,
+	request *DeviceCredentialRotateRequest) (*IssuedDeviceCredential, error) {
+	matches, err := api.DeviceCredentialsByToken(ctx, []string{token})
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	existing := matches[0]
+	before := auditSnapshot(existing)
+	if existing.RevokedAt.Valid {
+		return nil, fmt.Errorf("credential has been revoked: %s", token)
+	}
+
+	stored, secret, err := storedCredentialValue(existing.CredentialType, request.Value)
+	if err != nil {
+		return nil, err
+	}
+	expires, err := parseOptionalTime(request.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	replacement := &DeviceCredential{
+		TokenReference: rdb.TokenReference{
+			Token: request.Token,
+		},
+		MetadataEntity: existing.MetadataEntity,
+		DeviceId:       existing.DeviceId,
+		Device:         existing.Device,
+		CredentialType: existing.CredentialType,
+		Value:          stored,
+		ExpiresAt:      expires,
+	}
+
+	// Expire the existing credential once the grace period has passed.
+	grace := time.Duration(0)
+	if request.GracePeriodSeconds != nil {
+		grace = time.Duration(*request.GracePeriodSeconds) * time.Second
+	}
+	cutoff := time.Now().Add(grace)
+	if !existing.ExpiresAt.Valid || cutoff.Before(existing.ExpiresAt.Time) {
+		existing.ExpiresAt = sql.NullTime{Time: cutoff, Valid: true}
+	}
+
+	err = api.RDB.Database.Transaction(func(tx *gorm.DB) error {
+		result := tx.Create(replacement)
+		if result.Error != nil {
+			return result.Error
+		}
+		result = tx.Save(existing)
+		if result.Error != nil {
+			return result.Error
+		}
+		txapi := api.withDatabase(tx)
+		err := txapi.audit(ctx, AUDIT_OPERATION_UPDATE, existing.Token, before, existing)
+		if err != nil {
+			return err
+		}
+		return txapi.audit(ctx, AUDIT_OPERATION_CREATE, replacement.Token, nil, replacement)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &IssuedDeviceCredential{
+		Credential: replacement,
+		Secret:     secret,
+	}, nil
+}
+
+// Revoke a device credential.
+func (api *Api) RevokeDeviceCredential(ctx context.Context, token string) (*DeviceCredential, error) {
+	matches, err := api.DeviceCredentialsByToken(ctx, []string{token})
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	updated := matches[0]
+	if !updated.RevokedAt.Valid {
+		before := auditSnapshot(updated)
+		updated.RevokedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		err := api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+			return tx.Save(updated).Error
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return updated, nil
+}
+
+// Revoke all active credentials for a device.
+func revokeDeviceCredentials(tx *gorm.DB, deviceId uint) error {
+	result := tx.Model(&DeviceCredential{}).Where("device_id = ? and revoked_at is null", deviceId).
+		Update("revoked_at", time.Now())
+	return result.Error
+}
+
+// Get device credentials by id.
+func (api *Api) DeviceCredentialsById(ctx context.Context, ids []uint) ([]*DeviceCredential, error) {
+	found := make([]*DeviceCredential, 0)
+	result := api.RDB.Database
+	result = result.Preload("Device")
+	result = result.Find(&found, ids)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return found, nil
+}
+
+// Get device credentials by token.
+func (api *Api) DeviceCredentialsByToken(ctx context.Context, tokens []string) ([]*DeviceCredential, error) {
+	found := make([]*DeviceCredential, 0)
+	result := api.RDB.Database
+	result = result.Preload("Device")
+	result = result.Find(&found, "token in ?", tokens)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return found, nil
+}
+
+// Search for device credentials that meet criteria.
+func (api *Api) DeviceCredentials(ctx context.Context,
+	criteria DeviceCredentialSearchCriteria) (*DeviceCredentialSearchResults, error) {
+	results := make([]DeviceCredential, 0)
+	db, pag := api.RDB.ListOf(&DeviceCredential{}, func(result *gorm.DB) *gorm.DB {
+		if criteria.Device != nil {
+			result = result.Where("device_id = (?)",
+				api.RDB.Database.Model(&Device{}).Select("id").Where("token = ?", criteria.Device))
+		}
+		if criteria.CredentialType != nil {
+			result = result.Where("credential_type = ?", criteria.CredentialType)
+		}
+		if criteria.IncludeRevoked == nil || !*criteria.IncludeRevoked {
+			result = result.Where("revoked_at is null")
+		}
+		return result.Preload("Device")
+	}, criteria.Pagination)
+	db.Find(&results)
+	if db.Error != nil {
+		return nil, db.Error
+	}
+
+	// Wrap as search results.
+	return &DeviceCredentialSearchResults{
+		Results:    results,
+		Pagination: pag,
+	}, nil
+}
+
+// Verify authentication material presented by a device.
+func (api *Api) VerifyDeviceCredential(ctx context.Context,
+	request *DeviceCredentialVerifyRequest) (*DeviceCredentialVerification, error) {
+	var presented string
+	switch request.CredentialType {
+	case CREDENTIAL_TYPE_API_KEY, CREDENTIAL_TYPE_PRE_SHARED_KEY:
+		presented = hashApiKey(request.Value)
+	case CREDENTIAL_TYPE_X509_FINGERPRINT:
+		presented = normalizeFingerprint(request.Value)
+	default:
+		return nil, fmt.Errorf("unknown credential type: %s", request.CredentialType)
+	}
+
+	// Pre-shared keys are not unique, so the device must be specified.
+	if request.CredentialType == CREDENTIAL_TYPE_PRE_SHARED_KEY && request.Device == nil {
+		return nil, fmt.Errorf("device is required to verify a pre-shared key")
+	}
+
+	candidates := make([]*DeviceCredential, 0)
+	result := api.RDB.Database.Preload("Device").Where("credential_type = ?", request.CredentialType)
+	if request.Device != nil {
+		result = result.Where("device_id = (?)",
+			api.RDB.Database.Model(&Device{}).Select("id").Where("token = ?", request.Device))
+	}
+	if request.CredentialType != CREDENTIAL_TYPE_PRE_SHARED_KEY {
+		result = result.Where("value = ?", presented)
+	}
+	result = result.Find(&candidates)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	now := time.Now()
+	reason := "no matching credential"
+	for _, candidate := range candidates {
+		if subtle.ConstantTimeCompare([]byte(candidate.Value), []byte(presented)) != 1 {
+			continue
+		}
+		if candidate.RevokedAt.Valid {
+			reason = "credential has been revoked"
+			continue
+		}
+		if !candidate.IsActive(now) {
+			reason = "credential has expired"
+			continue
+		}
+		return &DeviceCredentialVerification{
+			Valid:      true,
+			Device:     candidate.Device,
+			Credential: candidate,
+		}, nil
+	}
+	return &DeviceCredentialVerification{
+		Valid:  false,
+		Reason: &reason,
+	}, nil
+}
@@ -0,0 +1,51 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CredentialsTestSuite struct {
+	suite.Suite
+}
+
+// Test supplied pre-shared keys are stored as hashes.
+func (suite *CredentialsTestSuite) TestPreSharedKeyHashed() {
+	key := "device-secret"
+	stored, secret, err := storedCredentialValue(CREDENTIAL_TYPE_PRE_SHARED_KEY, &key)
+	assert.Nil(suite.T(), err)
+	assert.Nil(suite.T(), secret)
+	assert.NotEqual(suite.T(), key, stored)
+	assert.Equal(suite.T(), hashApiKey(key), stored)
+}
+
+// Test generated pre-shared keys are returned once and stored as hashes.
+func (suite *CredentialsTestSuite) TestGeneratedPreSharedKeyHashed() {
+	stored, secret, err := storedCredentialValue(CREDENTIAL_TYPE_PRE_SHARED_KEY, nil)
+	assert.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), secret)
+	assert.Equal(suite.T(), hashApiKey(*secret), stored)
+}
+
+// Run all tests.
+func TestCredentialsTestSuite(t *testing.T) {
+	suite.Run(t, new(CredentialsTestSuite))
+}
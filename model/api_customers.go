@@ -44,9 +44,11 @@ func (api *Api) CreateCustomerType(ctx context.Context, request *CustomerTypeCre
 			Metadata: rdb.MetadataStrOf(request.Metadata),
 		},
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err := api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -63,6 +65,7 @@ func (api *Api) UpdateCustomerType(ctx context.Context, token string,
 	}
 
 	found := matches[0]
+	before := auditSnapshot(found)
 	found.Token = request.Token
 	found.Name = rdb.NullStrOf(request.Name)
 	found.Description = rdb.NullStrOf(request.Description)
@@ -73,9 +76,11 @@ func (api *Api) UpdateCustomerType(ctx context.Context, token string,
 	found.BorderColor = rdb.NullStrOf(request.BorderColor)
 	found.Metadata = rdb.MetadataStrOf(request.Metadata)
 
-	result := api.RDB.Database.Save(found)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, found.Token, before, found, func(tx *gorm.DB) error {
+		return tx.Save(found).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return found, nil
 }
@@ -139,9 +144,11 @@ func (api *Api) CreateCustomer(ctx context.Context, request *CustomerCreateReque
 		},
 		CustomerType: matches[0],
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -158,6 +165,7 @@ func (api *Api) UpdateCustomer(ctx context.Context, token string, request *Custo
 
 	// Update fields that changed.
 	updated := matches[0]
+	before := auditSnapshot(updated)
 	updated.Token = request.Token
 	updated.Name = rdb.NullStrOf(request.Name)
 	updated.Description = rdb.NullStrOf(request.Description)
@@ -175,9 +183,11 @@ func (api *Api) UpdateCustomer(ctx context.Context, token string, request *Custo
 		updated.CustomerType = ctmatches[0]
 	}
 
-	result := api.RDB.Database.Save(updated)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return updated, nil
 }
@@ -230,6 +240,11 @@ func (api *Api) Customers(ctx context.Context, criteria CustomerSearchCriteria)
 
 // Create a new customer relationship type.
 func (api *Api) CreateCustomerRelationshipType(ctx context.Context, request *CustomerRelationshipTypeCreateRequest) (*CustomerRelationshipType, error) {
+	constraints, err := relationshipConstraintsOf(request.RelationshipConstraintsCreateRequest)
+	if err != nil {
+		return nil, err
+	}
+
 	created := &CustomerRelationshipType{
 		TokenReference: rdb.TokenReference{
 			Token: request.Token,
@@ -241,10 +256,14 @@ func (api *Api) CreateCustomerRelationshipType(ctx context.Context, request *Cus
 		MetadataEntity: rdb.MetadataEntity{
 			Metadata: rdb.MetadataStrOf(request.Metadata),
 		},
+		Tracked:                 request.Tracked != nil && *request.Tracked,
+		RelationshipConstraints: constraints,
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -260,15 +279,25 @@ func (api *Api) UpdateCustomerRelationshipType(ctx context.Context, token string
 		return nil, gorm.ErrRecordNotFound
 	}
 
+	constraints, err := relationshipConstraintsOf(request.RelationshipConstraintsCreateRequest)
+	if err != nil {
+		return nil, err
+	}
+
 	updated := matches[0]
+	before := auditSnapshot(updated)
 	updated.Token = request.Token
 	updated.Name = rdb.NullStrOf(request.Name)
 	updated.Description = rdb.NullStrOf(request.Description)
 	updated.Metadata = rdb.MetadataStrOf(request.Metadata)
+	updated.Tracked = request.Tracked != nil && *request.Tracked
+	updated.RelationshipConstraints = constraints
 
-	result := api.RDB.Database.Save(updated)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return updated, nil
 }
@@ -352,10 +381,20 @@ func (api *Api) CreateCustomerRelationship(ctx context.Context, request *Custome
 		SourceCustomer:   *mmap[request.SourceCustomer],
 		RelationshipType: *crtmatches[0],
 	}
-	api.resolveRelationshipTargets(ctx, request.Targets, &created.EntityRelationship)
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.resolveRelationshipTargets(ctx, request.Targets, &created.EntityRelationship)
+	if err != nil {
+		return nil, err
+	}
+	var stype *string
+	if created.SourceCustomer.CustomerType != nil {
+		stype = &created.SourceCustomer.CustomerType.Token
+	}
+	rtype := created.RelationshipType
+	ref := relationshipTypeRef{Id: rtype.ID, Token: rtype.Token, Constraints: rtype.RelationshipConstraints}
+	err = api.createConstrainedRelationship(ctx, ENTITY_KIND_CUSTOMER, "source_customer_id",
+		created.SourceCustomer.ID, stype, ref, created)
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -389,6 +428,10 @@ func (api *Api) CustomerRelationshipsByToken(ctx context.Context, tokens []strin
 // Search for customer relationships that meet criteria.
 func (api *Api) CustomerRelationships(ctx context.Context,
 	criteria CustomerRelationshipSearchCriteria) (*CustomerRelationshipSearchResults, error) {
+	err := validateRelationshipTarget(criteria.EntityRelationshipTargetCriteria)
+	if err != nil {
+		return nil, err
+	}
 	results := make([]CustomerRelationship, 0)
 	db, pag := api.RDB.ListOf(&CustomerRelationship{}, func(result *gorm.DB) *gorm.DB {
 		if criteria.SourceCustomer != nil {
@@ -399,6 +442,11 @@ func (api *Api) CustomerRelationships(ctx context.Context,
 			result = result.Where("relationship_type_id = (?)",
 				api.RDB.Database.Model(&CustomerRelationshipType{}).Select("id").Where("token = ?", criteria.RelationshipType))
 		}
+		if criteria.Tracked != nil {
+			result = result.Where("relationship_type_id in (?)",
+				api.RDB.Database.Model(&CustomerRelationshipType{}).Select("id").Where("tracked = ?", criteria.Tracked))
+		}
+		result = api.whereRelationshipTarget(result, criteria.EntityRelationshipTargetCriteria)
 		return result
 	}, criteria.Pagination)
 	db.Preload("SourceCustomer").Preload("RelationshipType")
@@ -436,9 +484,11 @@ func (api *Api) CreateCustomerGroup(ctx context.Context, request *CustomerGroupC
 			Metadata: rdb.MetadataStrOf(request.Metadata),
 		},
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err := api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -455,6 +505,7 @@ func (api *Api) UpdateCustomerGroup(ctx context.Context, token string,
 	}
 
 	updated := matches[0]
+	before := auditSnapshot(updated)
 	updated.Token = request.Token
 	updated.Name = rdb.NullStrOf(request.Name)
 	updated.Description = rdb.NullStrOf(request.Description)
@@ -465,9 +516,11 @@ func (api *Api) UpdateCustomerGroup(ctx context.Context, token string,
 	updated.BorderColor = rdb.NullStrOf(request.BorderColor)
 	updated.Metadata = rdb.MetadataStrOf(request.Metadata)
 
-	result := api.RDB.Database.Save(updated)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return updated, nil
 }
@@ -511,6 +564,11 @@ func (api *Api) CustomerGroups(ctx context.Context, criteria CustomerGroupSearch
 // Create a new customer group relationship type.
 func (api *Api) CreateCustomerGroupRelationshipType(ctx context.Context,
 	request *CustomerGroupRelationshipTypeCreateRequest) (*CustomerGroupRelationshipType, error) {
+	constraints, err := relationshipConstraintsOf(request.RelationshipConstraintsCreateRequest)
+	if err != nil {
+		return nil, err
+	}
+
 	created := &CustomerGroupRelationshipType{
 		TokenReference: rdb.TokenReference{
 			Token: request.Token,
@@ -522,10 +580,13 @@ func (api *Api) CreateCustomerGroupRelationshipType(ctx context.Context,
 		MetadataEntity: rdb.MetadataEntity{
 			Metadata: rdb.MetadataStrOf(request.Metadata),
 		},
+		RelationshipConstraints: constraints,
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -541,15 +602,24 @@ func (api *Api) UpdateCustomerGroupRelationshipType(ctx context.Context, token s
 		return nil, gorm.ErrRecordNotFound
 	}
 
+	constraints, err := relationshipConstraintsOf(request.RelationshipConstraintsCreateRequest)
+	if err != nil {
+		return nil, err
+	}
+
 	updated := matches[0]
+	before := auditSnapshot(updated)
 	updated.Token = request.Token
 	updated.Name = rdb.NullStrOf(request.Name)
 	updated.Description = rdb.NullStrOf(request.Description)
 	updated.Metadata = rdb.MetadataStrOf(request.Metadata)
+	updated.RelationshipConstraints = constraints
 
-	result := api.RDB.Database.Save(updated)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return updated, nil
 }
@@ -625,9 +695,16 @@ func (api *Api) CreateCustomerGroupRelationship(ctx context.Context,
 		SourceCustomerGroup: *cgmatches[0],
 		RelationshipType:    *cgrtmatches[0],
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.resolveRelationshipTargets(ctx, request.Targets, &created.EntityRelationship)
+	if err != nil {
+		return nil, err
+	}
+	rtype := created.RelationshipType
+	ref := relationshipTypeRef{Id: rtype.ID, Token: rtype.Token, Constraints: rtype.RelationshipConstraints}
+	err = api.createConstrainedRelationship(ctx, ENTITY_KIND_CUSTOMER_GROUP, "source_customer_group_id",
+		created.SourceCustomerGroup.ID, nil, ref, created)
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -661,6 +738,10 @@ func (api *Api) CustomerGroupRelationshipsByToken(ctx context.Context, tokens []
 // Search for customer group relationships that meet criteria.
 func (api *Api) CustomerGroupRelationships(ctx context.Context,
 	criteria CustomerGroupRelationshipSearchCriteria) (*CustomerGroupRelationshipSearchResults, error) {
+	err := validateRelationshipTarget(criteria.EntityRelationshipTargetCriteria)
+	if err != nil {
+		return nil, err
+	}
 	results := make([]CustomerGroupRelationship, 0)
 	db, pag := api.RDB.ListOf(&CustomerGroupRelationship{}, func(result *gorm.DB) *gorm.DB {
 		if criteria.SourceCustomerGroup != nil {
@@ -671,6 +752,7 @@ func (api *Api) CustomerGroupRelationships(ctx context.Context,
 			result = result.Where("relationship_type_id = (?)",
 				api.RDB.Database.Model(&CustomerGroupRelationshipType{}).Select("id").Where("token = ?", criteria.RelationshipType))
 		}
+		result = api.whereRelationshipTarget(result, criteria.EntityRelationshipTargetCriteria)
 		return result
 	}, criteria.Pagination)
 	db.Preload("SourceCustomerGroup").Preload("RelationshipType")
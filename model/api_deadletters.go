@@ -0,0 +1,219 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Record a failed event. Events that fail repeatedly are tracked as a single entry.
+func (api *Api) RecordDeadLetterEvent(ctx context.Context, request *DeadLetterEventCreateRequest) (*DeadLetterEvent, error) {
+	sum := sha256.Sum256(request.Payload)
+	upsert := &DeadLetterEvent{
+		Reason:       request.Reason,
+		Service:      request.Service,
+		Message:      request.Message,
+		Error:        request.Error,
+		DeviceToken:  rdb.NullStrOf(request.DeviceToken),
+		Payload:      request.Payload,
+		PayloadHash:  hex.EncodeToString(sum[:]),
+		FailureCount: 1,
+		Status:       DEAD_LETTER_STATUS_FAILED,
+		FirstFailed:  request.FailedTime,
+		LastFailed:   request.FailedTime,
+	}
+	result := api.RDB.Database.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "payload_hash"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"reason":        upsert.Reason,
+			"service":       upsert.Service,
+			"message":       upsert.Message,
+			"error":         upsert.Error,
+			"last_failed":   upsert.LastFailed,
+			"failure_count": gorm.Expr("dead_letter_events.failure_count + 1"),
+			"status": gorm.Expr("CASE WHEN dead_letter_events.status = ? THEN dead_letter_events.status ELSE ? END",
+				DEAD_LETTER_STATUS_QUARANTINED, DEAD_LETTER_STATUS_FAILED),
+		}),
+	}).Create(upsert)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return upsert, nil
+}
+
+// Search for dead letter events that meet criteria.
+func (api *Api) DeadLetterEvents(ctx context.Context, criteria DeadLetterEventSearchCriteria) (*DeadLetterEventSearchResults, error) {
+	since, err := parseOptionalTime(criteria.Since)
+	if err != nil {
+		return nil, err
+	}
+	until, err := parseOptionalTime(criteria.Until)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]DeadLetterEvent, 0)
+	db, pag := api.RDB.ListOf(&DeadLetterEvent{}, func(result *gorm.DB) *gorm.DB {
+		if criteria.Reason != nil {
+			result = result.Where("reason = ?", criteria.Reason)
+		}
+		if criteria.Service != nil {
+			result = result.Where("service = ?", criteria.Service)
+		}
+		if criteria.DeviceToken != nil {
+			result = result.Where("device_token = ?", criteria.DeviceToken)
+		}
+		if criteria.Status != nil {
+			result = result.Where("status = ?", criteria.Status)
+		}
+		if since.Valid {
+			result = result.Where("last_failed >= ?", since.Time)
+		}
+		if until.Valid {
+			result = result.Where("last_failed < ?", until.Time)
+		}
+		return result
+	}, criteria.Pagination)
+	db = db.Order("last_failed desc").Find(&results)
+	if db.Error != nil {
+		return nil, db.Error
+	}
+
+	// Wrap as search results.
+	return &DeadLetterEventSearchResults{
+		Results:    results,
+		Pagination: pag,
+	}, nil
+}
+
+// Build query for dead letter events eligible to be replayed by a job. Events that failed after
+// the job was created are excluded so that events failing again during replay are not retried
+// within the same job.
+func (api *Api) replayableDeadLetterEvents(job *FailedEventReplayJob) *gorm.DB {
+	result := api.RDB.Database.Model(&DeadLetterEvent{}).
+		Where("status = ?", DEAD_LETTER_STATUS_FAILED).
+		Where("last_failed < ?", job.CreatedAt)
+	if job.Reason.Valid {
+		result = result.Where("reason = ?", job.Reason.Int32)
+	}
+	if job.Service.Valid {
+		result = result.Where("service = ?", job.Service.String)
+	}
+	if job.DeviceToken.Valid {
+		result = result.Where("device_token = ?", job.DeviceToken.String)
+	}
+	if job.Since.Valid {
+		result = result.Where("last_failed >= ?", job.Since.Time)
+	}
+	if job.Until.Valid {
+		result = result.Where("last_failed < ?", job.Until.Time)
+	}
+	return result
+}
+
+// Count dead letter events eligible to be replayed by a job.
+func (api *Api) CountReplayableDeadLetterEvents(ctx context.Context, job *FailedEventReplayJob) (int64, error) {
+	count := int64(0)
+	result := api.replayableDeadLetterEvents(job).Count(&count)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+// Get the next batch of dead letter events eligible to be replayed by a job.
+func (api *Api) ReplayableDeadLetterEvents(ctx context.Context, job *FailedEventReplayJob,
+	limit int) ([]*DeadLetterEvent, error) {
+	found := make([]*DeadLetterEvent, 0)
+	result := api.replayableDeadLetterEvents(job).Order("id").Limit(limit).Find(&found)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return found, nil
+}
+
+// Quarantine a dead letter event that has reached the replay limit so that it is not replayed.
+func (api *Api) QuarantineDeadLetterEvent(ctx context.Context, event *DeadLetterEvent) error {
+	return api.RDB.Database.Model(event).Update("status", DEAD_LETTER_STATUS_QUARANTINED).Error
+}
+
+// Mark dead letter events as replayed once they have been requeued for resolution.
+func (api *Api) MarkDeadLetterEventsReplayed(ctx context.Context, events []*DeadLetterEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	ids := make([]uint, 0, len(events))
+	for _, event := range events {
+		ids = append(ids, event.ID)
+	}
+	return api.RDB.Database.Model(&DeadLetterEvent{}).Where("id in ?", ids).Updates(map[string]interface{}{
+		"status":       DEAD_LETTER_STATUS_REPLAYED,
+		"replay_count": gorm.Expr("replay_count + 1"),
+	}).Error
+}
+
+// Create a job for replaying failed events.
+func (api *Api) CreateFailedEventReplayJob(ctx context.Context,
+	request *FailedEventReplayRequest) (*FailedEventReplayJob, error) {
+	since, err := parseOptionalTime(request.Since)
+	if err != nil {
+		return nil, err
+	}
+	until, err := parseOptionalTime(request.Until)
+	if err != nil {
+		return nil, err
+	}
+
+	created := &FailedEventReplayJob{
+		Status:      REPLAY_JOB_STATUS_RUNNING,
+		Service:     rdb.NullStrOf(request.Service),
+		DeviceToken: rdb.NullStrOf(request.DeviceToken),
+		Since:       since,
+		Until:       until,
+	}
+	if request.Reason != nil {
+		created.Reason = sql.NullInt32{Int32: *request.Reason, Valid: true}
+	}
+	result := api.RDB.Database.Create(created)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return created, nil
+}
+
+// Save progress for a failed event replay job.
+func (api *Api) UpdateFailedEventReplayJob(ctx context.Context, job *FailedEventReplayJob) error {
+	result := api.RDB.Database.Save(job)
+	return result.Error
+}
+
+// Get failed event replay jobs by id.
+func (api *Api) FailedEventReplayJobsById(ctx context.Context, ids []uint) ([]*FailedEventReplayJob, error) {
+	found := make([]*FailedEventReplayJob, 0)
+	result := api.RDB.Database.Find(&found, ids)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return found, nil
+}
@@ -18,6 +18,7 @@ package model
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/devicechain-io/dc-microservice/rdb"
 	"gorm.io/gorm"
@@ -43,10 +44,13 @@ func (api *Api) CreateDeviceType(ctx context.Context, request *DeviceTypeCreateR
 		MetadataEntity: rdb.MetadataEntity{
 			Metadata: rdb.MetadataStrOf(request.Metadata),
 		},
+		RateLimitedEntity: rateLimitedEntityOf(request.RateLimit, request.RateBurst),
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err := api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -63,6 +67,7 @@ func (api *Api) UpdateDeviceType(ctx context.Context, token string,
 	}
 
 	found := matches[0]
+	before := auditSnapshot(found)
 	found.Token = request.Token
 	found.Name = rdb.NullStrOf(request.Name)
 	found.Description = rdb.NullStrOf(request.Description)
@@ -72,10 +77,13 @@ func (api *Api) UpdateDeviceType(ctx context.Context, token string,
 	found.ForegroundColor = rdb.NullStrOf(request.ForegroundColor)
 	found.BorderColor = rdb.NullStrOf(request.BorderColor)
 	found.Metadata = rdb.MetadataStrOf(request.Metadata)
+	found.RateLimitedEntity = rateLimitedEntityOf(request.RateLimit, request.RateBurst)
 
-	result := api.RDB.Database.Save(found)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, found.Token, before, found, func(tx *gorm.DB) error {
+		return tx.Save(found).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return found, nil
 }
@@ -125,6 +133,10 @@ func (api *Api) CreateDevice(ctx context.Context, request *DeviceCreateRequest)
 	if len(matches) == 0 {
 		return nil, gorm.ErrRecordNotFound
 	}
+	status, err := initialDeviceStatus(request.Status)
+	if err != nil {
+		return nil, err
+	}
 
 	created := &Device{
 		TokenReference: rdb.TokenReference{
@@ -137,11 +149,15 @@ func (api *Api) CreateDevice(ctx context.Context, request *DeviceCreateRequest)
 		MetadataEntity: rdb.MetadataEntity{
 			Metadata: rdb.MetadataStrOf(request.Metadata),
 		},
-		DeviceType: matches[0],
+		RateLimitedEntity: rateLimitedEntityOf(request.RateLimit, request.RateBurst),
+		DeviceType:        matches[0],
+		Status:            status,
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -158,10 +174,12 @@ func (api *Api) UpdateDevice(ctx context.Context, token string, request *DeviceC
 
 	// Update fields that changed.
 	updated := matches[0]
+	before := auditSnapshot(updated)
 	updated.Token = request.Token
 	updated.Name = rdb.NullStrOf(request.Name)
 	updated.Description = rdb.NullStrOf(request.Description)
 	updated.Metadata = rdb.MetadataStrOf(request.Metadata)
+	updated.RateLimitedEntity = rateLimitedEntityOf(request.RateLimit, request.RateBurst)
 
 	// Update device type if changed.
 	if request.DeviceTypeToken != updated.DeviceType.Token {
@@ -175,13 +193,63 @@ func (api *Api) UpdateDevice(ctx context.Context, token string, request *DeviceC
 		updated.DeviceType = matches[0]
 	}
 
-	result := api.RDB.Database.Save(updated)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return updated, nil
 }
 
+// Build the token kept by a deleted entity. The entity id makes it unique and it still fits the
+// token column when the original token is at the maximum length.
+func deletedToken(id uint, token string) string {
+	freed := fmt.Sprintf("deleted-%d-%s", id, token)
+	if len(freed) > DELETED_TOKEN_MAX_LENGTH {
+		freed = freed[:DELETED_TOKEN_MAX_LENGTH]
+	}
+	return freed
+}
+
+// Delete an existing device. Certificates and credentials issued to the device are revoked.
+func (api *Api) DeleteDevice(ctx context.Context, token string) (*Device, error) {
+	matches, err := api.DevicesByToken(ctx, []string{token})
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	deleted := matches[0]
+	err = api.RDB.Database.Transaction(func(tx *gorm.DB) error {
+		err := revokeDeviceCertificates(tx, deleted.ID, "device deleted")
+		if err != nil {
+			return err
+		}
+		err = revokeDeviceCredentials(tx, deleted.ID)
+		if err != nil {
+			return err
+		}
+
+		// Free the token so that a device can be registered with it again.
+		result := tx.Model(&Device{}).Where("id = ?", deleted.ID).Update("token", deletedToken(deleted.ID, deleted.Token))
+		if result.Error != nil {
+			return result.Error
+		}
+		result = tx.Delete(deleted)
+		if result.Error != nil {
+			return result.Error
+		}
+		return api.withDatabase(tx).audit(ctx, AUDIT_OPERATION_DELETE, deleted.Token, deleted, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
 // Get devices by id.
 func (api *Api) DevicesById(ctx context.Context, ids []uint) ([]*Device, error) {
 	found := make([]*Device, 0)
@@ -214,6 +282,9 @@ func (api *Api) Devices(ctx context.Context, criteria DeviceSearchCriteria) (*De
 			result = result.Where("device_type_id = (?)",
 				api.RDB.Database.Model(&DeviceType{}).Select("id").Where("token = ?", criteria.DeviceType))
 		}
+		if criteria.Status != nil {
+			result = result.Where("status = ?", criteria.Status)
+		}
 		return result.Preload("DeviceType")
 	}, criteria.Pagination)
 	db.Find(&results)
@@ -230,6 +301,11 @@ func (api *Api) Devices(ctx context.Context, criteria DeviceSearchCriteria) (*De
 
 // Create a new device relationship type.
 func (api *Api) CreateDeviceRelationshipType(ctx context.Context, request *DeviceRelationshipTypeCreateRequest) (*DeviceRelationshipType, error) {
+	constraints, err := relationshipConstraintsOf(request.RelationshipConstraintsCreateRequest)
+	if err != nil {
+		return nil, err
+	}
+
 	created := &DeviceRelationshipType{
 		TokenReference: rdb.TokenReference{
 			Token: request.Token,
@@ -241,11 +317,16 @@ func (api *Api) CreateDeviceRelationshipType(ctx context.Context, request *Devic
 		MetadataEntity: rdb.MetadataEntity{
 			Metadata: rdb.MetadataStrOf(request.Metadata),
 		},
-		Tracked: request.Tracked,
-	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+		Tracked:                 request.Tracked,
+		Enriched:                request.Enriched != nil && *request.Enriched,
+		EnrichedMetadataKeys:    enrichedMetadataKeysOf(request.EnrichedMetadataKeys),
+		RelationshipConstraints: constraints,
+	}
+	err = api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -261,16 +342,27 @@ func (api *Api) UpdateDeviceRelationshipType(ctx context.Context, token string,
 		return nil, gorm.ErrRecordNotFound
 	}
 
+	constraints, err := relationshipConstraintsOf(request.RelationshipConstraintsCreateRequest)
+	if err != nil {
+		return nil, err
+	}
+
 	updated := matches[0]
+	before := auditSnapshot(updated)
 	updated.Token = request.Token
 	updated.Name = rdb.NullStrOf(request.Name)
 	updated.Description = rdb.NullStrOf(request.Description)
 	updated.Metadata = rdb.MetadataStrOf(request.Metadata)
 	updated.Tracked = request.Tracked
+	updated.Enriched = request.Enriched != nil && *request.Enriched
+	updated.EnrichedMetadataKeys = enrichedMetadataKeysOf(request.EnrichedMetadataKeys)
+	updated.RelationshipConstraints = constraints
 
-	result := api.RDB.Database.Save(updated)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return updated, nil
 }
@@ -354,10 +446,20 @@ func (api *Api) CreateDeviceRelationship(ctx context.Context, request *DeviceRel
 		SourceDevice:     *mmap[request.SourceDevice],
 		RelationshipType: *drmatches[0],
 	}
-	api.resolveRelationshipTargets(ctx, request.Targets, &created.EntityRelationship)
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.resolveRelationshipTargets(ctx, request.Targets, &created.EntityRelationship)
+	if err != nil {
+		return nil, err
+	}
+	var stype *string
+	if created.SourceDevice.DeviceType != nil {
+		stype = &created.SourceDevice.DeviceType.Token
+	}
+	rtype := created.RelationshipType
+	ref := relationshipTypeRef{Id: rtype.ID, Token: rtype.Token, Constraints: rtype.RelationshipConstraints}
+	err = api.createConstrainedRelationship(ctx, ENTITY_KIND_DEVICE, "source_device_id",
+		created.SourceDevice.ID, stype, ref, created)
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -391,6 +493,10 @@ func (api *Api) DeviceRelationshipsByToken(ctx context.Context, tokens []string)
 // Search for device relationships that meet criteria.
 func (api *Api) DeviceRelationships(ctx context.Context,
 	criteria DeviceRelationshipSearchCriteria) (*DeviceRelationshipSearchResults, error) {
+	err := validateRelationshipTarget(criteria.EntityRelationshipTargetCriteria)
+	if err != nil {
+		return nil, err
+	}
 	results := make([]DeviceRelationship, 0)
 	db, pag := api.RDB.ListOf(&DeviceRelationship{}, func(result *gorm.DB) *gorm.DB {
 		if criteria.SourceDevice != nil {
@@ -405,10 +511,14 @@ func (api *Api) DeviceRelationships(ctx context.Context,
 			result = result.Where("relationship_type_id in (?)",
 				api.RDB.Database.Model(&DeviceRelationshipType{}).Select("id").Where("tracked = ?", criteria.Tracked))
 		}
+		result = api.whereRelationshipTarget(result, criteria.EntityRelationshipTargetCriteria)
 		return result
 	}, criteria.Pagination)
 	db.Preload("SourceDevice").Preload("RelationshipType")
 	db = preloadRelationshipTargets(db)
+	if criteria.WithTargetTypes {
+		db = preloadRelationshipTargetTypes(db)
+	}
 	db.Find(&results)
 	if db.Error != nil {
 		return nil, db.Error
@@ -442,9 +552,11 @@ func (api *Api) CreateDeviceGroup(ctx context.Context, request *DeviceGroupCreat
 			Metadata: rdb.MetadataStrOf(request.Metadata),
 		},
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err := api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -461,6 +573,7 @@ func (api *Api) UpdateDeviceGroup(ctx context.Context, token string,
 	}
 
 	updated := matches[0]
+	before := auditSnapshot(updated)
 	updated.Token = request.Token
 	updated.Name = rdb.NullStrOf(request.Name)
 	updated.Description = rdb.NullStrOf(request.Description)
@@ -471,9 +584,11 @@ func (api *Api) UpdateDeviceGroup(ctx context.Context, token string,
 	updated.BorderColor = rdb.NullStrOf(request.BorderColor)
 	updated.Metadata = rdb.MetadataStrOf(request.Metadata)
 
-	result := api.RDB.Database.Save(updated)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return updated, nil
 }
@@ -517,6 +632,11 @@ func (api *Api) DeviceGroups(ctx context.Context, criteria DeviceGroupSearchCrit
 // Create a new device group relationship type.
 func (api *Api) CreateDeviceGroupRelationshipType(ctx context.Context,
 	request *DeviceGroupRelationshipTypeCreateRequest) (*DeviceGroupRelationshipType, error) {
+	constraints, err := relationshipConstraintsOf(request.RelationshipConstraintsCreateRequest)
+	if err != nil {
+		return nil, err
+	}
+
 	created := &DeviceGroupRelationshipType{
 		TokenReference: rdb.TokenReference{
 			Token: request.Token,
@@ -528,10 +648,13 @@ func (api *Api) CreateDeviceGroupRelationshipType(ctx context.Context,
 		MetadataEntity: rdb.MetadataEntity{
 			Metadata: rdb.MetadataStrOf(request.Metadata),
 		},
+		RelationshipConstraints: constraints,
 	}
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -547,15 +670,24 @@ func (api *Api) UpdateDeviceGroupRelationshipType(ctx context.Context, token str
 		return nil, gorm.ErrRecordNotFound
 	}
 
+	constraints, err := relationshipConstraintsOf(request.RelationshipConstraintsCreateRequest)
+	if err != nil {
+		return nil, err
+	}
+
 	updated := matches[0]
+	before := auditSnapshot(updated)
 	updated.Token = request.Token
 	updated.Name = rdb.NullStrOf(request.Name)
 	updated.Description = rdb.NullStrOf(request.Description)
 	updated.Metadata = rdb.MetadataStrOf(request.Metadata)
+	updated.RelationshipConstraints = constraints
 
-	result := api.RDB.Database.Save(updated)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
 	}
 	return updated, nil
 }
@@ -631,10 +763,16 @@ func (api *Api) CreateDeviceGroupRelationship(ctx context.Context,
 		SourceDeviceGroup: *gmatches[0],
 		RelationshipType:  *rtmatches[0],
 	}
-	api.resolveRelationshipTargets(ctx, request.Targets, &created.EntityRelationship)
-	result := api.RDB.Database.Create(created)
-	if result.Error != nil {
-		return nil, result.Error
+	err = api.resolveRelationshipTargets(ctx, request.Targets, &created.EntityRelationship)
+	if err != nil {
+		return nil, err
+	}
+	rtype := created.RelationshipType
+	ref := relationshipTypeRef{Id: rtype.ID, Token: rtype.Token, Constraints: rtype.RelationshipConstraints}
+	err = api.createConstrainedRelationship(ctx, ENTITY_KIND_DEVICE_GROUP, "source_device_group_id",
+		created.SourceDeviceGroup.ID, nil, ref, created)
+	if err != nil {
+		return nil, err
 	}
 	return created, nil
 }
@@ -668,6 +806,10 @@ func (api *Api) DeviceGroupRelationshipsByToken(ctx context.Context, tokens []st
 // Search for device group relationships that meet criteria.
 func (api *Api) DeviceGroupRelationships(ctx context.Context,
 	criteria DeviceGroupRelationshipSearchCriteria) (*DeviceGroupRelationshipSearchResults, error) {
+	err := validateRelationshipTarget(criteria.EntityRelationshipTargetCriteria)
+	if err != nil {
+		return nil, err
+	}
 	results := make([]DeviceGroupRelationship, 0)
 	db, pag := api.RDB.ListOf(&DeviceGroupRelationship{}, func(result *gorm.DB) *gorm.DB {
 		if criteria.SourceDeviceGroup != nil {
@@ -678,6 +820,7 @@ func (api *Api) DeviceGroupRelationships(ctx context.Context,
 			result = result.Where("relationship_type_id = (?)",
 				api.RDB.Database.Model(&DeviceGroupRelationshipType{}).Select("id").Where("token = ?", criteria.RelationshipType))
 		}
+		result = api.whereRelationshipTarget(result, criteria.EntityRelationshipTargetCriteria)
 		return result
 	}, criteria.Pagination)
 	db.Preload("SourceDeviceGroup").Preload("RelationshipType")
@@ -0,0 +1,135 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Determine the status for a newly created device.
+func initialDeviceStatus(status *string) (string, error) {
+	if status == nil {
+		return DEVICE_STATUS_ACTIVE, nil
+	}
+	for _, allowed := range DeviceInitialStatuses {
+		if *status == allowed {
+			return allowed, nil
+		}
+	}
+	return "", fmt.Errorf("device may not be created with status: %s", *status)
+}
+
+// Indicates whether a device may move from one status to another.
+func IsLegalDeviceStatusTransition(from string, to string) bool {
+	for _, allowed := range DeviceStatusLegalTransitions[from] {
+		if to == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// Transition a device to a new status, recording the change in its history. The device row is
+// locked while the transition is validated so that concurrent transitions are applied one at a
+// time. The change is attributed to the actor making the request.
+func (api *Api) TransitionDeviceStatus(ctx context.Context, token string,
+	request *DeviceStatusTransitionRequest) (*Device, error) {
+	matches, err := api.DevicesByToken(ctx, []string{token})
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	updated := matches[0]
+	var before map[string]interface{}
+	err = api.RDB.Database.Transaction(func(tx *gorm.DB) error {
+		locked := &Device{}
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(locked, updated.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		updated.Status = locked.Status
+		before = auditSnapshot(updated)
+		if !IsLegalDeviceStatusTransition(locked.Status, request.Status) {
+			return fmt.Errorf("device may not transition from %s to %s", locked.Status, request.Status)
+		}
+		transition := &DeviceStatusTransition{
+			DeviceId:   updated.ID,
+			FromStatus: locked.Status,
+			ToStatus:   request.Status,
+			Actor:      sql.NullString{String: ActorFromContext(ctx), Valid: true},
+			Reason:     rdb.NullStrOf(request.Reason),
+		}
+		updated.Status = request.Status
+		result = tx.Model(updated).Update("status", updated.Status)
+		if result.Error != nil {
+			return result.Error
+		}
+		result = tx.Create(transition)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		// Decommissioned devices may no longer authenticate.
+		if updated.Status == DEVICE_STATUS_DECOMMISSIONED {
+			err := revokeDeviceCertificates(tx, updated.ID, "device decommissioned")
+			if err != nil {
+				return err
+			}
+			err = revokeDeviceCredentials(tx, updated.ID)
+			if err != nil {
+				return err
+			}
+		}
+		return api.withDatabase(tx).audit(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Search for device status transitions that meet criteria.
+func (api *Api) DeviceStatusTransitions(ctx context.Context,
+	criteria DeviceStatusTransitionSearchCriteria) (*DeviceStatusTransitionSearchResults, error) {
+	results := make([]DeviceStatusTransition, 0)
+	db, pag := api.RDB.ListOf(&DeviceStatusTransition{}, func(result *gorm.DB) *gorm.DB {
+		if criteria.Device != nil {
+			result = result.Where("device_id = (?)",
+				api.RDB.Database.Model(&Device{}).Select("id").Where("token = ?", criteria.Device))
+		}
+		return result.Preload("Device")
+	}, criteria.Pagination)
+	db = db.Order("created_at desc").Find(&results)
+	if db.Error != nil {
+		return nil, db.Error
+	}
+
+	// Wrap as search results.
+	return &DeviceStatusTransitionSearchResults{
+		Results:    results,
+		Pagination: pag,
+	}, nil
+}
@@ -0,0 +1,363 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// Relationship from any family reduced to the details needed for traversal.
+type graphRelationship struct {
+	Relationship     *EntityRelationship
+	Source           EntityGraphNode
+	RelationshipType string
+	Tracked          bool
+}
+
+// Fields of an entity included in graph nodes.
+type entityGraphRow struct {
+	ID    uint
+	Token string
+	Name  sql.NullString
+}
+
+// Get an empty instance of the model for a kind of entity.
+func entityModelOf(kind string) interface{} {
+	switch kind {
+	case ENTITY_KIND_DEVICE:
+		return &Device{}
+	case ENTITY_KIND_DEVICE_GROUP:
+		return &DeviceGroup{}
+	case ENTITY_KIND_ASSET:
+		return &Asset{}
+	case ENTITY_KIND_ASSET_GROUP:
+		return &AssetGroup{}
+	case ENTITY_KIND_AREA:
+		return &Area{}
+	case ENTITY_KIND_AREA_GROUP:
+		return &AreaGroup{}
+	case ENTITY_KIND_CUSTOMER:
+		return &Customer{}
+	case ENTITY_KIND_CUSTOMER_GROUP:
+		return &CustomerGroup{}
+	}
+	return nil
+}
+
+// Get ids of nodes with the given kind.
+func entityGraphIds(nodes []EntityGraphNode, kind string) []uint {
+	ids := make([]uint, 0)
+	for _, node := range nodes {
+		if node.Kind == kind {
+			ids = append(ids, node.Id)
+		}
+	}
+	return ids
+}
+
+// Get nodes for the targets of a relationship.
+func entityGraphTargets(rel *EntityRelationship, depth int) []EntityGraphNode {
+	nodes := make([]EntityGraphNode, 0)
+	if rel.TargetDevice != nil {
+		nodes = append(nodes, EntityGraphNode{ENTITY_KIND_DEVICE, rel.TargetDevice.ID,
+			rel.TargetDevice.Token, rel.TargetDevice.Name, depth})
+	}
+	if rel.TargetDeviceGroup != nil {
+		nodes = append(nodes, EntityGraphNode{ENTITY_KIND_DEVICE_GROUP, rel.TargetDeviceGroup.ID,
+			rel.TargetDeviceGroup.Token, rel.TargetDeviceGroup.Name, depth})
+	}
+	if rel.TargetAsset != nil {
+		nodes = append(nodes, EntityGraphNode{ENTITY_KIND_ASSET, rel.TargetAsset.ID,
+			rel.TargetAsset.Token, rel.TargetAsset.Name, depth})
+	}
+	if rel.TargetAssetGroup != nil {
+		nodes = append(nodes, EntityGraphNode{ENTITY_KIND_ASSET_GROUP, rel.TargetAssetGroup.ID,
+			rel.TargetAssetGroup.Token, rel.TargetAssetGroup.Name, depth})
+	}
+	if rel.TargetArea != nil {
+		nodes = append(nodes, EntityGraphNode{ENTITY_KIND_AREA, rel.TargetArea.ID,
+			rel.TargetArea.Token, rel.TargetArea.Name, depth})
+	}
+	if rel.TargetAreaGroup != nil {
+		nodes = append(nodes, EntityGraphNode{ENTITY_KIND_AREA_GROUP, rel.TargetAreaGroup.ID,
+			rel.TargetAreaGroup.Token, rel.TargetAreaGroup.Name, depth})
+	}
+	if rel.TargetCustomer != nil {
+		nodes = append(nodes, EntityGraphNode{ENTITY_KIND_CUSTOMER, rel.TargetCustomer.ID,
+			rel.TargetCustomer.Token, rel.TargetCustomer.Name, depth})
+	}
+	if rel.TargetCustomerGroup != nil {
+		nodes = append(nodes, EntityGraphNode{ENTITY_KIND_CUSTOMER_GROUP, rel.TargetCustomerGroup.ID,
+			rel.TargetCustomerGroup.Token, rel.TargetCustomerGroup.Name, depth})
+	}
+	return nodes
+}
+
+// Find entities of a kind with the given token.
+func (api *Api) entityGraphNodes(ctx context.Context, kind string, token string) ([]EntityGraphNode, error) {
+	rows := make([]entityGraphRow, 0)
+	result := api.RDB.Database.Model(entityModelOf(kind)).Where("token = ?", token).Find(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	nodes := make([]EntityGraphNode, 0)
+	for _, row := range rows {
+		nodes = append(nodes, EntityGraphNode{Kind: kind, Id: row.ID, Token: row.Token, Name: row.Name})
+	}
+	return nodes, nil
+}
+
+// List relationships from the family with the given kind of source where a column references
+// one of the given ids.
+func (api *Api) graphRelationships(ctx context.Context, family string, column string,
+	ids []uint) ([]graphRelationship, error) {
+	db := api.RDB.Database.Preload("RelationshipType")
+	db = preloadRelationshipTargets(db)
+	db = db.Where(fmt.Sprintf("%s in ?", column), ids).Order("id")
+
+	rels := make([]graphRelationship, 0)
+	source := func(id uint, token string, name sql.NullString) EntityGraphNode {
+		return EntityGraphNode{Kind: family, Id: id, Token: token, Name: name}
+	}
+	switch family {
+	case ENTITY_KIND_DEVICE:
+		found := make([]DeviceRelationship, 0)
+		if err := db.Preload("SourceDevice").Find(&found).Error; err != nil {
+			return nil, err
+		}
+		for i, rel := range found {
+			rels = append(rels, graphRelationship{&found[i].EntityRelationship,
+				source(rel.SourceDevice.ID, rel.SourceDevice.Token, rel.SourceDevice.Name),
+				rel.RelationshipType.Token, rel.RelationshipType.Tracked})
+		}
+	case ENTITY_KIND_DEVICE_GROUP:
+		found := make([]DeviceGroupRelationship, 0)
+		if err := db.Preload("SourceDeviceGroup").Find(&found).Error; err != nil {
+			return nil, err
+		}
+		for i, rel := range found {
+			rels = append(rels, graphRelationship{&found[i].EntityRelationship,
+				source(rel.SourceDeviceGroup.ID, rel.SourceDeviceGroup.Token, rel.SourceDeviceGroup.Name),
+				rel.RelationshipType.Token, false})
+		}
+	case ENTITY_KIND_ASSET:
+		found := make([]AssetRelationship, 0)
+		if err := db.Preload("SourceAsset").Find(&found).Error; err != nil {
+			return nil, err
+		}
+		for i, rel := range found {
+			rels = append(rels, graphRelationship{&found[i].EntityRelationship,
+				source(rel.SourceAsset.ID, rel.SourceAsset.Token, rel.SourceAsset.Name),
+				rel.RelationshipType.Token, rel.RelationshipType.Tracked})
+		}
+	case ENTITY_KIND_ASSET_GROUP:
+		found := make([]AssetGroupRelationship, 0)
+		if err := db.Preload("SourceAssetGroup").Find(&found).Error; err != nil {
+			return nil, err
+		}
+		for i, rel := range found {
+			rels = append(rels, graphRelationship{&found[i].EntityRelationship,
+				source(rel.SourceAssetGroup.ID, rel.SourceAssetGroup.Token, rel.SourceAssetGroup.Name),
+				rel.RelationshipType.Token, false})
+		}
+	case ENTITY_KIND_AREA:
+		found := make([]AreaRelationship, 0)
+		if err := db.Preload("SourceArea").Find(&found).Error; err != nil {
+			return nil, err
+		}
+		for i, rel := range found {
+			rels = append(rels, graphRelationship{&found[i].EntityRelationship,
+				source(rel.SourceArea.ID, rel.SourceArea.Token, rel.SourceArea.Name),
+				rel.RelationshipType.Token, rel.RelationshipType.Tracked})
+		}
+	case ENTITY_KIND_AREA_GROUP:
+		found := make([]AreaGroupRelationship, 0)
+		if err := db.Preload("SourceAreaGroup").Find(&found).Error; err != nil {
+			return nil, err
+		}
+		for i, rel := range found {
+			rels = append(rels, graphRelationship{&found[i].EntityRelationship,
+				source(rel.SourceAreaGroup.ID, rel.SourceAreaGroup.Token, rel.SourceAreaGroup.Name),
+				rel.RelationshipType.Token, false})
+		}
+	case ENTITY_KIND_CUSTOMER:
+		found := make([]CustomerRelationship, 0)
+		if err := db.Preload("SourceCustomer").Find(&found).Error; err != nil {
+			return nil, err
+		}
+		for i, rel := range found {
+			rels = append(rels, graphRelationship{&found[i].EntityRelationship,
+				source(rel.SourceCustomer.ID, rel.SourceCustomer.Token, rel.SourceCustomer.Name),
+				rel.RelationshipType.Token, rel.RelationshipType.Tracked})
+		}
+	case ENTITY_KIND_CUSTOMER_GROUP:
+		found := make([]CustomerGroupRelationship, 0)
+		if err := db.Preload("SourceCustomerGroup").Find(&found).Error; err != nil {
+			return nil, err
+		}
+		for i, rel := range found {
+			rels = append(rels, graphRelationship{&found[i].EntityRelationship,
+				source(rel.SourceCustomerGroup.ID, rel.SourceCustomerGroup.Token, rel.SourceCustomerGroup.Name),
+				rel.RelationshipType.Token, false})
+		}
+	}
+	return rels, nil
+}
+
+// Get the entities and relationships reachable from a root entity across all relationship
+// families. Relationships that lead back to entities already reached are included as edges,
+// but their targets are not traversed again.
+func (api *Api) EntityGraph(ctx context.Context, criteria EntityGraphCriteria) (*EntityGraph, error) {
+	if !IsEntityKind(criteria.RootKind) {
+		return nil, fmt.Errorf("unknown entity kind: %s", criteria.RootKind)
+	}
+	roots, err := api.entityGraphNodes(ctx, criteria.RootKind, criteria.RootToken)
+	if err != nil {
+		return nil, err
+	}
+	if len(roots) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	types := make(map[string]bool)
+	if criteria.RelationshipTypes != nil {
+		for _, rtype := range *criteria.RelationshipTypes {
+			types[rtype] = true
+		}
+	}
+
+	graph := &EntityGraph{
+		Root:  roots[0],
+		Nodes: []EntityGraphNode{roots[0]},
+		Edges: make([]EntityGraphEdge, 0),
+	}
+	visited := map[string]bool{roots[0].Key(): true}
+	frontier := []EntityGraphNode{roots[0]}
+	for depth := 1; depth <= entityGraphDepthOf(criteria.Depth) && len(frontier) > 0; depth++ {
+		next := make([]EntityGraphNode, 0)
+		for _, kind := range EntityKinds {
+			ids := entityGraphIds(frontier, kind)
+			if len(ids) == 0 {
+				continue
+			}
+			column := fmt.Sprintf("source_%s_id", entityKindColumns[kind])
+			rels, err := api.graphRelationships(ctx, kind, column, ids)
+			if err != nil {
+				return nil, err
+			}
+			for _, rel := range rels {
+				if len(types) > 0 && !types[rel.RelationshipType] {
+					continue
+				}
+				for _, target := range entityGraphTargets(rel.Relationship, depth) {
+					graph.Edges = append(graph.Edges, EntityGraphEdge{
+						RelationshipId:    rel.Relationship.ID,
+						RelationshipToken: rel.Relationship.Token,
+						RelationshipType:  rel.RelationshipType,
+						Tracked:           rel.Tracked,
+						SourceKind:        rel.Source.Kind,
+						SourceToken:       rel.Source.Token,
+						TargetKind:        target.Kind,
+						TargetToken:       target.Token,
+						Depth:             depth,
+					})
+					if !visited[target.Key()] {
+						visited[target.Key()] = true
+						graph.Nodes = append(graph.Nodes, target)
+						next = append(next, target)
+					}
+				}
+			}
+		}
+		frontier = next
+	}
+	return graph, nil
+}
+
+// Find devices whose events reach an entity, either through a tracked device relationship that
+// targets it or by fanning out along tracked asset, area and customer relationships leading to it.
+// When no kind is given, entities of every kind with the token are considered.
+func (api *Api) ImpactedBy(ctx context.Context, criteria ImpactedByCriteria) ([]*Device, error) {
+	kinds := EntityKinds
+	if criteria.Kind != nil {
+		if !IsEntityKind(*criteria.Kind) {
+			return nil, fmt.Errorf("unknown entity kind: %s", *criteria.Kind)
+		}
+		kinds = []string{*criteria.Kind}
+	}
+	frontier := make([]EntityGraphNode, 0)
+	for _, kind := range kinds {
+		nodes, err := api.entityGraphNodes(ctx, kind, criteria.Token)
+		if err != nil {
+			return nil, err
+		}
+		frontier = append(frontier, nodes...)
+	}
+	if len(frontier) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	visited := make(map[string]bool)
+	for _, node := range frontier {
+		visited[node.Key()] = true
+	}
+
+	maxDepth := entityGraphDepthOf(criteria.Depth)
+	devices := make([]uint, 0)
+	found := make(map[uint]bool)
+	for depth := 0; len(frontier) > 0; depth++ {
+		families := []string{ENTITY_KIND_DEVICE}
+		if depth < maxDepth {
+			families = append(families, ENTITY_KIND_ASSET, ENTITY_KIND_AREA, ENTITY_KIND_CUSTOMER)
+		}
+		next := make([]EntityGraphNode, 0)
+		for _, kind := range EntityKinds {
+			ids := entityGraphIds(frontier, kind)
+			if len(ids) == 0 {
+				continue
+			}
+			column := fmt.Sprintf("target_%s_id", entityKindColumns[kind])
+			for _, family := range families {
+				rels, err := api.graphRelationships(ctx, family, column, ids)
+				if err != nil {
+					return nil, err
+				}
+				for _, rel := range rels {
+					if !rel.Tracked {
+						continue
+					}
+					if family == ENTITY_KIND_DEVICE {
+						if !found[rel.Source.Id] {
+							found[rel.Source.Id] = true
+							devices = append(devices, rel.Source.Id)
+						}
+					} else if !visited[rel.Source.Key()] {
+						visited[rel.Source.Key()] = true
+						next = append(next, rel.Source)
+					}
+				}
+			}
+		}
+		frontier = next
+	}
+	if len(devices) == 0 {
+		return []*Device{}, nil
+	}
+	return api.DevicesById(ctx, devices)
+}
@@ -0,0 +1,301 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Record an event for an unregistered device. Returns the pending device and whether the event was held.
+func (api *Api) RecordPendingDeviceEvent(ctx context.Context,
+	request *PendingDeviceEventCreateRequest) (*PendingDevice, bool, error) {
+	now := time.Now()
+	upsert := &PendingDevice{
+		TokenReference: rdb.TokenReference{
+			Token: request.Token,
+		},
+		FirstSeen:     now,
+		LastSeen:      now,
+		Source:        request.Source,
+		SamplePayload: rdb.NullStrOf(request.SamplePayload),
+		EventCount:    1,
+	}
+	result := api.RDB.Database.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "token"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"last_seen":      now,
+			"source":         upsert.Source,
+			"sample_payload": upsert.SamplePayload,
+			"event_count":    gorm.Expr("pending_devices.event_count + 1"),
+		}),
+	}).Create(upsert)
+	if result.Error != nil {
+		return nil, false, result.Error
+	}
+
+	matches, err := api.PendingDevicesByToken(ctx, []string{request.Token})
+	if err != nil {
+		return nil, false, err
+	}
+	if len(matches) == 0 {
+		return nil, false, gorm.ErrRecordNotFound
+	}
+	pending := matches[0]
+
+	// Events for rejected devices are never held.
+	if pending.Rejected {
+		return pending, false, nil
+	}
+
+	// Hold event unless the limit for the device has been reached. The pending device is locked
+	// so that concurrent events can not each see room for one more event.
+	held := false
+	err = api.RDB.Database.Transaction(func(tx *gorm.DB) error {
+		locked := &PendingDevice{}
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(locked, pending.ID)
+		if result.Error != nil {
+			return result.Error
+		}
+		count := int64(0)
+		result = tx.Model(&PendingDeviceEvent{}).Where("pending_device_id = ?", pending.ID).Count(&count)
+		if result.Error != nil {
+			return result.Error
+		}
+		if count >= PENDING_DEVICE_MAX_HELD_EVENTS {
+			return nil
+		}
+		event := &PendingDeviceEvent{
+			PendingDeviceId: pending.ID,
+			Payload:         request.Payload,
+		}
+		result = tx.Create(event)
+		if result.Error != nil {
+			return result.Error
+		}
+		held = true
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return pending, held, nil
+}
+
+// Get pending devices by id.
+func (api *Api) PendingDevicesById(ctx context.Context, ids []uint) ([]*PendingDevice, error) {
+	found := make([]*PendingDevice, 0)
+	result := api.RDB.Database.Find(&found, ids)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return found, nil
+}
+
+// Get pending devices by token.
+func (api *Api) PendingDevicesByToken(ctx context.Context, tokens []string) ([]*PendingDevice, error) {
+	found := make([]*PendingDevice, 0)
+	result := api.RDB.Database.Find(&found, "token in ?", tokens)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return found, nil
+}
+
+// Search for pending devices that meet criteria.
+func (api *Api) PendingDevices(ctx context.Context, criteria PendingDeviceSearchCriteria) (*PendingDeviceSearchResults, error) {
+	results := make([]PendingDevice, 0)
+	db, pag := api.RDB.ListOf(&PendingDevice{}, func(result *gorm.DB) *gorm.DB {
+		if criteria.Rejected != nil {
+			result = result.Where("rejected = ?", criteria.Rejected)
+		}
+		return result
+	}, criteria.Pagination)
+	db.Find(&results)
+	if db.Error != nil {
+		return nil, db.Error
+	}
+
+	// Wrap as search results.
+	return &PendingDeviceSearchResults{
+		Results:    results,
+		Pagination: pag,
+	}, nil
+}
+
+// Remove a pending device along with any events held for it.
+func (api *Api) deletePendingDevice(ctx context.Context, pending *PendingDevice) error {
+	result := api.RDB.Database.Unscoped().Where("pending_device_id = ?", pending.ID).Delete(&PendingDeviceEvent{})
+	if result.Error != nil {
+		return result.Error
+	}
+	result = api.RDB.Database.Unscoped().Delete(pending)
+	if result.Error != nil {
+		return result.Error
+	}
+	return nil
+}
+
+// Get events held for a pending device in the order they were received.
+func (api *Api) heldPendingDeviceEvents(ctx context.Context, pending *PendingDevice) ([][]byte, error) {
+	events := make([]PendingDeviceEvent, 0)
+	result := api.RDB.Database.Where("pending_device_id = ?", pending.ID).Order("id").Find(&events)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	held := make([][]byte, 0)
+	for _, event := range events {
+		held = append(held, event.Payload)
+	}
+	return held, nil
+}
+
+// Approve a pending device, registering it as a device with the given type and relationships.
+// The device, its relationships and removal of the pending device are committed together. When
+// events are to be replayed, the pending device is only marked deleted so that its held events
+// are kept until ReleasePendingDevice is called once they have been handed off. Approving again
+// before then returns the held events without registering the device again.
+func (api *Api) ApprovePendingDevice(ctx context.Context, token string,
+	request *PendingDeviceApprovalRequest) (*PendingDeviceApproval, error) {
+	matches := make([]*PendingDevice, 0)
+	result := api.RDB.Database.Unscoped().Find(&matches, "token = ?", token)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if len(matches) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	pending := matches[0]
+
+	// Resume an approval whose held events were not released.
+	if pending.DeletedAt.Valid {
+		devices, err := api.DevicesByToken(ctx, []string{pending.Token})
+		if err != nil {
+			return nil, err
+		}
+		if len(devices) == 0 {
+			return nil, gorm.ErrRecordNotFound
+		}
+		held, err := api.heldPendingDeviceEvents(ctx, pending)
+		if err != nil {
+			return nil, err
+		}
+		return &PendingDeviceApproval{
+			Device:        devices[0],
+			Relationships: make([]*DeviceRelationship, 0),
+			PendingDevice: pending,
+			HeldEvents:    held,
+		}, nil
+	}
+
+	approval := &PendingDeviceApproval{
+		Relationships: make([]*DeviceRelationship, 0),
+		PendingDevice: pending,
+		HeldEvents:    make([][]byte, 0),
+	}
+	err := api.RDB.Database.Transaction(func(tx *gorm.DB) error {
+		txapi := api.withDatabase(tx)
+
+		// Register the device.
+		device, err := txapi.CreateDevice(ctx, &DeviceCreateRequest{
+			Token:           pending.Token,
+			Name:            request.Name,
+			Description:     request.Description,
+			DeviceTypeToken: request.DeviceTypeToken,
+			Metadata:        request.Metadata,
+		})
+		if err != nil {
+			return err
+		}
+		approval.Device = device
+
+		// Create initial relationships.
+		if request.Relationships != nil {
+			for _, relreq := range *request.Relationships {
+				created, err := txapi.CreateDeviceRelationship(ctx, &DeviceRelationshipCreateRequest{
+					Token:            relreq.Token,
+					SourceDevice:     device.Token,
+					RelationshipType: relreq.RelationshipType,
+					Targets:          relreq.Targets,
+					Metadata:         relreq.Metadata,
+				})
+				if err != nil {
+					return err
+				}
+				approval.Relationships = append(approval.Relationships, created)
+			}
+		}
+
+		// Keep held events if they are to be replayed.
+		if request.ReplayEvents {
+			approval.HeldEvents, err = txapi.heldPendingDeviceEvents(ctx, pending)
+			if err != nil {
+				return err
+			}
+		}
+		if len(approval.HeldEvents) > 0 {
+			err = tx.Delete(pending).Error
+		} else {
+			err = txapi.deletePendingDevice(ctx, pending)
+		}
+		if err != nil {
+			return err
+		}
+		return txapi.audit(ctx, AUDIT_OPERATION_DELETE, pending.Token, pending, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return approval, nil
+}
+
+// Remove an approved pending device and its held events once they have been handed off for replay.
+func (api *Api) ReleasePendingDevice(ctx context.Context, pending *PendingDevice) error {
+	return api.RDB.Database.Transaction(func(tx *gorm.DB) error {
+		return api.withDatabase(tx).deletePendingDevice(ctx, pending)
+	})
+}
+
+// Reject a pending device. Held events are discarded and future events are blocked.
+func (api *Api) RejectPendingDevice(ctx context.Context, token string) (*PendingDevice, error) {
+	matches, err := api.PendingDevicesByToken(ctx, []string{token})
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	updated := matches[0]
+	before := auditSnapshot(updated)
+	updated.Rejected = true
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		result := tx.Save(updated)
+		if result.Error != nil {
+			return result.Error
+		}
+		return tx.Unscoped().Where("pending_device_id = ?", updated.ID).Delete(&PendingDeviceEvent{}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
@@ -0,0 +1,231 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+
+	esmodel "github.com/devicechain-io/dc-event-sources/model"
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+var (
+	// Characters allowed in routed topic names (scoped to the instance and tenant when created).
+	routingTopicPattern = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,128}$`)
+)
+
+// Validate topics for an event routing rule and encode them for storage.
+func routingTopicsOf(topics []string) (datatypes.JSON, error) {
+	if len(topics) == 0 {
+		return nil, errors.New("at least one topic is required")
+	}
+	for _, topic := range topics {
+		if !routingTopicPattern.MatchString(topic) {
+			return nil, fmt.Errorf("invalid topic name: %s", topic)
+		}
+	}
+	encoded, err := json.Marshal(topics)
+	if err != nil {
+		return nil, err
+	}
+	return datatypes.JSON(encoded), nil
+}
+
+// Apply the values from a create request to an event routing rule.
+func (api *Api) applyEventRoutingRule(ctx context.Context, request *EventRoutingRuleCreateRequest,
+	rule *EventRoutingRule) error {
+	if request.EventType != nil {
+		if _, ok := esmodel.EventTypesByName[*request.EventType]; !ok {
+			return fmt.Errorf("unknown event type: %s", *request.EventType)
+		}
+	}
+	topics, err := routingTopicsOf(request.Topics)
+	if err != nil {
+		return err
+	}
+
+	rule.Token = request.Token
+	rule.Name = rdb.NullStrOf(request.Name)
+	rule.Description = rdb.NullStrOf(request.Description)
+	rule.Metadata = rdb.MetadataStrOf(request.Metadata)
+	rule.EventType = rdb.NullStrOf(request.EventType)
+	rule.Topics = topics
+	rule.Disabled = request.Disabled != nil && *request.Disabled
+
+	rule.DeviceTypeId, rule.DeviceType = nil, nil
+	if request.DeviceType != nil {
+		matches, err := api.DeviceTypesByToken(ctx, []string{*request.DeviceType})
+		if err != nil {
+			return err
+		}
+		if len(matches) == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		rule.DeviceTypeId, rule.DeviceType = &matches[0].ID, matches[0]
+	}
+	rule.RelationshipTypeId, rule.RelationshipType = nil, nil
+	if request.RelationshipType != nil {
+		matches, err := api.DeviceRelationshipTypesByToken(ctx, []string{*request.RelationshipType})
+		if err != nil {
+			return err
+		}
+		if len(matches) == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		rule.RelationshipTypeId, rule.RelationshipType = &matches[0].ID, matches[0]
+	}
+	rule.CustomerId, rule.Customer = nil, nil
+	if request.Customer != nil {
+		matches, err := api.CustomersByToken(ctx, []string{*request.Customer})
+		if err != nil {
+			return err
+		}
+		if len(matches) == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		rule.CustomerId, rule.Customer = &matches[0].ID, matches[0]
+	}
+	rule.AreaId, rule.Area = nil, nil
+	if request.Area != nil {
+		matches, err := api.AreasByToken(ctx, []string{*request.Area})
+		if err != nil {
+			return err
+		}
+		if len(matches) == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		rule.AreaId, rule.Area = &matches[0].ID, matches[0]
+	}
+	return nil
+}
+
+// Add preloads for entities referenced by event routing rules.
+func preloadRoutingReferences(db *gorm.DB) *gorm.DB {
+	return db.Preload("DeviceType").Preload("RelationshipType").Preload("Customer").Preload("Area")
+}
+
+// Create a new event routing rule.
+func (api *Api) CreateEventRoutingRule(ctx context.Context, request *EventRoutingRuleCreateRequest) (*EventRoutingRule, error) {
+	created := &EventRoutingRule{}
+	err := api.applyEventRoutingRule(ctx, request, created)
+	if err != nil {
+		return nil, err
+	}
+	err = api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// Update an existing event routing rule.
+func (api *Api) UpdateEventRoutingRule(ctx context.Context, token string,
+	request *EventRoutingRuleCreateRequest) (*EventRoutingRule, error) {
+	matches, err := api.EventRoutingRulesByToken(ctx, []string{token})
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	updated := matches[0]
+	before := auditSnapshot(updated)
+	err = api.applyEventRoutingRule(ctx, request, updated)
+	if err != nil {
+		return nil, err
+	}
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Delete an existing event routing rule.
+func (api *Api) DeleteEventRoutingRule(ctx context.Context, token string) (*EventRoutingRule, error) {
+	matches, err := api.EventRoutingRulesByToken(ctx, []string{token})
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	deleted := matches[0]
+	err = api.audited(ctx, AUDIT_OPERATION_DELETE, deleted.Token, deleted, nil, func(tx *gorm.DB) error {
+		return tx.Delete(deleted).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+// Get event routing rules by id.
+func (api *Api) EventRoutingRulesById(ctx context.Context, ids []uint) ([]*EventRoutingRule, error) {
+	found := make([]*EventRoutingRule, 0)
+	result := preloadRoutingReferences(api.RDB.Database)
+	result = result.Find(&found, ids)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return found, nil
+}
+
+// Get event routing rules by token.
+func (api *Api) EventRoutingRulesByToken(ctx context.Context, tokens []string) ([]*EventRoutingRule, error) {
+	found := make([]*EventRoutingRule, 0)
+	result := preloadRoutingReferences(api.RDB.Database)
+	result = result.Find(&found, "token in ?", tokens)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return found, nil
+}
+
+// Search for event routing rules that meet criteria. Disabled rules are excluded unless requested.
+func (api *Api) EventRoutingRules(ctx context.Context,
+	criteria EventRoutingRuleSearchCriteria) (*EventRoutingRuleSearchResults, error) {
+	results := make([]EventRoutingRule, 0)
+	db, pag := api.RDB.ListOf(&EventRoutingRule{}, func(result *gorm.DB) *gorm.DB {
+		if criteria.IncludeDisabled == nil || !*criteria.IncludeDisabled {
+			result = result.Where("disabled = ?", false)
+		}
+		return preloadRoutingReferences(result)
+	}, criteria.Pagination)
+	db.Find(&results)
+	if db.Error != nil {
+		return nil, db.Error
+	}
+
+	// Wrap as search results.
+	return &EventRoutingRuleSearchResults{
+		Results:    results,
+		Pagination: pag,
+	}, nil
+}
@@ -0,0 +1,162 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"context"
+
+	"github.com/devicechain-io/dc-device-management/transform"
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+)
+
+// Apply the values from a create request to an event transform.
+func (api *Api) applyEventTransform(ctx context.Context, request *EventTransformCreateRequest,
+	xform *EventTransform) error {
+	_, err := transform.Compile(request.Script)
+	if err != nil {
+		return err
+	}
+	matches, err := api.DeviceTypesByToken(ctx, []string{request.DeviceType})
+	if err != nil {
+		return err
+	}
+	if len(matches) == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	xform.Token = request.Token
+	xform.Name = rdb.NullStrOf(request.Name)
+	xform.Description = rdb.NullStrOf(request.Description)
+	xform.Metadata = rdb.MetadataStrOf(request.Metadata)
+	xform.DeviceTypeId = matches[0].ID
+	xform.DeviceType = matches[0]
+	xform.Script = request.Script
+	xform.Disabled = request.Disabled != nil && *request.Disabled
+	return nil
+}
+
+// Create a new event transform.
+func (api *Api) CreateEventTransform(ctx context.Context, request *EventTransformCreateRequest) (*EventTransform, error) {
+	created := &EventTransform{}
+	err := api.applyEventTransform(ctx, request, created)
+	if err != nil {
+		return nil, err
+	}
+	err = api.audited(ctx, AUDIT_OPERATION_CREATE, created.Token, nil, created, func(tx *gorm.DB) error {
+		return tx.Create(created).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// Update an existing event transform.
+func (api *Api) UpdateEventTransform(ctx context.Context, token string,
+	request *EventTransformCreateRequest) (*EventTransform, error) {
+	matches, err := api.EventTransformsByToken(ctx, []string{token})
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	updated := matches[0]
+	before := auditSnapshot(updated)
+	err = api.applyEventTransform(ctx, request, updated)
+	if err != nil {
+		return nil, err
+	}
+	err = api.audited(ctx, AUDIT_OPERATION_UPDATE, updated.Token, before, updated, func(tx *gorm.DB) error {
+		return tx.Save(updated).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Delete an existing event transform.
+func (api *Api) DeleteEventTransform(ctx context.Context, token string) (*EventTransform, error) {
+	matches, err := api.EventTransformsByToken(ctx, []string{token})
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	deleted := matches[0]
+	err = api.audited(ctx, AUDIT_OPERATION_DELETE, deleted.Token, deleted, nil, func(tx *gorm.DB) error {
+		return tx.Delete(deleted).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return deleted, nil
+}
+
+// Get event transforms by id.
+func (api *Api) EventTransformsById(ctx context.Context, ids []uint) ([]*EventTransform, error) {
+	found := make([]*EventTransform, 0)
+	result := api.RDB.Database.Preload("DeviceType")
+	result = result.Find(&found, ids)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return found, nil
+}
+
+// Get event transforms by token.
+func (api *Api) EventTransformsByToken(ctx context.Context, tokens []string) ([]*EventTransform, error) {
+	found := make([]*EventTransform, 0)
+	result := api.RDB.Database.Preload("DeviceType")
+	result = result.Find(&found, "token in ?", tokens)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return found, nil
+}
+
+// Search for event transforms that meet criteria. Disabled transforms are excluded unless requested.
+// Results are ordered so that transforms for a device type are listed in the order they apply.
+func (api *Api) EventTransforms(ctx context.Context,
+	criteria EventTransformSearchCriteria) (*EventTransformSearchResults, error) {
+	results := make([]EventTransform, 0)
+	db, pag := api.RDB.ListOf(&EventTransform{}, func(result *gorm.DB) *gorm.DB {
+		if criteria.DeviceType != nil {
+			result = result.Where("device_type_id = (?)",
+				api.RDB.Database.Model(&DeviceType{}).Select("id").Where("token = ?", criteria.DeviceType))
+		}
+		if criteria.IncludeDisabled == nil || !*criteria.IncludeDisabled {
+			result = result.Where("disabled = ?", false)
+		}
+		return result.Preload("DeviceType").Order("id")
+	}, criteria.Pagination)
+	db.Find(&results)
+	if db.Error != nil {
+		return nil, db.Error
+	}
+
+	// Wrap as search results.
+	return &EventTransformSearchResults{
+		Results:    results,
+		Pagination: pag,
+	}, nil
+}
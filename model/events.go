@@ -80,6 +80,35 @@ type ResolvedAlertsPayload struct {
 	Entries []ResolvedAlertEntry
 }
 
+// Snapshot of an entity referenced by a resolved event.
+type EntitySnapshot struct {
+	Token     string
+	TypeToken *string
+	Name      *string
+	Metadata  map[string]string
+}
+
+// Entity snapshots embedded in a resolved event when the relationship type enables enrichment.
+type ResolvedEventEnrichment struct {
+	SourceDevice        *EntitySnapshot
+	RelationshipType    *EntitySnapshot
+	TargetDevice        *EntitySnapshot
+	TargetDeviceGroup   *EntitySnapshot
+	TargetCustomer      *EntitySnapshot
+	TargetCustomerGroup *EntitySnapshot
+	TargetArea          *EntitySnapshot
+	TargetAreaGroup     *EntitySnapshot
+	TargetAsset         *EntitySnapshot
+	TargetAssetGroup    *EntitySnapshot
+}
+
+// Tracked asset, area or customer relationship through which a resolved event was fanned out.
+type ResolvedEventFanOut struct {
+	SourceKind     string // Kind of entity at the source of the relationship
+	RelationshipId uint
+	Depth          uint32 // Number of relationships followed beyond the device relationship
+}
+
 // Event with token references resolved and info from device relationship merged.
 type ResolvedEvent struct {
 	Source                string
@@ -98,6 +127,8 @@ type ResolvedEvent struct {
 	ProcessedTime         time.Time
 	EventType             esmodel.EventType
 	Payload               interface{}
+	Enrichment            *ResolvedEventEnrichment
+	FanOut                *ResolvedEventFanOut
 }
 
 // Captures failure information for events that could not be processed.
@@ -109,6 +140,16 @@ type FailedEvent struct {
 	Payload []byte
 }
 
+// Indicates that a device started or stopped being throttled due to its event rate.
+type ThrottleEvent struct {
+	DeviceToken  string
+	Throttled    bool
+	Rate         float64
+	Burst        int
+	Dropped      uint64
+	OccurredTime time.Time
+}
+
 // Create a new FailedEvent.
 func NewFailedEvent(reason uint, service string, message string, err error, payload []byte) *FailedEvent {
 	return &FailedEvent{
@@ -0,0 +1,138 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"database/sql"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+)
+
+const (
+	ALERT_COMPARATOR_GT  = "gt"  // Value greater than threshold
+	ALERT_COMPARATOR_GTE = "gte" // Value greater than or equal to threshold
+	ALERT_COMPARATOR_LT  = "lt"  // Value less than threshold
+	ALERT_COMPARATOR_LTE = "lte" // Value less than or equal to threshold
+	ALERT_COMPARATOR_EQ  = "eq"  // Value equal to threshold
+	ALERT_COMPARATOR_NE  = "ne"  // Value not equal to threshold
+)
+
+var (
+	// Comparators that may be used by alert rules.
+	AlertComparators = []string{
+		ALERT_COMPARATOR_GT,
+		ALERT_COMPARATOR_GTE,
+		ALERT_COMPARATOR_LT,
+		ALERT_COMPARATOR_LTE,
+		ALERT_COMPARATOR_EQ,
+		ALERT_COMPARATOR_NE,
+	}
+)
+
+// Data required to create an alert rule.
+type AlertRuleCreateRequest struct {
+	Token       string
+	Name        *string
+	Description *string
+	Metadata    *string
+	DeviceType  *string
+	Device      *string
+	Measurement string
+	Comparator  string
+	Threshold   float64
+	DurationMs  *int32
+	Hysteresis  *float64
+	AlertType   string
+	Level       int32
+	Message     *string
+	Disabled    *bool
+}
+
+// Rule that generates an alert when a measurement crosses a threshold. Rules apply either to
+// all devices of a type or to a single device. The alert fires once the threshold has been
+// crossed for the given duration and does not fire again until the value has moved back past
+// the threshold by the hysteresis amount.
+type AlertRule struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+
+	DeviceTypeId *uint
+	DeviceType   *DeviceType
+	DeviceId     *uint
+	Device       *Device
+	Measurement  string  `gorm:"size:128;not null"`
+	Comparator   string  `gorm:"size:8;not null"`
+	Threshold    float64 `gorm:"not null"`
+	DurationMs   int32   `gorm:"not null;default:0"`
+	Hysteresis   float64 `gorm:"not null;default:0"`
+	AlertType    string  `gorm:"size:128;not null"`
+	Level        uint32  `gorm:"not null"`
+	Message      sql.NullString
+	Disabled     bool `gorm:"not null;default:false"`
+}
+
+// Search criteria for locating alert rules.
+type AlertRuleSearchCriteria struct {
+	rdb.Pagination
+	DeviceType      *string
+	Device          *string
+	IncludeDisabled *bool
+}
+
+// Results for alert rule search.
+type AlertRuleSearchResults struct {
+	Results    []AlertRule
+	Pagination rdb.SearchResultsPagination
+}
+
+// Indicates whether a measurement value crosses the rule threshold.
+func (rule *AlertRule) Breached(value float64) bool {
+	switch rule.Comparator {
+	case ALERT_COMPARATOR_GT:
+		return value > rule.Threshold
+	case ALERT_COMPARATOR_GTE:
+		return value >= rule.Threshold
+	case ALERT_COMPARATOR_LT:
+		return value < rule.Threshold
+	case ALERT_COMPARATOR_LTE:
+		return value <= rule.Threshold
+	case ALERT_COMPARATOR_EQ:
+		return value == rule.Threshold
+	case ALERT_COMPARATOR_NE:
+		return value != rule.Threshold
+	}
+	return false
+}
+
+// Indicates whether a measurement value has moved far enough back past the threshold for
+// an alert that has fired to be cleared.
+func (rule *AlertRule) Cleared(value float64) bool {
+	switch rule.Comparator {
+	case ALERT_COMPARATOR_GT:
+		return value <= rule.Threshold-rule.Hysteresis
+	case ALERT_COMPARATOR_GTE:
+		return value < rule.Threshold-rule.Hysteresis
+	case ALERT_COMPARATOR_LT:
+		return value >= rule.Threshold+rule.Hysteresis
+	case ALERT_COMPARATOR_LTE:
+		return value > rule.Threshold+rule.Hysteresis
+	}
+	return !rule.Breached(value)
+}
@@ -0,0 +1,133 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+)
+
+const (
+	ALERT_STATE_OPEN         = "Open"         // Alert has occurred and has not been handled
+	ALERT_STATE_ACKNOWLEDGED = "Acknowledged" // Alert has been seen but not yet resolved
+	ALERT_STATE_RESOLVED     = "Resolved"     // Alert has been resolved
+)
+
+// Data recorded each time an alert occurs for a device.
+type AlertOccurrenceRequest struct {
+	DeviceId     uint
+	AlertType    string
+	Level        uint32
+	Message      string
+	Source       string
+	OccurredTime time.Time
+}
+
+// Data required to acknowledge or resolve an alert.
+type AlertStateChangeRequest struct {
+	Actor   *string
+	Comment *string
+}
+
+// Alert instance for a device. Occurrences of an alert type for a device are counted against
+// the same instance until it is resolved, after which a new instance is opened.
+type Alert struct {
+	gorm.Model
+	rdb.TokenReference
+
+	DeviceId          uint `gorm:"index"`
+	Device            *Device
+	AlertType         string `gorm:"size:128;not null;index"`
+	State             string `gorm:"size:16;not null;index"`
+	Level             uint32 `gorm:"not null"`
+	Message           sql.NullString
+	Source            sql.NullString `gorm:"size:128"`
+	Occurrences       uint           `gorm:"not null"`
+	FirstOccurred     time.Time
+	LastOccurred      time.Time
+	AcknowledgedAt    sql.NullTime
+	AcknowledgedBy    sql.NullString `gorm:"size:128"`
+	ResolvedAt        sql.NullTime
+	ResolvedBy        sql.NullString `gorm:"size:128"`
+	ResolutionComment sql.NullString
+}
+
+// Search criteria for locating active alerts.
+type AlertSearchCriteria struct {
+	rdb.Pagination
+	Device    *string
+	Customer  *string
+	Area      *string
+	AlertType *string
+	MinLevel  *int32
+	State     *string
+}
+
+// Results for alert search.
+type AlertSearchResults struct {
+	Results    []Alert
+	Pagination rdb.SearchResultsPagination
+}
+
+// Data required to create an alert suppression window.
+type AlertSuppressionCreateRequest struct {
+	Token       string
+	Name        *string
+	Description *string
+	Metadata    *string
+	Device      *string
+	DeviceGroup *string
+	AlertType   *string
+	StartTime   string
+	EndTime     string
+}
+
+// Window during which alerts for a device or members of a device group are not recorded.
+// Devices are members of a group if they have a relationship targeting the group or the group
+// has a relationship targeting them. If an alert type is set only alerts of that type are
+// suppressed.
+type AlertSuppression struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+
+	DeviceId      *uint
+	Device        *Device
+	DeviceGroupId *uint
+	DeviceGroup   *DeviceGroup
+	AlertType     sql.NullString `gorm:"size:128"`
+	StartTime     time.Time      `gorm:"not null"`
+	EndTime       time.Time      `gorm:"not null;index"`
+}
+
+// Search criteria for locating alert suppression windows.
+type AlertSuppressionSearchCriteria struct {
+	rdb.Pagination
+	Device       *string
+	DeviceGroup  *string
+	IncludeEnded *bool
+}
+
+// Results for alert suppression search.
+type AlertSuppressionSearchResults struct {
+	Results    []AlertSuppression
+	Pagination rdb.SearchResultsPagination
+}
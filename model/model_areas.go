@@ -94,6 +94,8 @@ type AreaRelationshipTypeCreateRequest struct {
 	Name        *string
 	Description *string
 	Metadata    *string
+	Tracked     *bool
+	RelationshipConstraintsCreateRequest
 }
 
 // Metadata indicating a relationship between areas.
@@ -102,6 +104,8 @@ type AreaRelationshipType struct {
 	rdb.TokenReference
 	rdb.NamedEntity
 	rdb.MetadataEntity
+	Tracked bool
+	RelationshipConstraints
 }
 
 // Search criteria for locating area relationship types.
@@ -136,8 +140,10 @@ type AreaRelationship struct {
 // Search criteria for locating area relationships.
 type AreaRelationshipSearchCriteria struct {
 	rdb.Pagination
+	EntityRelationshipTargetCriteria
 	SourceArea       *string
 	RelationshipType *string
+	Tracked          *bool
 }
 
 // Results for area relationship search.
@@ -185,6 +191,7 @@ type AreaGroupRelationshipTypeCreateRequest struct {
 	Name        *string
 	Description *string
 	Metadata    *string
+	RelationshipConstraintsCreateRequest
 }
 
 // Metadata indicating a relationship between area and group.
@@ -193,6 +200,7 @@ type AreaGroupRelationshipType struct {
 	rdb.TokenReference
 	rdb.NamedEntity
 	rdb.MetadataEntity
+	RelationshipConstraints
 }
 
 // Search criteria for locating area groups relationship types.
@@ -227,6 +235,7 @@ type AreaGroupRelationship struct {
 // Search criteria for locating area groups relationships.
 type AreaGroupRelationshipSearchCriteria struct {
 	rdb.Pagination
+	EntityRelationshipTargetCriteria
 	SourceAreaGroup  *string
 	RelationshipType *string
 }
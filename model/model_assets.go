@@ -94,6 +94,8 @@ type AssetRelationshipTypeCreateRequest struct {
 	Name        *string
 	Description *string
 	Metadata    *string
+	Tracked     *bool
+	RelationshipConstraintsCreateRequest
 }
 
 // Metadata indicating a relationship between assets.
@@ -102,6 +104,8 @@ type AssetRelationshipType struct {
 	rdb.TokenReference
 	rdb.NamedEntity
 	rdb.MetadataEntity
+	Tracked bool
+	RelationshipConstraints
 }
 
 // Search criteria for locating asset relationship types.
@@ -136,8 +140,10 @@ type AssetRelationship struct {
 // Search criteria for locating asset relationships.
 type AssetRelationshipSearchCriteria struct {
 	rdb.Pagination
+	EntityRelationshipTargetCriteria
 	SourceAsset      *string
 	RelationshipType *string
+	Tracked          *bool
 }
 
 // Results for asset relationship search.
@@ -185,6 +191,7 @@ type AssetGroupRelationshipTypeCreateRequest struct {
 	Name        *string
 	Description *string
 	Metadata    *string
+	RelationshipConstraintsCreateRequest
 }
 
 // Metadata indicating a relationship between asset and group.
@@ -193,6 +200,7 @@ type AssetGroupRelationshipType struct {
 	rdb.TokenReference
 	rdb.NamedEntity
 	rdb.MetadataEntity
+	RelationshipConstraints
 }
 
 // Search criteria for locating asset group relationship types.
@@ -228,6 +236,7 @@ type AssetGroupRelationship struct {
 // Search criteria for locating asset group relationships.
 type AssetGroupRelationshipSearchCriteria struct {
 	rdb.Pagination
+	EntityRelationshipTargetCriteria
 	SourceAssetGroup *string
 	RelationshipType *string
 }
@@ -0,0 +1,61 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"time"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+const (
+	AUDIT_OPERATION_CREATE = "Create" // Entity was created
+	AUDIT_OPERATION_UPDATE = "Update" // Entity was updated
+	AUDIT_OPERATION_DELETE = "Delete" // Entity was deleted
+	AUDIT_SYSTEM_ACTOR     = "system" // Actor recorded when none is available in context
+)
+
+// Record of a change made through the API.
+type AuditEntry struct {
+	gorm.Model
+	Actor       string `gorm:"size:128;not null;index"`
+	Tenant      string `gorm:"size:128;index"`
+	EntityKind  string `gorm:"size:64;not null;index"`
+	EntityToken string `gorm:"size:128;index"`
+	Operation   string `gorm:"size:32;not null"`
+	Changes     datatypes.JSON
+	OccurredAt  time.Time `gorm:"not null;index"`
+}
+
+// Search criteria for locating audit entries.
+type AuditEntrySearchCriteria struct {
+	rdb.Pagination
+	EntityKind  *string
+	EntityToken *string
+	Actor       *string
+	Operation   *string
+	Since       *string
+	Until       *string
+}
+
+// Results for audit entry search.
+type AuditEntrySearchResults struct {
+	Results    []AuditEntry
+	Pagination rdb.SearchResultsPagination
+}
@@ -0,0 +1,81 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+)
+
+const (
+	CERTIFICATE_DEFAULT_VALIDITY_DAYS = 365  // Validity used for device certificates when not specified
+	CERTIFICATE_MAX_VALIDITY_DAYS     = 3650 // Maximum validity allowed for device certificates
+	CA_VALIDITY_YEARS                 = 20   // Validity of generated tenant certificate authorities
+	CRL_VALIDITY_HOURS                = 24   // Interval after which CRL consumers should refresh
+
+	CA_KEY_PEM_TYPE_PLAIN     = "EC PRIVATE KEY"           // Key stored before keys were encrypted at rest
+	CA_KEY_PEM_TYPE_ENCRYPTED = "ENCRYPTED EC PRIVATE KEY" // Nonce followed by AES-GCM sealed key
+)
+
+var ErrCaKeyEncryptionKeyMissing = errors.New("certificate authority key encryption key is not configured")
+
+// Certificate authority used to issue device certificates for a tenant.
+type CertificateAuthority struct {
+	gorm.Model
+	Tenant      string `gorm:"size:128;unique;not null"`
+	Certificate string `gorm:"not null"`
+	PrivateKey  string `gorm:"not null"` // Encrypted with the configured key encryption key
+}
+
+// Data required to issue a device certificate from a certificate signing request.
+type DeviceCsrSignRequest struct {
+	Device       string
+	Csr          string
+	ValidityDays *int32
+}
+
+// Certificate issued to a device by the tenant certificate authority.
+type DeviceCertificate struct {
+	gorm.Model
+	DeviceId         uint
+	Device           *Device
+	SerialNumber     string `gorm:"size:64;unique;not null"`
+	Subject          string `gorm:"size:256"`
+	Fingerprint      string `gorm:"size:64;index"`
+	Certificate      string `gorm:"not null"`
+	NotBefore        time.Time
+	NotAfter         time.Time
+	RevokedAt        sql.NullTime
+	RevocationReason sql.NullString `gorm:"size:128"`
+}
+
+// Search criteria for locating device certificates.
+type DeviceCertificateSearchCriteria struct {
+	rdb.Pagination
+	Device         *string
+	IncludeRevoked *bool
+}
+
+// Results for device certificate search.
+type DeviceCertificateSearchResults struct {
+	Results    []DeviceCertificate
+	Pagination rdb.SearchResultsPagination
+}
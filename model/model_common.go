@@ -17,10 +17,72 @@
 package model
 
 import (
+	"database/sql"
+
 	"github.com/devicechain-io/dc-microservice/rdb"
 	"gorm.io/gorm"
 )
 
+// Kinds of entity that may take part in relationships.
+const (
+	ENTITY_KIND_DEVICE         = "Device"
+	ENTITY_KIND_DEVICE_GROUP   = "DeviceGroup"
+	ENTITY_KIND_ASSET          = "Asset"
+	ENTITY_KIND_ASSET_GROUP    = "AssetGroup"
+	ENTITY_KIND_AREA           = "Area"
+	ENTITY_KIND_AREA_GROUP     = "AreaGroup"
+	ENTITY_KIND_CUSTOMER       = "Customer"
+	ENTITY_KIND_CUSTOMER_GROUP = "CustomerGroup"
+)
+
+// All kinds of entity that may take part in relationships.
+var EntityKinds = []string{
+	ENTITY_KIND_DEVICE,
+	ENTITY_KIND_DEVICE_GROUP,
+	ENTITY_KIND_ASSET,
+	ENTITY_KIND_ASSET_GROUP,
+	ENTITY_KIND_AREA,
+	ENTITY_KIND_AREA_GROUP,
+	ENTITY_KIND_CUSTOMER,
+	ENTITY_KIND_CUSTOMER_GROUP,
+}
+
+// Column name fragment used when referencing each kind of entity.
+var entityKindColumns = map[string]string{
+	ENTITY_KIND_DEVICE:         "device",
+	ENTITY_KIND_DEVICE_GROUP:   "device_group",
+	ENTITY_KIND_ASSET:          "asset",
+	ENTITY_KIND_ASSET_GROUP:    "asset_group",
+	ENTITY_KIND_AREA:           "area",
+	ENTITY_KIND_AREA_GROUP:     "area_group",
+	ENTITY_KIND_CUSTOMER:       "customer",
+	ENTITY_KIND_CUSTOMER_GROUP: "customer_group",
+}
+
+// Indicates whether a value is a known kind of entity.
+func IsEntityKind(kind string) bool {
+	_, ok := entityKindColumns[kind]
+	return ok
+}
+
+// Base data for entities that limit the rate of inbound events.
+type RateLimitedEntity struct {
+	RateLimit sql.NullFloat64 // Events allowed per second (unlimited if not set)
+	RateBurst sql.NullInt64   // Events allowed in a burst above the rate
+}
+
+// Create rate limited entity from optional request values.
+func rateLimitedEntityOf(limit *float64, burst *int32) RateLimitedEntity {
+	entity := RateLimitedEntity{}
+	if limit != nil {
+		entity.RateLimit = sql.NullFloat64{Float64: *limit, Valid: true}
+	}
+	if burst != nil {
+		entity.RateBurst = sql.NullInt64{Int64: int64(*burst), Valid: true}
+	}
+	return entity
+}
+
 // Base data required to create an entity relationship.
 type EntityRelationshipCreateRequest struct {
 	TargetDevice        *string
@@ -33,6 +95,13 @@ type EntityRelationshipCreateRequest struct {
 	TargetCustomerGroup *string
 }
 
+// Criteria for locating relationships by target. When only a kind is given, relationships
+// with any target of that kind match. When only a token is given, targets of any kind match.
+type EntityRelationshipTargetCriteria struct {
+	TargetKind  *string
+	TargetToken *string
+}
+
 // Based data for capturing a relationship between entites.
 type EntityRelationship struct {
 	gorm.Model
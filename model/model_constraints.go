@@ -0,0 +1,147 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"encoding/json"
+
+	"gorm.io/datatypes"
+)
+
+// Cardinality of relationships of a type, expressed as sources to targets.
+const (
+	RELATIONSHIP_CARDINALITY_ONE_TO_ONE   = "OneToOne"   // A source has one target and a target has one source
+	RELATIONSHIP_CARDINALITY_ONE_TO_MANY  = "OneToMany"  // A target has one source
+	RELATIONSHIP_CARDINALITY_MANY_TO_ONE  = "ManyToOne"  // A source has one target
+	RELATIONSHIP_CARDINALITY_MANY_TO_MANY = "ManyToMany" // Only identical relationships are limited
+)
+
+// Handling of new relationships that conflict with existing relationships of the same type.
+const (
+	RELATIONSHIP_CONFLICT_REJECT  = "Reject"  // The new relationship is not created
+	RELATIONSHIP_CONFLICT_REPLACE = "Replace" // Conflicting relationships are ended before it is created
+)
+
+// Valid relationship cardinalities.
+var RelationshipCardinalities = []string{
+	RELATIONSHIP_CARDINALITY_ONE_TO_ONE,
+	RELATIONSHIP_CARDINALITY_ONE_TO_MANY,
+	RELATIONSHIP_CARDINALITY_MANY_TO_ONE,
+	RELATIONSHIP_CARDINALITY_MANY_TO_MANY,
+}
+
+// Valid handling of relationship conflicts.
+var RelationshipConflictPolicies = []string{
+	RELATIONSHIP_CONFLICT_REJECT,
+	RELATIONSHIP_CONFLICT_REPLACE,
+}
+
+// Data required to set the constraints of a relationship type.
+type RelationshipConstraintsCreateRequest struct {
+	Cardinality        *string
+	OnConflict         *string
+	AllowedTargetKinds *[]string
+	AllowedSourceTypes *[]string
+	AllowedTargetTypes *[]string
+}
+
+// Constraints enforced when relationships of a type are created. Allowed source and target types
+// are type tokens and only apply to entities that have types, so groups are limited by kind.
+type RelationshipConstraints struct {
+	Cardinality        string
+	OnConflict         string
+	AllowedTargetKinds *datatypes.JSON
+	AllowedSourceTypes *datatypes.JSON
+	AllowedTargetTypes *datatypes.JSON
+}
+
+// Get the cardinality, which defaults to many-to-many.
+func (rc RelationshipConstraints) CardinalityOrDefault() string {
+	if rc.Cardinality == "" {
+		return RELATIONSHIP_CARDINALITY_MANY_TO_MANY
+	}
+	return rc.Cardinality
+}
+
+// Get the handling of conflicts, which defaults to rejecting the new relationship.
+func (rc RelationshipConstraints) OnConflictOrDefault() string {
+	if rc.OnConflict == "" {
+		return RELATIONSHIP_CONFLICT_REJECT
+	}
+	return rc.OnConflict
+}
+
+// Indicates whether a source may only have one relationship of the type.
+func (rc RelationshipConstraints) SingleTarget() bool {
+	cardinality := rc.CardinalityOrDefault()
+	return cardinality == RELATIONSHIP_CARDINALITY_ONE_TO_ONE || cardinality == RELATIONSHIP_CARDINALITY_MANY_TO_ONE
+}
+
+// Indicates whether a target may only have one relationship of the type.
+func (rc RelationshipConstraints) SingleSource() bool {
+	cardinality := rc.CardinalityOrDefault()
+	return cardinality == RELATIONSHIP_CARDINALITY_ONE_TO_ONE || cardinality == RELATIONSHIP_CARDINALITY_ONE_TO_MANY
+}
+
+// Get the kinds of entity allowed as targets (empty if any kind is allowed).
+func (rc RelationshipConstraints) TargetKinds() []string {
+	return stringListFrom(rc.AllowedTargetKinds)
+}
+
+// Get the tokens of types allowed for sources (empty if any type is allowed).
+func (rc RelationshipConstraints) SourceTypes() []string {
+	return stringListFrom(rc.AllowedSourceTypes)
+}
+
+// Get the tokens of types allowed for targets (empty if any type is allowed).
+func (rc RelationshipConstraints) TargetTypes() []string {
+	return stringListFrom(rc.AllowedTargetTypes)
+}
+
+// Decode a list of strings (empty if not set).
+func stringListFrom(value *datatypes.JSON) []string {
+	values := make([]string, 0)
+	if value != nil {
+		if err := json.Unmarshal(*value, &values); err != nil {
+			return []string{}
+		}
+	}
+	return values
+}
+
+// Encode a list of strings (nil if the list is empty).
+func stringListOf(values *[]string) *datatypes.JSON {
+	if values == nil || len(*values) == 0 {
+		return nil
+	}
+	encoded, err := json.Marshal(*values)
+	if err != nil {
+		return nil
+	}
+	conv := datatypes.JSON(encoded)
+	return &conv
+}
+
+// Indicates whether a list contains a value.
+func containsString(values []string, value string) bool {
+	for _, current := range values {
+		if current == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"database/sql"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+)
+
+const (
+	CREDENTIAL_TYPE_API_KEY          = "ApiKey"          // Generated key stored as a hash
+	CREDENTIAL_TYPE_X509_FINGERPRINT = "X509Fingerprint" // SHA-256 fingerprint of a device certificate
+	CREDENTIAL_TYPE_PRE_SHARED_KEY   = "PreSharedKey"    // Key shared with the device out of band, stored as a hash
+)
+
+// Data required to create a device credential.
+type DeviceCredentialCreateRequest struct {
+	Token          string
+	Device         string
+	CredentialType string
+	Value          *string
+	ExpiresAt      *string
+	Metadata       *string
+}
+
+// Data required to rotate a device credential.
+type DeviceCredentialRotateRequest struct {
+	Token              string
+	Value              *string
+	ExpiresAt          *string
+	GracePeriodSeconds *int32
+}
+
+// Authentication material associated with a device.
+type DeviceCredential struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.MetadataEntity
+	DeviceId       uint
+	Device         *Device
+	CredentialType string `gorm:"size:32;not null"`
+	Value          string `gorm:"size:256;not null;index"`
+	ExpiresAt      sql.NullTime
+	RevokedAt      sql.NullTime
+}
+
+// Credential along with the secret generated when it was issued.
+type IssuedDeviceCredential struct {
+	Credential *DeviceCredential
+	Secret     *string
+}
+
+// Search criteria for locating device credentials.
+type DeviceCredentialSearchCriteria struct {
+	rdb.Pagination
+	Device         *string
+	CredentialType *string
+	IncludeRevoked *bool
+}
+
+// Results for device credential search.
+type DeviceCredentialSearchResults struct {
+	Results    []DeviceCredential
+	Pagination rdb.SearchResultsPagination
+}
+
+// Data required to verify a device credential.
+type DeviceCredentialVerifyRequest struct {
+	Device         *string
+	CredentialType string
+	Value          string
+}
+
+// Result of verifying a device credential.
+type DeviceCredentialVerification struct {
+	Valid      bool
+	Reason     *string
+	Device     *Device
+	Credential *DeviceCredential
+}
@@ -94,6 +94,8 @@ type CustomerRelationshipTypeCreateRequest struct {
 	Name        *string
 	Description *string
 	Metadata    *string
+	Tracked     *bool
+	RelationshipConstraintsCreateRequest
 }
 
 // Metadata indicating a relationship between customers.
@@ -102,6 +104,8 @@ type CustomerRelationshipType struct {
 	rdb.TokenReference
 	rdb.NamedEntity
 	rdb.MetadataEntity
+	Tracked bool
+	RelationshipConstraints
 }
 
 // Search criteria for locating customer relationship types.
@@ -136,8 +140,10 @@ type CustomerRelationship struct {
 // Search criteria for locating customer relationships.
 type CustomerRelationshipSearchCriteria struct {
 	rdb.Pagination
+	EntityRelationshipTargetCriteria
 	SourceCustomer   *string
 	RelationshipType *string
+	Tracked          *bool
 }
 
 // Results for customer relationship search.
@@ -185,6 +191,7 @@ type CustomerGroupRelationshipTypeCreateRequest struct {
 	Name        *string
 	Description *string
 	Metadata    *string
+	RelationshipConstraintsCreateRequest
 }
 
 // Metadata indicating a relationship between customer and group.
@@ -193,6 +200,7 @@ type CustomerGroupRelationshipType struct {
 	rdb.TokenReference
 	rdb.NamedEntity
 	rdb.MetadataEntity
+	RelationshipConstraints
 }
 
 // Search criteria for locating customer groups relationship types.
@@ -227,6 +235,7 @@ type CustomerGroupRelationship struct {
 // Search criteria for locating customer groups relationships.
 type CustomerGroupRelationshipSearchCriteria struct {
 	rdb.Pagination
+	EntityRelationshipTargetCriteria
 	SourceCustomerGroup *string
 	RelationshipType    *string
 }
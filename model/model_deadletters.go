@@ -0,0 +1,112 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+)
+
+const (
+	DEAD_LETTER_MAX_REPLAYS = 5 // Number of replays after which a dead letter event is quarantined
+
+	DEAD_LETTER_STATUS_FAILED      = "Failed"      // Event failed and is eligible for replay
+	DEAD_LETTER_STATUS_REPLAYED    = "Replayed"    // Event was replayed and has not failed again
+	DEAD_LETTER_STATUS_QUARANTINED = "Quarantined" // Event failed too many times and will not be replayed
+
+	REPLAY_JOB_STATUS_RUNNING   = "Running"   // Replay job is in progress
+	REPLAY_JOB_STATUS_COMPLETED = "Completed" // Replay job finished successfully
+	REPLAY_JOB_STATUS_FAILED    = "Failed"    // Replay job stopped due to an error
+)
+
+// Data recorded when a failed event is consumed from the failed events topic.
+type DeadLetterEventCreateRequest struct {
+	Reason      uint
+	Service     string
+	Message     string
+	Error       string
+	DeviceToken *string
+	Payload     []byte
+	FailedTime  time.Time
+}
+
+// Failed event stored so that it may be inspected and replayed.
+type DeadLetterEvent struct {
+	gorm.Model
+	Reason       uint   `gorm:"index"`
+	Service      string `gorm:"size:128;index"`
+	Message      string
+	Error        string
+	DeviceToken  sql.NullString `gorm:"size:128;index"`
+	Payload      []byte
+	PayloadHash  string `gorm:"size:64;uniqueIndex"`
+	FailureCount uint
+	ReplayCount  uint
+	Status       string `gorm:"size:32;index"`
+	FirstFailed  time.Time
+	LastFailed   time.Time `gorm:"index"`
+}
+
+// Indicates whether a dead letter event may be replayed again rather than quarantined.
+func (event *DeadLetterEvent) Replayable() bool {
+	return event.ReplayCount < DEAD_LETTER_MAX_REPLAYS
+}
+
+// Search criteria for locating dead letter events.
+type DeadLetterEventSearchCriteria struct {
+	rdb.Pagination
+	Reason      *int32
+	Service     *string
+	DeviceToken *string
+	Status      *string
+	Since       *string
+	Until       *string
+}
+
+// Results for dead letter event search.
+type DeadLetterEventSearchResults struct {
+	Results    []DeadLetterEvent
+	Pagination rdb.SearchResultsPagination
+}
+
+// Data required to start replaying failed events.
+type FailedEventReplayRequest struct {
+	Reason      *int32
+	Service     *string
+	DeviceToken *string
+	Since       *string
+	Until       *string
+}
+
+// Tracks progress of replaying failed events that match a filter.
+type FailedEventReplayJob struct {
+	gorm.Model
+	Status      string `gorm:"size:32;index"`
+	Reason      sql.NullInt32
+	Service     sql.NullString `gorm:"size:128"`
+	DeviceToken sql.NullString `gorm:"size:128"`
+	Since       sql.NullTime
+	Until       sql.NullTime
+	Total       uint
+	Replayed    uint
+	Quarantined uint
+	CompletedAt sql.NullTime
+	Error       sql.NullString
+}
@@ -17,10 +17,17 @@
 package model
 
 import (
+	"encoding/json"
+
 	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/datatypes"
 	"gorm.io/gorm"
 )
 
+const (
+	DELETED_TOKEN_MAX_LENGTH = 128 // Size of token columns, which deleted tokens must fit
+)
+
 // Data required to create a device type.
 type DeviceTypeCreateRequest struct {
 	Token           string
@@ -32,6 +39,8 @@ type DeviceTypeCreateRequest struct {
 	ForegroundColor *string
 	BorderColor     *string
 	Metadata        *string
+	RateLimit       *float64
+	RateBurst       *int32
 }
 
 // Represents a device type.
@@ -41,6 +50,7 @@ type DeviceType struct {
 	rdb.NamedEntity
 	rdb.BrandedEntity
 	rdb.MetadataEntity
+	RateLimitedEntity
 
 	Devices []Device
 }
@@ -62,7 +72,10 @@ type DeviceCreateRequest struct {
 	Name            *string
 	Description     *string
 	DeviceTypeToken string
+	Status          *string
 	Metadata        *string
+	RateLimit       *float64
+	RateBurst       *int32
 }
 
 // Represents a device.
@@ -71,15 +84,18 @@ type Device struct {
 	rdb.TokenReference
 	rdb.NamedEntity
 	rdb.MetadataEntity
+	RateLimitedEntity
 
 	DeviceTypeId uint
 	DeviceType   *DeviceType
+	Status       string `gorm:"size:32;not null;default:Active;index"`
 }
 
 // Search criteria for locating devices.
 type DeviceSearchCriteria struct {
 	rdb.Pagination
 	DeviceType *string
+	Status     *string
 }
 
 // Results for device search.
@@ -90,11 +106,14 @@ type DeviceSearchResults struct {
 
 // Data required to create a device relationship type.
 type DeviceRelationshipTypeCreateRequest struct {
-	Token       string
-	Name        *string
-	Description *string
-	Metadata    *string
-	Tracked     bool
+	Token                string
+	Name                 *string
+	Description          *string
+	Metadata             *string
+	Tracked              bool
+	Enriched             *bool
+	EnrichedMetadataKeys *[]string
+	RelationshipConstraintsCreateRequest
 }
 
 // Metadata indicating a relationship between devices.
@@ -103,7 +122,34 @@ type DeviceRelationshipType struct {
 	rdb.TokenReference
 	rdb.NamedEntity
 	rdb.MetadataEntity
-	Tracked bool
+	Tracked              bool
+	Enriched             bool
+	EnrichedMetadataKeys *datatypes.JSON
+	RelationshipConstraints
+}
+
+// Get metadata keys copied into enriched events (empty if none are configured).
+func (rt *DeviceRelationshipType) MetadataKeysForEnrichment() []string {
+	keys := make([]string, 0)
+	if rt.EnrichedMetadataKeys != nil {
+		if err := json.Unmarshal(*rt.EnrichedMetadataKeys, &keys); err != nil {
+			return []string{}
+		}
+	}
+	return keys
+}
+
+// Encode metadata keys used for enrichment (nil if no keys are configured).
+func enrichedMetadataKeysOf(keys *[]string) *datatypes.JSON {
+	if keys == nil || len(*keys) == 0 {
+		return nil
+	}
+	encoded, err := json.Marshal(*keys)
+	if err != nil {
+		return nil
+	}
+	conv := datatypes.JSON(encoded)
+	return &conv
 }
 
 // Search criteria for locating device relationship types.
@@ -138,9 +184,11 @@ type DeviceRelationship struct {
 // Search criteria for locating device relationships.
 type DeviceRelationshipSearchCriteria struct {
 	rdb.Pagination
+	EntityRelationshipTargetCriteria
 	SourceDevice     *string
 	RelationshipType *string
 	Tracked          *bool
+	WithTargetTypes  bool // Load the types of relationship targets (used for event enrichment)
 }
 
 // Results for device relationship search.
@@ -188,6 +236,7 @@ type DeviceGroupRelationshipTypeCreateRequest struct {
 	Name        *string
 	Description *string
 	Metadata    *string
+	RelationshipConstraintsCreateRequest
 }
 
 // Metadata indicating a relationship between device and group.
@@ -196,6 +245,7 @@ type DeviceGroupRelationshipType struct {
 	rdb.TokenReference
 	rdb.NamedEntity
 	rdb.MetadataEntity
+	RelationshipConstraints
 }
 
 // Search criteria for locating device groups relationship types.
@@ -230,6 +280,7 @@ type DeviceGroupRelationship struct {
 // Search criteria for locating device groups relationships.
 type DeviceGroupRelationshipSearchCriteria struct {
 	rdb.Pagination
+	EntityRelationshipTargetCriteria
 	SourceDeviceGroup *string
 	RelationshipType  *string
 }
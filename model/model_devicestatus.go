@@ -0,0 +1,79 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"database/sql"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+)
+
+const (
+	DEVICE_STATUS_MANUFACTURED   = "Manufactured"   // Device has been built but not provisioned
+	DEVICE_STATUS_PROVISIONED    = "Provisioned"    // Device has been provisioned but not activated
+	DEVICE_STATUS_ACTIVE         = "Active"         // Device is in service
+	DEVICE_STATUS_SUSPENDED      = "Suspended"      // Device has been temporarily taken out of service
+	DEVICE_STATUS_DECOMMISSIONED = "Decommissioned" // Device has been permanently taken out of service
+)
+
+var (
+	// Statuses that may be assigned when a device is created.
+	DeviceInitialStatuses = []string{
+		DEVICE_STATUS_MANUFACTURED,
+		DEVICE_STATUS_PROVISIONED,
+		DEVICE_STATUS_ACTIVE,
+	}
+
+	// Legal transitions from each device status.
+	DeviceStatusLegalTransitions = map[string][]string{
+		DEVICE_STATUS_MANUFACTURED:   {DEVICE_STATUS_PROVISIONED, DEVICE_STATUS_DECOMMISSIONED},
+		DEVICE_STATUS_PROVISIONED:    {DEVICE_STATUS_ACTIVE, DEVICE_STATUS_DECOMMISSIONED},
+		DEVICE_STATUS_ACTIVE:         {DEVICE_STATUS_SUSPENDED, DEVICE_STATUS_DECOMMISSIONED},
+		DEVICE_STATUS_SUSPENDED:      {DEVICE_STATUS_ACTIVE, DEVICE_STATUS_DECOMMISSIONED},
+		DEVICE_STATUS_DECOMMISSIONED: {},
+	}
+)
+
+// Data required to transition a device to a new status.
+type DeviceStatusTransitionRequest struct {
+	Status string
+	Reason *string
+}
+
+// Record of a device moving from one status to another.
+type DeviceStatusTransition struct {
+	gorm.Model
+	DeviceId   uint
+	Device     *Device
+	FromStatus string         `gorm:"size:32;not null"`
+	ToStatus   string         `gorm:"size:32;not null"`
+	Actor      sql.NullString `gorm:"size:128"`
+	Reason     sql.NullString
+}
+
+// Search criteria for locating device status transitions.
+type DeviceStatusTransitionSearchCriteria struct {
+	rdb.Pagination
+	Device *string
+}
+
+// Results for device status transition search.
+type DeviceStatusTransitionSearchResults struct {
+	Results    []DeviceStatusTransition
+	Pagination rdb.SearchResultsPagination
+}
@@ -0,0 +1,90 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+const (
+	DEFAULT_ENTITY_GRAPH_DEPTH = 3  // Relationships followed from the root when no depth is given
+	MAX_ENTITY_GRAPH_DEPTH     = 10 // Limit on relationships followed from the root
+)
+
+// Criteria for traversing relationships outward from an entity.
+type EntityGraphCriteria struct {
+	RootToken         string
+	RootKind          string
+	Depth             *int32
+	RelationshipTypes *[]string
+}
+
+// Criteria for finding the devices whose events reach an entity.
+type ImpactedByCriteria struct {
+	Token string
+	Kind  *string
+	Depth *int32
+}
+
+// Entity reached while traversing relationships.
+type EntityGraphNode struct {
+	Kind  string
+	Id    uint
+	Token string
+	Name  sql.NullString
+	Depth int // Number of relationships followed from the root
+}
+
+// Key that uniquely identifies the node across entity kinds.
+func (node EntityGraphNode) Key() string {
+	return fmt.Sprintf("%s:%d", node.Kind, node.Id)
+}
+
+// Relationship traversed from one entity to another.
+type EntityGraphEdge struct {
+	RelationshipId    uint
+	RelationshipToken string
+	RelationshipType  string
+	Tracked           bool
+	SourceKind        string
+	SourceToken       string
+	TargetKind        string
+	TargetToken       string
+	Depth             int
+}
+
+// Entities and relationships reachable from a root entity.
+type EntityGraph struct {
+	Root  EntityGraphNode
+	Nodes []EntityGraphNode
+	Edges []EntityGraphEdge
+}
+
+// Get the depth to traverse given an optional requested value.
+func entityGraphDepthOf(depth *int32) int {
+	if depth == nil {
+		return DEFAULT_ENTITY_GRAPH_DEPTH
+	}
+	if *depth > MAX_ENTITY_GRAPH_DEPTH {
+		return MAX_ENTITY_GRAPH_DEPTH
+	}
+	if *depth < 0 {
+		return 0
+	}
+	return int(*depth)
+}
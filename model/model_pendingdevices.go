@@ -0,0 +1,97 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+)
+
+const (
+	PENDING_DEVICE_MAX_HELD_EVENTS = 100 // Maximum number of events held for a single pending device
+)
+
+// Data recorded when an event is received for an unregistered device.
+type PendingDeviceEventCreateRequest struct {
+	Token         string
+	Source        string
+	SamplePayload *string
+	Payload       []byte
+}
+
+// Represents a device token that has sent events but has not been registered.
+type PendingDevice struct {
+	gorm.Model
+	rdb.TokenReference
+	FirstSeen     time.Time
+	LastSeen      time.Time
+	Source        string `gorm:"size:128"`
+	SamplePayload sql.NullString
+	EventCount    uint
+	Rejected      bool
+
+	Events []PendingDeviceEvent
+}
+
+// Event held for a pending device until the device is approved or rejected.
+type PendingDeviceEvent struct {
+	gorm.Model
+	PendingDeviceId uint
+	PendingDevice   *PendingDevice
+	Payload         []byte
+}
+
+// Search criteria for locating pending devices.
+type PendingDeviceSearchCriteria struct {
+	rdb.Pagination
+	Rejected *bool
+}
+
+// Results for pending device search.
+type PendingDeviceSearchResults struct {
+	Results    []PendingDevice
+	Pagination rdb.SearchResultsPagination
+}
+
+// Data required to create a relationship for an approved device.
+type PendingDeviceRelationshipRequest struct {
+	Token            string
+	RelationshipType string
+	Targets          EntityRelationshipCreateRequest
+	Metadata         *string
+}
+
+// Data required to approve a pending device.
+type PendingDeviceApprovalRequest struct {
+	DeviceTypeToken string
+	Name            *string
+	Description     *string
+	Metadata        *string
+	Relationships   *[]PendingDeviceRelationshipRequest
+	ReplayEvents    bool
+}
+
+// Results of approving a pending device.
+type PendingDeviceApproval struct {
+	Device        *Device
+	Relationships []*DeviceRelationship
+	PendingDevice *PendingDevice
+	HeldEvents    [][]byte // Kept until the pending device is released
+}
@@ -0,0 +1,83 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Data required to create an event routing rule.
+type EventRoutingRuleCreateRequest struct {
+	Token            string
+	Name             *string
+	Description      *string
+	Metadata         *string
+	EventType        *string
+	DeviceType       *string
+	RelationshipType *string
+	Customer         *string
+	Area             *string
+	Topics           []string
+	Disabled         *bool
+}
+
+// Rule that sends resolved events matching all of its criteria to additional topics. Criteria
+// that are not set match any event.
+type EventRoutingRule struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+
+	EventType          sql.NullString `gorm:"size:32"`
+	DeviceTypeId       *uint
+	DeviceType         *DeviceType
+	RelationshipTypeId *uint
+	RelationshipType   *DeviceRelationshipType
+	CustomerId         *uint
+	Customer           *Customer
+	AreaId             *uint
+	Area               *Area
+	Topics             datatypes.JSON
+	Disabled           bool `gorm:"not null;default:false"`
+}
+
+// Search criteria for locating event routing rules.
+type EventRoutingRuleSearchCriteria struct {
+	rdb.Pagination
+	IncludeDisabled *bool
+}
+
+// Results for event routing rule search.
+type EventRoutingRuleSearchResults struct {
+	Results    []EventRoutingRule
+	Pagination rdb.SearchResultsPagination
+}
+
+// Get names of topics events are routed to.
+func (rule *EventRoutingRule) TopicNames() []string {
+	topics := make([]string, 0)
+	if err := json.Unmarshal(rule.Topics, &topics); err != nil {
+		return []string{}
+	}
+	return topics
+}
@@ -0,0 +1,85 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	esmodel "github.com/devicechain-io/dc-event-sources/model"
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+)
+
+// Data required to create an event transform.
+type EventTransformCreateRequest struct {
+	Token       string
+	Name        *string
+	Description *string
+	Metadata    *string
+	DeviceType  string
+	Script      string
+	Disabled    *bool
+}
+
+// Script applied to inbound events for devices of a given type before they are resolved.
+// Transforms for the same device type are applied in the order they were created.
+type EventTransform struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+
+	DeviceTypeId uint
+	DeviceType   *DeviceType
+	Script       string `gorm:"type:text;not null"`
+	Disabled     bool   `gorm:"not null;default:false"`
+}
+
+// Search criteria for locating event transforms.
+type EventTransformSearchCriteria struct {
+	rdb.Pagination
+	DeviceType      *string
+	IncludeDisabled *bool
+}
+
+// Results for event transform search.
+type EventTransformSearchResults struct {
+	Results    []EventTransform
+	Pagination rdb.SearchResultsPagination
+}
+
+// Named measurement value used when testing transforms.
+type MeasurementValue struct {
+	Name  string
+	Value string
+}
+
+// Measurements entry used when testing transforms.
+type EventTransformTestEntry struct {
+	Measurements []MeasurementValue
+	OccurredTime *string
+}
+
+// Data required to test a transform script against sample measurements.
+type EventTransformTestRequest struct {
+	Script  string
+	Entries []EventTransformTestEntry
+}
+
+// Results of testing a transform script. Error is set if the script failed to compile or run.
+type EventTransformTestResults struct {
+	Events []*esmodel.UnresolvedEvent
+	Error  *string
+}
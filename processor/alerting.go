@@ -0,0 +1,241 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	esmodel "github.com/devicechain-io/dc-event-sources/model"
+	"github.com/devicechain-io/dc-microservice/core"
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Source assigned to alert events generated from alert rules.
+	ALERT_RULE_EVENT_SOURCE = "alert-rules"
+)
+
+var (
+	alertingMetricsOnce sync.Once
+	generatedAlerts     *prometheus.CounterVec
+	alertRulesLoaded    prometheus.Gauge
+)
+
+// Identifies the state of a rule for a single device.
+type alertStateKey struct {
+	RuleId   uint
+	DeviceId uint
+}
+
+// Tracks whether a rule threshold is currently crossed for a device.
+type alertState struct {
+	BreachedSince *time.Time
+	Active        bool
+}
+
+// Evaluates alert rules stored in the database against inbound measurements and generates
+// alert events when thresholds are crossed. Rules are cached and reloaded when changed. The
+// state of each rule is kept per device so that an alert fires once when its threshold is
+// crossed rather than on every sample.
+type AlertEvaluator struct {
+	Api model.DeviceManagementApi
+
+	mutex        sync.Mutex
+	byDeviceType map[uint][]model.AlertRule
+	byDevice     map[uint][]model.AlertRule
+	states       map[alertStateKey]*alertState
+}
+
+// Create a new alert evaluator.
+func NewAlertEvaluator(ms *core.Microservice, api model.DeviceManagementApi) *AlertEvaluator {
+	alertingMetricsOnce.Do(func() {
+		generatedAlerts = ms.NewCounterVec("generated_alerts_total",
+			"Number of alerts generated from alert rules", []string{"type"})
+		alertRulesLoaded = ms.NewGauge("alert_rules_loaded",
+			"Number of enabled alert rules currently applied", []string{})
+	})
+	return &AlertEvaluator{
+		Api:          api,
+		byDeviceType: make(map[uint][]model.AlertRule),
+		byDevice:     make(map[uint][]model.AlertRule),
+		states:       make(map[alertStateKey]*alertState),
+	}
+}
+
+// Load the current set of enabled alert rules. State is discarded for rules that are no
+// longer enabled.
+func (alerts *AlertEvaluator) Reload(ctx context.Context) error {
+	if alerts == nil {
+		return nil
+	}
+	found, err := alerts.Api.AlertRules(ctx, model.AlertRuleSearchCriteria{
+		Pagination: rdb.Pagination{
+			PageNumber: 1,
+			PageSize:   0,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	byDeviceType := make(map[uint][]model.AlertRule)
+	byDevice := make(map[uint][]model.AlertRule)
+	loaded := make(map[uint]bool)
+	for _, rule := range found.Results {
+		if rule.DeviceTypeId != nil {
+			byDeviceType[*rule.DeviceTypeId] = append(byDeviceType[*rule.DeviceTypeId], rule)
+		}
+		if rule.DeviceId != nil {
+			byDevice[*rule.DeviceId] = append(byDevice[*rule.DeviceId], rule)
+		}
+		loaded[rule.ID] = true
+	}
+
+	alerts.mutex.Lock()
+	defer alerts.mutex.Unlock()
+	alerts.byDeviceType = byDeviceType
+	alerts.byDevice = byDevice
+	for key := range alerts.states {
+		if !loaded[key.RuleId] {
+			delete(alerts.states, key)
+		}
+	}
+	alertRulesLoaded.Set(float64(len(found.Results)))
+	return nil
+}
+
+// Get the time a payload entry occurred, falling back to the event time.
+func entryOccurredTime(value *string, event *esmodel.UnresolvedEvent) time.Time {
+	if value != nil {
+		if occurred, err := time.Parse(time.RFC3339Nano, *value); err == nil {
+			return occurred
+		}
+	}
+	return event.OccurredTime
+}
+
+// Build the message for an alert generated by a rule.
+func alertMessageOf(rule *model.AlertRule, value string) string {
+	if rule.Message.Valid {
+		return rule.Message.String
+	}
+	return fmt.Sprintf("%s %s %s (value %s)", rule.Measurement, rule.Comparator,
+		strconv.FormatFloat(rule.Threshold, 'f', -1, 64), value)
+}
+
+// Update rule state for a measurement value. Returns true if the alert should fire.
+func (state *alertState) update(rule *model.AlertRule, value float64, occurred time.Time) bool {
+	if state.Active {
+		if rule.Cleared(value) {
+			state.Active = false
+			state.BreachedSince = nil
+		}
+		return false
+	}
+	if !rule.Breached(value) {
+		state.BreachedSince = nil
+		return false
+	}
+	if state.BreachedSince == nil {
+		state.BreachedSince = &occurred
+	}
+	if occurred.Sub(*state.BreachedSince) < time.Duration(rule.DurationMs)*time.Millisecond {
+		return false
+	}
+	state.Active = true
+	return true
+}
+
+// Evaluate alert rules for the device against a measurements event. Returns an alerts event
+// for any rules that fired or nil if none did. Rules that fired are marked active so that they
+// do not fire again. The returned rollback function clears them if the alerts could not be
+// delivered so that they fire again on the next breaching measurement.
+func (alerts *AlertEvaluator) Evaluate(device *model.Device,
+	event *esmodel.UnresolvedEvent) (*esmodel.UnresolvedEvent, func()) {
+	if alerts == nil || event.EventType != esmodel.Measurement {
+		return nil, func() {}
+	}
+	payload, ok := event.Payload.(*esmodel.UnresolvedMeasurementsPayload)
+	if !ok {
+		return nil, func() {}
+	}
+
+	alerts.mutex.Lock()
+	defer alerts.mutex.Unlock()
+	rules := append(append([]model.AlertRule{}, alerts.byDeviceType[device.DeviceTypeId]...),
+		alerts.byDevice[device.ID]...)
+	if len(rules) == 0 {
+		return nil, func() {}
+	}
+
+	entries := make([]esmodel.UnresolvedAlertEntry, 0)
+	fired := make([]*alertState, 0)
+	for _, entry := range payload.Entries {
+		occurred := entryOccurredTime(entry.OccurredTime, event)
+		for i := range rules {
+			rule := &rules[i]
+			raw, ok := entry.Measurements[rule.Measurement]
+			if !ok {
+				continue
+			}
+			value, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				continue
+			}
+			key := alertStateKey{RuleId: rule.ID, DeviceId: device.ID}
+			state, ok := alerts.states[key]
+			if !ok {
+				state = &alertState{}
+				alerts.states[key] = state
+			}
+			if state.update(rule, value, occurred) {
+				fired = append(fired, state)
+				entries = append(entries, esmodel.UnresolvedAlertEntry{
+					Type:         rule.AlertType,
+					Level:        rule.Level,
+					Message:      alertMessageOf(rule, raw),
+					Source:       rule.Token,
+					OccurredTime: entry.OccurredTime,
+				})
+				generatedAlerts.WithLabelValues(rule.AlertType).Inc()
+			}
+		}
+	}
+	if len(entries) == 0 {
+		return nil, func() {}
+	}
+	rollback := func() {
+		alerts.mutex.Lock()
+		defer alerts.mutex.Unlock()
+		for _, state := range fired {
+			state.Active = false
+		}
+	}
+	return &esmodel.UnresolvedEvent{
+		Source:        ALERT_RULE_EVENT_SOURCE,
+		Device:        event.Device,
+		OccurredTime:  event.OccurredTime,
+		ProcessedTime: event.ProcessedTime,
+		EventType:     esmodel.Alert,
+		Payload:       &esmodel.UnresolvedAlertsPayload{Entries: entries},
+	}, rollback
+}
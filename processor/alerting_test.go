@@ -0,0 +1,242 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/devicechain-io/dc-device-management/config"
+	dmodel "github.com/devicechain-io/dc-device-management/model"
+	dmproto "github.com/devicechain-io/dc-device-management/proto"
+	dmtest "github.com/devicechain-io/dc-device-management/test"
+	"github.com/devicechain-io/dc-event-sources/model"
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AlertEvaluatorTestSuite struct {
+	suite.Suite
+	API      *dmtest.MockApi
+	Alerts   *AlertEvaluator
+	Resolver *EventResolver
+	Start    time.Time
+}
+
+// Perform common setup tasks.
+func (suite *AlertEvaluatorTestSuite) SetupTest() {
+	suite.API = new(dmtest.MockApi)
+	suite.Alerts = NewAlertEvaluator(dmtest.DeviceManagementMicroservice, suite.API)
+	retry := NewRetrier(dmtest.DeviceManagementMicroservice, config.RetryConfiguration{})
+	suite.Resolver = NewEventResolver(1, suite.API, retry, PipelineStages{Alerts: suite.Alerts}, nil, nil, nil, nil)
+	suite.Start = time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+}
+
+// Build a rule that fires when temperature exceeds a threshold for the test device type.
+func buildAlertRule(id uint, threshold float64) dmodel.AlertRule {
+	dtype := buildDevice().DeviceTypeId
+	return dmodel.AlertRule{
+		Model:          gorm.Model{ID: id},
+		TokenReference: rdb.TokenReference{Token: "overheat"},
+		DeviceTypeId:   &dtype,
+		Measurement:    "temperature",
+		Comparator:     dmodel.ALERT_COMPARATOR_GT,
+		Threshold:      threshold,
+		AlertType:      "engine.overheat",
+		Level:          5,
+	}
+}
+
+// Load rules into the evaluator.
+func (suite *AlertEvaluatorTestSuite) loadRules(rules ...dmodel.AlertRule) {
+	suite.API.Mock.On("AlertRules").Return(&dmodel.AlertRuleSearchResults{Results: rules}, nil)
+	assert.Nil(suite.T(), suite.Alerts.Reload(context.Background()))
+}
+
+// Build a measurements event with a temperature sample taken at an offset from the start time.
+func (suite *AlertEvaluatorTestSuite) temperature(value string, offset time.Duration) *model.UnresolvedEvent {
+	occurred := suite.Start.Add(offset).Format(time.RFC3339Nano)
+	return &model.UnresolvedEvent{
+		Device:    "TEST-123",
+		EventType: model.Measurement,
+		Payload: &model.UnresolvedMeasurementsPayload{
+			Entries: []model.UnresolvedMeasurementsEntry{
+				{Measurements: map[string]string{"temperature": value}, OccurredTime: &occurred},
+			},
+		},
+	}
+}
+
+// Evaluate a sequence of samples and return whether each fired an alert.
+func (suite *AlertEvaluatorTestSuite) evaluate(device *dmodel.Device, values ...string) []bool {
+	fired := make([]bool, 0)
+	for i, value := range values {
+		alerts, _ := suite.Alerts.Evaluate(device, suite.temperature(value, time.Duration(i)*time.Second))
+		fired = append(fired, alerts != nil)
+	}
+	return fired
+}
+
+// Test an alert fires once while the threshold remains crossed.
+func (suite *AlertEvaluatorTestSuite) TestFiresOnce() {
+	suite.loadRules(buildAlertRule(1, 100))
+	assert.Equal(suite.T(), []bool{false, true, false, false, true},
+		suite.evaluate(buildDevice(), "99", "101", "102", "100", "101"))
+}
+
+// Test alerts do not clear until the value moves back past the threshold by the hysteresis.
+func (suite *AlertEvaluatorTestSuite) TestHysteresis() {
+	rule := buildAlertRule(1, 100)
+	rule.Hysteresis = 5
+	suite.loadRules(rule)
+	assert.Equal(suite.T(), []bool{true, false, false, false, true},
+		suite.evaluate(buildDevice(), "101", "97", "101", "95", "101"))
+}
+
+// Test alerts only fire once the threshold has been crossed for the rule duration.
+func (suite *AlertEvaluatorTestSuite) TestDuration() {
+	rule := buildAlertRule(1, 100)
+	rule.DurationMs = 2000
+	suite.loadRules(rule)
+	assert.Equal(suite.T(), []bool{false, false, false, false, false, true},
+		suite.evaluate(buildDevice(), "101", "101", "99", "101", "101", "101"))
+}
+
+// Test rules for a device only apply to that device.
+func (suite *AlertEvaluatorTestSuite) TestDeviceRule() {
+	rule := buildAlertRule(1, 100)
+	deviceId := uint(2)
+	rule.DeviceTypeId, rule.DeviceId = nil, &deviceId
+	suite.loadRules(rule)
+	assert.Equal(suite.T(), []bool{false}, suite.evaluate(buildDevice(), "101"))
+
+	other := buildDevice()
+	other.ID = deviceId
+	assert.Equal(suite.T(), []bool{true}, suite.evaluate(other, "101"))
+}
+
+// Test non-numeric and missing measurements are ignored.
+func (suite *AlertEvaluatorTestSuite) TestNonNumericIgnored() {
+	suite.loadRules(buildAlertRule(1, 100))
+	assert.Equal(suite.T(), []bool{false}, suite.evaluate(buildDevice(), "hot"))
+	alerts, _ := suite.Alerts.Evaluate(buildDevice(), buildMeasurementsEvent())
+	assert.Nil(suite.T(), alerts)
+}
+
+// Test rule state is discarded when a rule is no longer loaded.
+func (suite *AlertEvaluatorTestSuite) TestStateDiscardedOnReload() {
+	suite.loadRules(buildAlertRule(1, 100))
+	assert.Equal(suite.T(), []bool{true}, suite.evaluate(buildDevice(), "101"))
+
+	suite.API.Mock.ExpectedCalls = nil
+	suite.loadRules(buildAlertRule(2, 100))
+	assert.Equal(suite.T(), []bool{true}, suite.evaluate(buildDevice(), "101"))
+}
+
+// Test generated alerts are resolved along the relationships of the measurements.
+func (suite *AlertEvaluatorTestSuite) TestResolvedAlerts() {
+	suite.loadRules(buildAlertRule(1, 100))
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships").Return(buildDeviceRelationshipSearchResults(), nil)
+	suite.API.Mock.On("RecordAlertOccurrence", mock.Anything).Return(&dmodel.Alert{}, true, nil)
+
+	results, _, err := suite.Resolver.ResolveEvent(context.Background(), suite.temperature("101", 0))
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 2, len(results))
+	assert.Equal(suite.T(), model.Measurement, results[0].Resolved.EventType)
+
+	alert := results[1].Resolved
+	assert.Equal(suite.T(), model.Alert, alert.EventType)
+	assert.Equal(suite.T(), ALERT_RULE_EVENT_SOURCE, alert.Source)
+	assert.Equal(suite.T(), results[0].Relationship.ID, alert.DeviceRelationshipId)
+	entries := alert.Payload.(*dmodel.ResolvedAlertsPayload).Entries
+	assert.Equal(suite.T(), 1, len(entries))
+	assert.Equal(suite.T(), "engine.overheat", entries[0].Type)
+	assert.Equal(suite.T(), uint32(5), entries[0].Level)
+	assert.Equal(suite.T(), "overheat", entries[0].Source)
+	assert.Equal(suite.T(), "temperature gt 100 (value 101)", entries[0].Message)
+
+	suite.API.Mock.AssertCalled(suite.T(), "RecordAlertOccurrence", &dmodel.AlertOccurrenceRequest{
+		DeviceId:     buildDevice().ID,
+		AlertType:    "engine.overheat",
+		Level:        5,
+		Message:      "temperature gt 100 (value 101)",
+		Source:       "overheat",
+		OccurredTime: suite.Start,
+	})
+
+	results, _, err = suite.Resolver.ResolveEvent(context.Background(), suite.temperature("102", time.Second))
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 1, len(results))
+	suite.API.Mock.AssertNumberOfCalls(suite.T(), "RecordAlertOccurrence", 1)
+}
+
+// Test inbound alerts are recorded once regardless of how many relationships they resolve to.
+func (suite *AlertEvaluatorTestSuite) TestInboundAlertsRecorded() {
+	relationships := buildDeviceRelationshipSearchResults()
+	relationships.Results = append(relationships.Results, relationships.Results[0])
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships").Return(relationships, nil)
+	suite.API.Mock.On("RecordAlertOccurrence", mock.Anything).Return(&dmodel.Alert{}, true, nil)
+
+	results, _, err := suite.Resolver.ResolveEvent(context.Background(), buildAlertsEvent())
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 2, len(results))
+	suite.API.Mock.AssertNumberOfCalls(suite.T(), "RecordAlertOccurrence", 1)
+}
+
+// Test failures recording alerts fail resolution so that the event may be replayed.
+func (suite *AlertEvaluatorTestSuite) TestRecordAlertsFailure() {
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships").Return(buildDeviceRelationshipSearchResults(), nil)
+	suite.API.Mock.On("RecordAlertOccurrence", mock.Anything).Return((*dmodel.Alert)(nil), false,
+		errors.New("database unavailable"))
+
+	_, reason, err := suite.Resolver.ResolveEvent(context.Background(), buildAlertsEvent())
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), uint(dmproto.FailureReason_ApiCallFailed), reason)
+}
+
+// Test rules fire again when generated alerts could not be recorded.
+func (suite *AlertEvaluatorTestSuite) TestGeneratedAlertsFailure() {
+	suite.loadRules(buildAlertRule(1, 100))
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships").Return(buildDeviceRelationshipSearchResults(), nil)
+	suite.API.Mock.On("RecordAlertOccurrence", mock.Anything).Return((*dmodel.Alert)(nil), false,
+		errors.New("database unavailable")).Once()
+	suite.API.Mock.On("RecordAlertOccurrence", mock.Anything).Return(&dmodel.Alert{}, true, nil)
+
+	_, reason, err := suite.Resolver.ResolveEvent(context.Background(), suite.temperature("101", 0))
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), uint(dmproto.FailureReason_ApiCallFailed), reason)
+
+	results, _, err := suite.Resolver.ResolveEvent(context.Background(), suite.temperature("101", 0))
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 2, len(results))
+	assert.Equal(suite.T(), model.Alert, results[1].Resolved.EventType)
+}
+
+// Run all tests.
+func TestAlertEvaluatorTestSuite(t *testing.T) {
+	suite.Run(t, new(AlertEvaluatorTestSuite))
+}
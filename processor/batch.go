@@ -0,0 +1,224 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/devicechain-io/dc-device-management/config"
+	dmodel "github.com/devicechain-io/dc-device-management/model"
+	"github.com/devicechain-io/dc-device-management/proto"
+	kcore "github.com/devicechain-io/dc-microservice/kafka"
+	"github.com/rs/zerolog/log"
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	UNDELIVERED_DIVERTED = "diverted" // Undelivered message was sent to failed events
+	UNDELIVERED_DROPPED  = "dropped"  // Undelivered message was dropped
+)
+
+// Outbound message along with the inbound message it originated from.
+type pendingWrite struct {
+	Message   kafka.Message
+	Source    kafka.Message
+	OnWritten func() // Called once the message has been written (optional)
+}
+
+// Configure a writer so that writes block until they are acknowledged, since inbound offsets are
+// committed once writes complete. Messages are keyed by device token and are partitioned by key
+// so that events for a device stay in order.
+func SynchronousWriter(writer kcore.KafkaWriter, sizing config.ProcessorConfiguration) kcore.KafkaWriter {
+	if dckw, ok := writer.(*kcore.DeviceChainKafkaWriter); ok {
+		dckw.Async = false
+		dckw.Balancer = &kafka.Hash{}
+		dckw.BatchSize = sizing.WriterBatchSize
+		dckw.BatchTimeout = time.Duration(sizing.WriterFlushIntervalMs) * time.Millisecond
+	}
+	return writer
+}
+
+// Maximum number of messages in an outbound batch.
+func (iproc *InboundEventsProcessor) batchSize() int {
+	if iproc.Sizing.WriterBatchSize < 1 {
+		return 1
+	}
+	return iproc.Sizing.WriterBatchSize
+}
+
+// Maximum time to wait for an outbound batch to fill.
+func (iproc *InboundEventsProcessor) flushInterval() time.Duration {
+	return time.Duration(iproc.Sizing.WriterFlushIntervalMs) * time.Millisecond
+}
+
+// Collect resolved events until the batch is full or the flush interval elapses.
+func (iproc *InboundEventsProcessor) nextResolvedBatch() ([]outboundResolvedEvent, bool) {
+	first, more := <-iproc.resolved
+	if !more {
+		return nil, false
+	}
+	batch := []outboundResolvedEvent{first}
+	timer := time.NewTimer(iproc.flushInterval())
+	defer timer.Stop()
+	for len(batch) < iproc.batchSize() {
+		select {
+		case next, more := <-iproc.resolved:
+			if !more {
+				return batch, true
+			}
+			batch = append(batch, next)
+		case <-timer.C:
+			return batch, true
+		}
+	}
+	return batch, true
+}
+
+// Collect failed events until the batch is full or the flush interval elapses.
+func (iproc *InboundEventsProcessor) nextFailedBatch() ([]outboundFailedEvent, bool) {
+	first, more := <-iproc.failed
+	if !more {
+		return nil, false
+	}
+	batch := []outboundFailedEvent{first}
+	timer := time.NewTimer(iproc.flushInterval())
+	defer timer.Stop()
+	for len(batch) < iproc.batchSize() {
+		select {
+		case next, more := <-iproc.failed:
+			if !more {
+				return batch, true
+			}
+			batch = append(batch, next)
+		case <-timer.C:
+			return batch, true
+		}
+	}
+	return batch, true
+}
+
+// Called with a message that could not be written once retries are exhausted.
+type undeliveredWrite func(write pendingWrite, err error)
+
+// Determine which messages in a batch were not written.
+func failedWrites(err error, count int) []bool {
+	failed := make([]bool, count)
+	if err == nil {
+		return failed
+	}
+	var werrs kafka.WriteErrors
+	if errors.As(err, &werrs) && len(werrs) == count {
+		for i, werr := range werrs {
+			failed[i] = werr != nil
+		}
+		return failed
+	}
+	for i := range failed {
+		failed[i] = true
+	}
+	return failed
+}
+
+// Index of the first message in a batch that was not written, or the batch size if all were.
+func firstFailedWrite(failed []bool) int {
+	for i, fail := range failed {
+		if fail {
+			return i
+		}
+	}
+	return len(failed)
+}
+
+// Release a message that was written.
+func (iproc *InboundEventsProcessor) written(write pendingWrite) {
+	if write.OnWritten != nil {
+		write.OnWritten()
+	}
+	iproc.offsets.Done(write.Source)
+}
+
+// Write a batch of messages in a single call. When part of a batch fails, the batch is written
+// again from the first failed message so that messages after it (which may share its key) are
+// not released ahead of it. Messages still not written once retries are exhausted are passed to
+// the undelivered handler. The offsets of source messages are always released, either once the
+// message has been written or once it has been handed off as undelivered.
+func (iproc *InboundEventsProcessor) WriteBatch(ctx context.Context, writer kcore.KafkaWriter, batch []pendingWrite,
+	undelivered undeliveredWrite) {
+	pending := batch
+	for attempt := 0; len(pending) > 0; attempt++ {
+		msgs := make([]kafka.Message, 0, len(pending))
+		for _, write := range pending {
+			msgs = append(msgs, write.Message)
+		}
+		err := writer.WriteMessages(ctx, msgs...)
+		writer.HandleResponse(err)
+
+		// Release messages written ahead of the first failure.
+		failed := failedWrites(err, len(pending))
+		first := firstFailedWrite(failed)
+		for _, write := range pending[:first] {
+			iproc.written(write)
+		}
+		if first == len(pending) {
+			return
+		}
+		if attempt >= iproc.Retry.MaxRetries {
+			log.Error().Err(err).Msg(fmt.Sprintf("unable to write %d messages after %d retries",
+				len(pending)-first, attempt))
+			for i := first; i < len(pending); i++ {
+				if failed[i] {
+					undelivered(pending[i], err)
+					iproc.offsets.Done(pending[i].Source)
+				} else {
+					iproc.written(pending[i])
+				}
+			}
+			return
+		}
+
+		backoff := iproc.Retry.Backoff(attempt)
+		log.Warn().Err(err).Msg(fmt.Sprintf("retrying write of %d messages in %s", len(pending)-first, backoff))
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		pending = pending[first:]
+	}
+}
+
+// Send the source of a resolved event that could not be written to failed events so that it
+// may be replayed.
+func (iproc *InboundEventsProcessor) divertUndelivered(write pendingWrite, err error) {
+	eventsUndelivered.WithLabelValues(UNDELIVERED_DIVERTED).Inc()
+	failed := dmodel.NewFailedEvent(uint(proto.FailureReason_Unknown), iproc.Microservice.FunctionalArea,
+		"resolved event could not be written", err, write.Source.Value)
+	recordFailedEvent(failed.Reason, EVENT_TYPE_UNKNOWN)
+	iproc.offsets.Add(write.Source, 1)
+	iproc.failed <- outboundFailedEvent{Event: *failed, Source: write.Source}
+}
+
+// Drop a failed event that could not be written. The time is recorded so that health checks
+// report events are being lost.
+func (iproc *InboundEventsProcessor) dropUndelivered(write pendingWrite, err error) {
+	eventsUndelivered.WithLabelValues(UNDELIVERED_DROPPED).Inc()
+	atomic.StoreInt64(&iproc.lastDropped, time.Now().UnixNano())
+}
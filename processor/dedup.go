@@ -0,0 +1,235 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	esmodel "github.com/devicechain-io/dc-event-sources/model"
+	"github.com/devicechain-io/dc-microservice/core"
+	redis "github.com/go-redis/redis/v8"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	DEDUP_CACHE_NAME = "event-dedup"
+)
+
+var (
+	dedupMetricsOnce sync.Once
+	dedupDropped     prometheus.Counter
+)
+
+// Records keys of events that have been seen within a window. Keys are first recorded as pending
+// for a short time and only kept for the full window once the event has been written, so that an
+// event redelivered after a crash is not dropped as a duplicate.
+type DuplicateDetector interface {
+	// Record a key as pending, returning true if it was already recorded.
+	CheckAndRecord(ctx context.Context, key string) (bool, error)
+	// Keep a key for the full window once its event has been written.
+	Confirm(ctx context.Context, key string) error
+	// Forget a key so that the event may be processed again.
+	Forget(ctx context.Context, key string) error
+}
+
+// Entry in the in-process duplicate detector.
+type seenEntry struct {
+	key     string
+	expires time.Time
+}
+
+// Duplicate detector backed by an in-process LRU.
+type MemoryDuplicateDetector struct {
+	Window  time.Duration
+	Pending time.Duration
+	Size    int
+
+	mutex   sync.Mutex
+	entries map[string]*list.Element
+	order   *list.List
+}
+
+// Create a new in-process duplicate detector.
+func NewMemoryDuplicateDetector(window time.Duration, pending time.Duration, size int) *MemoryDuplicateDetector {
+	return &MemoryDuplicateDetector{
+		Window:  window,
+		Pending: pending,
+		Size:    size,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Record a key as pending, returning true if it was already recorded.
+func (mdd *MemoryDuplicateDetector) CheckAndRecord(ctx context.Context, key string) (bool, error) {
+	mdd.mutex.Lock()
+	defer mdd.mutex.Unlock()
+
+	now := time.Now()
+	if elem, ok := mdd.entries[key]; ok && now.Before(elem.Value.(*seenEntry).expires) {
+		return true, nil
+	}
+	mdd.record(key, now.Add(mdd.Pending))
+	return false, nil
+}
+
+// Keep a key for the full window once its event has been written.
+func (mdd *MemoryDuplicateDetector) Confirm(ctx context.Context, key string) error {
+	mdd.mutex.Lock()
+	defer mdd.mutex.Unlock()
+
+	mdd.record(key, time.Now().Add(mdd.Window))
+	return nil
+}
+
+// Record a key until the given time, evicting the least recently recorded keys once size is
+// reached. Callers must hold the mutex.
+func (mdd *MemoryDuplicateDetector) record(key string, expires time.Time) {
+	if elem, ok := mdd.entries[key]; ok {
+		elem.Value.(*seenEntry).expires = expires
+		mdd.order.MoveToFront(elem)
+		return
+	}
+	mdd.entries[key] = mdd.order.PushFront(&seenEntry{key: key, expires: expires})
+	for mdd.Size > 0 && mdd.order.Len() > mdd.Size {
+		oldest := mdd.order.Back()
+		mdd.order.Remove(oldest)
+		delete(mdd.entries, oldest.Value.(*seenEntry).key)
+	}
+}
+
+// Forget a key so that the event may be processed again.
+func (mdd *MemoryDuplicateDetector) Forget(ctx context.Context, key string) error {
+	mdd.mutex.Lock()
+	defer mdd.mutex.Unlock()
+
+	if elem, ok := mdd.entries[key]; ok {
+		mdd.order.Remove(elem)
+		delete(mdd.entries, key)
+	}
+	return nil
+}
+
+// Duplicate detector backed by Redis so that events are deduplicated across instances.
+type RedisDuplicateDetector struct {
+	Client  *redis.Client
+	Window  time.Duration
+	Pending time.Duration
+
+	prefix string
+}
+
+// Create a new Redis duplicate detector.
+func NewRedisDuplicateDetector(ms *core.Microservice, window time.Duration,
+	pending time.Duration) *RedisDuplicateDetector {
+	return &RedisDuplicateDetector{
+		Client:  ms.Redis.Client,
+		Window:  window,
+		Pending: pending,
+		prefix:  fmt.Sprintf("%s_%s_%s_", ms.InstanceId, ms.FunctionalArea, DEDUP_CACHE_NAME),
+	}
+}
+
+// Record a key as pending, returning true if it was already recorded.
+func (rdd *RedisDuplicateDetector) CheckAndRecord(ctx context.Context, key string) (bool, error) {
+	added, err := rdd.Client.SetNX(ctx, rdd.prefix+key, 1, rdd.Pending).Result()
+	if err != nil {
+		return false, err
+	}
+	return !added, nil
+}
+
+// Keep a key for the full window once its event has been written.
+func (rdd *RedisDuplicateDetector) Confirm(ctx context.Context, key string) error {
+	return rdd.Client.Set(ctx, rdd.prefix+key, 1, rdd.Window).Err()
+}
+
+// Forget a key so that the event may be processed again.
+func (rdd *RedisDuplicateDetector) Forget(ctx context.Context, key string) error {
+	return rdd.Client.Del(ctx, rdd.prefix+key).Err()
+}
+
+// Drops events with a (device, AltId) pair that was already seen within the window.
+type Deduplicator struct {
+	Detector DuplicateDetector
+}
+
+// Create a new deduplicator.
+func NewDeduplicator(ms *core.Microservice, detector DuplicateDetector) *Deduplicator {
+	dedupMetricsOnce.Do(func() {
+		dedupDropped = ms.NewCounter("duplicate_events_dropped_total",
+			"Number of inbound events dropped as duplicates", []string{})
+	})
+	return &Deduplicator{
+		Detector: detector,
+	}
+}
+
+// Get the deduplication key for an event (nil if event can not be deduplicated).
+func dedupKey(event *esmodel.UnresolvedEvent) *string {
+	if event.AltId == nil || *event.AltId == "" {
+		return nil
+	}
+	key := fmt.Sprintf("%s/%s", event.Device, *event.AltId)
+	return &key
+}
+
+// Check whether an event is a duplicate. Events are processed if the check fails.
+func (dedup *Deduplicator) IsDuplicate(ctx context.Context, event *esmodel.UnresolvedEvent) bool {
+	key := dedupKey(event)
+	if dedup == nil || key == nil {
+		return false
+	}
+	seen, err := dedup.Detector.CheckAndRecord(ctx, *key)
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to check for duplicate event")
+		return false
+	}
+	if seen {
+		dedupDropped.Inc()
+	}
+	return seen
+}
+
+// Confirm an event has been written so that resends within the window are dropped.
+func (dedup *Deduplicator) Confirm(ctx context.Context, event *esmodel.UnresolvedEvent) {
+	key := dedupKey(event)
+	if dedup == nil || key == nil {
+		return
+	}
+	err := dedup.Detector.Confirm(ctx, *key)
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to confirm duplicate event key")
+	}
+}
+
+// Release an event that failed to process so that a resend is not treated as a duplicate.
+func (dedup *Deduplicator) Release(ctx context.Context, event *esmodel.UnresolvedEvent) {
+	key := dedupKey(event)
+	if dedup == nil || key == nil {
+		return
+	}
+	err := dedup.Detector.Forget(ctx, *key)
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to release duplicate event key")
+	}
+}
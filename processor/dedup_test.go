@@ -0,0 +1,179 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/devicechain-io/dc-device-management/config"
+	dmodel "github.com/devicechain-io/dc-device-management/model"
+	dmtest "github.com/devicechain-io/dc-device-management/test"
+	esmodel "github.com/devicechain-io/dc-event-sources/model"
+	esproto "github.com/devicechain-io/dc-event-sources/proto"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type DeduplicationTestSuite struct {
+	suite.Suite
+	API      *dmtest.MockApi
+	Detector *MemoryDuplicateDetector
+	Dedup    *Deduplicator
+	Written  bool // Whether resolved events are reported as written
+}
+
+// Perform common setup tasks.
+func (suite *DeduplicationTestSuite) SetupTest() {
+	suite.API = new(dmtest.MockApi)
+	suite.Detector = NewMemoryDuplicateDetector(time.Minute, time.Minute, 2)
+	suite.Dedup = NewDeduplicator(dmtest.DeviceManagementMicroservice, suite.Detector)
+	suite.Written = true
+}
+
+// Run messages through a resolver and collect results.
+func (suite *DeduplicationTestSuite) resolve(msgs ...kafka.Message) (int, int) {
+	unrez := make(chan kafka.Message, len(msgs))
+	for _, msg := range msgs {
+		unrez <- msg
+	}
+	close(unrez)
+
+	resolved, failed := 0, 0
+	rez := NewEventResolver(1, suite.API, NewRetrier(dmtest.DeviceManagementMicroservice, suite.retryConfig()),
+		PipelineStages{Dedup: suite.Dedup}, unrez, nil,
+		func(msg kafka.Message, results []EventResolutionResults, written func()) {
+			resolved += len(results)
+			if written != nil && suite.Written {
+				written()
+			}
+		},
+		func(msg kafka.Message, reason uint, event esmodel.UnresolvedEvent, err error) { failed++ })
+	rez.Process(context.Background())
+	return resolved, failed
+}
+
+// Build a message for a locations event.
+func (suite *DeduplicationTestSuite) locationsMessage() kafka.Message {
+	bytes, err := esproto.MarshalUnresolvedEvent(buildLocationsEvent())
+	assert.Nil(suite.T(), err)
+	return kafka.Message{Key: []byte("TEST-123"), Value: bytes}
+}
+
+// Test events seen within the window are detected.
+func (suite *DeduplicationTestSuite) TestMemoryWindow() {
+	ctx := context.Background()
+	seen, _ := suite.Detector.CheckAndRecord(ctx, "a")
+	assert.False(suite.T(), seen)
+	seen, _ = suite.Detector.CheckAndRecord(ctx, "a")
+	assert.True(suite.T(), seen)
+
+	suite.Detector.Window = 0
+	assert.Nil(suite.T(), suite.Detector.Confirm(ctx, "a"))
+	seen, _ = suite.Detector.CheckAndRecord(ctx, "a")
+	assert.False(suite.T(), seen)
+}
+
+// Test keys are only kept for the full window once confirmed.
+func (suite *DeduplicationTestSuite) TestMemoryPending() {
+	ctx := context.Background()
+	suite.Detector.Pending = 0
+	seen, _ := suite.Detector.CheckAndRecord(ctx, "a")
+	assert.False(suite.T(), seen)
+	seen, _ = suite.Detector.CheckAndRecord(ctx, "a")
+	assert.False(suite.T(), seen)
+
+	assert.Nil(suite.T(), suite.Detector.Confirm(ctx, "a"))
+	seen, _ = suite.Detector.CheckAndRecord(ctx, "a")
+	assert.True(suite.T(), seen)
+}
+
+// Test least recently seen entries are evicted once size is reached.
+func (suite *DeduplicationTestSuite) TestMemoryEviction() {
+	ctx := context.Background()
+	suite.Detector.CheckAndRecord(ctx, "a")
+	suite.Detector.CheckAndRecord(ctx, "b")
+	suite.Detector.CheckAndRecord(ctx, "c")
+
+	seen, _ := suite.Detector.CheckAndRecord(ctx, "a")
+	assert.False(suite.T(), seen)
+	seen, _ = suite.Detector.CheckAndRecord(ctx, "c")
+	assert.True(suite.T(), seen)
+}
+
+// Test duplicate event is dropped before resolution.
+func (suite *DeduplicationTestSuite) TestDuplicateDropped() {
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships").Return(buildDeviceRelationshipSearchResults(), nil)
+
+	msg := suite.locationsMessage()
+	resolved, failed := suite.resolve(msg, msg)
+
+	assert.Equal(suite.T(), 1, resolved)
+	assert.Equal(suite.T(), 0, failed)
+	suite.API.AssertNumberOfCalls(suite.T(), "DevicesByToken", 1)
+}
+
+// Test an event that was resolved but never written is not dropped when it is redelivered once
+// its pending key has expired.
+func (suite *DeduplicationTestSuite) TestUnwrittenEventRedelivered() {
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships").Return(buildDeviceRelationshipSearchResults(), nil)
+	suite.Detector.Pending = 0
+	suite.Written = false
+
+	msg := suite.locationsMessage()
+	resolved, _ := suite.resolve(msg, msg)
+	assert.Equal(suite.T(), 2, resolved)
+}
+
+// Test replayed events are not treated as duplicates.
+func (suite *DeduplicationTestSuite) TestReplayNotDropped() {
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships").Return(buildDeviceRelationshipSearchResults(), nil)
+
+	msg := suite.locationsMessage()
+	replayed := msg
+	replayed.Headers = []kafka.Header{{Key: REPLAY_HEADER, Value: []byte("true")}}
+	resolved, _ := suite.resolve(msg, replayed)
+
+	assert.Equal(suite.T(), 2, resolved)
+}
+
+// Test resend of a failed event is processed again.
+func (suite *DeduplicationTestSuite) TestFailedEventReleased() {
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{}, errors.New("relation does not exist"))
+
+	msg := suite.locationsMessage()
+	_, failed := suite.resolve(msg, msg)
+
+	assert.Equal(suite.T(), 2, failed)
+}
+
+// Retry settings that keep tests fast.
+func (suite *DeduplicationTestSuite) retryConfig() config.RetryConfiguration {
+	return config.RetryConfiguration{MaxRetries: 0}
+}
+
+// Run all tests.
+func TestDeduplicationTestSuite(t *testing.T) {
+	suite.Run(t, new(DeduplicationTestSuite))
+}
@@ -0,0 +1,152 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"encoding/json"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	"github.com/devicechain-io/dc-microservice/rdb"
+)
+
+// Copy selected keys from entity metadata. Non-string values are included in JSON form.
+func snapshotMetadata(entity rdb.MetadataEntity, keys []string) map[string]string {
+	selected := make(map[string]string)
+	if entity.Metadata == nil || len(keys) == 0 {
+		return selected
+	}
+	values := make(map[string]interface{})
+	if err := json.Unmarshal(*entity.Metadata, &values); err != nil {
+		return selected
+	}
+	for _, key := range keys {
+		value, ok := values[key]
+		if !ok {
+			continue
+		}
+		if str, ok := value.(string); ok {
+			selected[key] = str
+		} else if encoded, err := json.Marshal(value); err == nil {
+			selected[key] = string(encoded)
+		}
+	}
+	return selected
+}
+
+// Create a snapshot from the common parts of an entity.
+func snapshotOf(token rdb.TokenReference, named rdb.NamedEntity, meta rdb.MetadataEntity,
+	keys []string) *model.EntitySnapshot {
+	snapshot := &model.EntitySnapshot{
+		Token:    token.Token,
+		Metadata: snapshotMetadata(meta, keys),
+	}
+	if named.Name.Valid {
+		name := named.Name.String
+		snapshot.Name = &name
+	}
+	return snapshot
+}
+
+// Create a snapshot of a typed entity. The type token is omitted if the type was not loaded.
+func typedSnapshotOf(token rdb.TokenReference, named rdb.NamedEntity, meta rdb.MetadataEntity,
+	typeRef *rdb.TokenReference, keys []string) *model.EntitySnapshot {
+	snapshot := snapshotOf(token, named, meta, keys)
+	if typeRef != nil {
+		typeToken := typeRef.Token
+		snapshot.TypeToken = &typeToken
+	}
+	return snapshot
+}
+
+// Create snapshot of a device.
+func deviceSnapshot(device *model.Device, keys []string) *model.EntitySnapshot {
+	if device == nil {
+		return nil
+	}
+	var typeRef *rdb.TokenReference
+	if device.DeviceType != nil {
+		typeRef = &device.DeviceType.TokenReference
+	}
+	return typedSnapshotOf(device.TokenReference, device.NamedEntity, device.MetadataEntity, typeRef, keys)
+}
+
+// Create snapshot of an asset.
+func assetSnapshot(asset *model.Asset, keys []string) *model.EntitySnapshot {
+	if asset == nil {
+		return nil
+	}
+	var typeRef *rdb.TokenReference
+	if asset.AssetType != nil {
+		typeRef = &asset.AssetType.TokenReference
+	}
+	return typedSnapshotOf(asset.TokenReference, asset.NamedEntity, asset.MetadataEntity, typeRef, keys)
+}
+
+// Create snapshot of an area.
+func areaSnapshot(area *model.Area, keys []string) *model.EntitySnapshot {
+	if area == nil {
+		return nil
+	}
+	var typeRef *rdb.TokenReference
+	if area.AreaType != nil {
+		typeRef = &area.AreaType.TokenReference
+	}
+	return typedSnapshotOf(area.TokenReference, area.NamedEntity, area.MetadataEntity, typeRef, keys)
+}
+
+// Create snapshot of a customer.
+func customerSnapshot(customer *model.Customer, keys []string) *model.EntitySnapshot {
+	if customer == nil {
+		return nil
+	}
+	var typeRef *rdb.TokenReference
+	if customer.CustomerType != nil {
+		typeRef = &customer.CustomerType.TokenReference
+	}
+	return typedSnapshotOf(customer.TokenReference, customer.NamedEntity, customer.MetadataEntity, typeRef, keys)
+}
+
+// Build entity snapshots for a resolved event based on the relationship type settings. Returns
+// nil if enrichment is not enabled for the relationship type.
+func EnrichmentFor(device *model.Device, relation *model.DeviceRelationship) *model.ResolvedEventEnrichment {
+	rtype := &relation.RelationshipType
+	if !rtype.Enriched {
+		return nil
+	}
+	keys := rtype.MetadataKeysForEnrichment()
+	enrichment := &model.ResolvedEventEnrichment{
+		SourceDevice:     deviceSnapshot(device, keys),
+		RelationshipType: snapshotOf(rtype.TokenReference, rtype.NamedEntity, rtype.MetadataEntity, keys),
+		TargetDevice:     deviceSnapshot(relation.TargetDevice, keys),
+		TargetAsset:      assetSnapshot(relation.TargetAsset, keys),
+		TargetArea:       areaSnapshot(relation.TargetArea, keys),
+		TargetCustomer:   customerSnapshot(relation.TargetCustomer, keys),
+	}
+	if group := relation.TargetDeviceGroup; group != nil {
+		enrichment.TargetDeviceGroup = snapshotOf(group.TokenReference, group.NamedEntity, group.MetadataEntity, keys)
+	}
+	if group := relation.TargetAssetGroup; group != nil {
+		enrichment.TargetAssetGroup = snapshotOf(group.TokenReference, group.NamedEntity, group.MetadataEntity, keys)
+	}
+	if group := relation.TargetAreaGroup; group != nil {
+		enrichment.TargetAreaGroup = snapshotOf(group.TokenReference, group.NamedEntity, group.MetadataEntity, keys)
+	}
+	if group := relation.TargetCustomerGroup; group != nil {
+		enrichment.TargetCustomerGroup = snapshotOf(group.TokenReference, group.NamedEntity, group.MetadataEntity, keys)
+	}
+	return enrichment
+}
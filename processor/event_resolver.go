@@ -31,42 +31,59 @@ import (
 	"github.com/google/uuid"
 	"github.com/rs/zerolog/log"
 	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel/attribute"
 )
 
+// Optional stages of the inbound event pipeline. Stages that are not set are skipped.
+type PipelineStages struct {
+	Dedup       *Deduplicator
+	Limiter     *RateLimiter
+	Router      *EventRouter
+	Transformer *EventTransformer
+	Alerts      *AlertEvaluator
+	FanOut      *RelationshipFanOut
+}
+
 // Worker used to resolve event entities.
 type EventResolver struct {
+	PipelineStages
 	WorkerId   int
 	Api        model.DeviceManagementApi
+	Retry      *Retrier
 	Unresolved <-chan kafka.Message
 	Invalid    func(error, kafka.Message)
-	Resolved   func([]EventResolutionResults)
-	Failed     func(uint, esmodel.UnresolvedEvent, error)
+	Resolved   func(kafka.Message, []EventResolutionResults, func()) // Calls back once results are written
+	Failed     func(kafka.Message, uint, esmodel.UnresolvedEvent, error)
 }
 
 // Results of event resolution process.
 type EventResolutionResults struct {
 	Device       *model.Device
 	Relationship *model.DeviceRelationship
+	Hop          *RelationshipHop // Set when the event was fanned out beyond the device relationship
 	Resolved     *model.ResolvedEvent
 }
 
 // Create a new event resolver.
-func NewEventResolver(workerId int, api model.DeviceManagementApi,
+func NewEventResolver(workerId int, api model.DeviceManagementApi, retry *Retrier, stages PipelineStages,
 	unrez <-chan kafka.Message,
 	invalid func(error, kafka.Message),
-	resolved func([]EventResolutionResults),
-	failed func(uint, esmodel.UnresolvedEvent, error)) *EventResolver {
+	resolved func(kafka.Message, []EventResolutionResults, func()),
+	failed func(kafka.Message, uint, esmodel.UnresolvedEvent, error)) *EventResolver {
 	return &EventResolver{
-		WorkerId:   workerId,
-		Api:        api,
-		Unresolved: unrez,
-		Invalid:    invalid,
-		Resolved:   resolved,
-		Failed:     failed,
+		PipelineStages: stages,
+		WorkerId:       workerId,
+		Api:            api,
+		Retry:          retry,
+		Unresolved:     unrez,
+		Invalid:        invalid,
+		Resolved:       resolved,
+		Failed:         failed,
 	}
 }
 
 // Merge device and relationship data with unresolved event in order to create a resolved event.
+// Entity snapshots are included when enrichment is enabled for the relationship type.
 func (rez *EventResolver) MergeRelationshipToResolveEvent(device *model.Device, relation *model.DeviceRelationship,
 	event *esmodel.UnresolvedEvent, rezPayload interface{}) (*EventResolutionResults, error) {
 	// Assemble resolved event from initial event and device assignment.
@@ -87,6 +104,7 @@ func (rez *EventResolver) MergeRelationshipToResolveEvent(device *model.Device,
 		ProcessedTime:         event.ProcessedTime,
 		EventType:             event.EventType,
 		Payload:               rezPayload,
+		Enrichment:            EnrichmentFor(device, relation),
 	}
 
 	results := &EventResolutionResults{
@@ -98,6 +116,34 @@ func (rez *EventResolver) MergeRelationshipToResolveEvent(device *model.Device,
 	return results, nil
 }
 
+// Copy resolved event results and retarget them to a tracked relationship reached by fan-out.
+// Enrichment describes the device relationship targets, so it is not carried to the copy.
+func (rez *EventResolver) FanOutResolvedEvent(results EventResolutionResults, hop RelationshipHop) EventResolutionResults {
+	resolved := *results.Resolved
+	resolved.TargetDeviceId = hop.Relationship.TargetDeviceId
+	resolved.TargetDeviceGroupId = hop.Relationship.TargetDeviceGroupId
+	resolved.TargetAssetId = hop.Relationship.TargetAssetId
+	resolved.TargetAssetGroupId = hop.Relationship.TargetAssetGroupId
+	resolved.TargetCustomerId = hop.Relationship.TargetCustomerId
+	resolved.TargetCustomerGroupId = hop.Relationship.TargetCustomerGroupId
+	resolved.TargetAreaId = hop.Relationship.TargetAreaId
+	resolved.TargetAreaGroupId = hop.Relationship.TargetAreaGroupId
+	resolved.Enrichment = nil
+	resolved.FanOut = &model.ResolvedEventFanOut{
+		SourceKind:     hop.SourceKind,
+		RelationshipId: hop.Relationship.ID,
+		Depth:          uint32(hop.Depth),
+	}
+	fannedOutEvents.WithLabelValues(hop.SourceKind).Inc()
+
+	return EventResolutionResults{
+		Device:       results.Device,
+		Relationship: results.Relationship,
+		Hop:          &hop,
+		Resolved:     &resolved,
+	}
+}
+
 // Create a new device relationship based on inbound event.
 func (rez *EventResolver) CreateNewDeviceRelationship(ctx context.Context, device *model.Device,
 	relcreate esmodel.UnresolvedNewRelationshipPayload) (*model.DeviceRelationship, uint, error) {
@@ -117,7 +163,11 @@ func (rez *EventResolver) CreateNewDeviceRelationship(ctx context.Context, devic
 			TargetCustomerGroup: relcreate.TargetCustomerGroup,
 		},
 	}
-	created, err := rez.Api.CreateDeviceRelationship(ctx, create)
+	var created *model.DeviceRelationship
+	err := rez.Retry.Do(ctx, "CreateDeviceRelationship", func() (err error) {
+		created, err = rez.Api.CreateDeviceRelationship(ctx, create)
+		return err
+	})
 	if err != nil {
 		return nil, uint(dmproto.FailureReason_ApiCallFailed), err
 	}
@@ -248,7 +298,10 @@ func (rez *EventResolver) ResolveEventPayload(ctx context.Context, device *model
 
 // Create resolved events by looking up device assignment info and merging it into other event data.
 func (rez *EventResolver) HandleStandardEvent(ctx context.Context,
-	device *model.Device, event *esmodel.UnresolvedEvent) ([]EventResolutionResults, uint, error) {
+	device *model.Device, event *esmodel.UnresolvedEvent) (_ []EventResolutionResults, _ uint, err error) {
+	ctx, span := startSpan(ctx, "HandleStandardEvent")
+	defer func() { endSpan(span, err) }()
+
 	// Look up device relationships for tracked types.
 	tracked := true
 	criteria := model.DeviceRelationshipSearchCriteria{
@@ -256,16 +309,23 @@ func (rez *EventResolver) HandleStandardEvent(ctx context.Context,
 			PageNumber: 1,
 			PageSize:   0,
 		},
-		SourceDevice: &device.Token,
-		Tracked:      &tracked,
+		SourceDevice:    &device.Token,
+		Tracked:         &tracked,
+		WithTargetTypes: true,
 	}
-	drels, err := rez.Api.DeviceRelationships(ctx, criteria)
+	var drels *model.DeviceRelationshipSearchResults
+	err = rez.Retry.Do(ctx, "DeviceRelationships", func() (err error) {
+		drels, err = rez.Api.DeviceRelationships(ctx, criteria)
+		return err
+	})
 	if err != nil {
 		return nil, uint(dmproto.FailureReason_ApiCallFailed), err
 	}
 
 	// Create separate merged event for each tracked device relationship.
+	span.SetAttributes(attribute.Int("relationships.tracked", len(drels.Results)))
 	results := make([]EventResolutionResults, 0)
+	walk := rez.FanOut.NewWalk(drels.Results)
 	for _, drel := range drels.Results {
 		resolved, err := rez.ResolveEventPayload(ctx, device, &drel, event)
 		if err != nil {
@@ -277,8 +337,14 @@ func (rez *EventResolver) HandleStandardEvent(ctx context.Context,
 			return nil, uint(dmproto.FailureReason_ApiCallFailed), err
 		}
 		results = append(results, *result)
+
+		// Fan out along tracked relationships of the entities targeted by the relationship.
+		for _, hop := range walk.From(&drel) {
+			results = append(results, rez.FanOutResolvedEvent(*result, hop))
+		}
 	}
 
+	span.SetAttributes(attribute.Int("relationships.fanned_out", len(results)-len(drels.Results)))
 	return results, 0, nil
 }
 
@@ -295,13 +361,171 @@ func (rez *EventResolver) HandleEvent(ctx context.Context,
 	}
 }
 
+// Record alert instances for each entry of an alerts event so that they may be acknowledged
+// and resolved. Events of other types are ignored.
+func (rez *EventResolver) RecordAlerts(ctx context.Context, device *model.Device,
+	event *esmodel.UnresolvedEvent) (uint, error) {
+	if event == nil || event.EventType != esmodel.Alert {
+		return 0, nil
+	}
+	payload, ok := event.Payload.(*esmodel.UnresolvedAlertsPayload)
+	if !ok {
+		return uint(dmproto.FailureReason_Invalid), errors.New("alerts payload was not of expected type")
+	}
+	for _, entry := range payload.Entries {
+		request := &model.AlertOccurrenceRequest{
+			DeviceId:     device.ID,
+			AlertType:    entry.Type,
+			Level:        entry.Level,
+			Message:      entry.Message,
+			Source:       entry.Source,
+			OccurredTime: entryOccurredTime(entry.OccurredTime, event),
+		}
+		err := rez.Retry.Do(ctx, "RecordAlertOccurrence", func() (err error) {
+			_, _, err = rez.Api.RecordAlertOccurrence(ctx, request)
+			return err
+		})
+		if err != nil {
+			return uint(dmproto.FailureReason_ApiCallFailed), err
+		}
+	}
+	return 0, nil
+}
+
+// Resolve alerts generated by alert rules along the same relationships as the measurements
+// that triggered them.
+func (rez *EventResolver) ResolveGeneratedAlerts(ctx context.Context, device *model.Device,
+	alerts *esmodel.UnresolvedEvent, handled []EventResolutionResults) ([]EventResolutionResults, uint, error) {
+	results := make([]EventResolutionResults, 0)
+	if alerts == nil {
+		return results, 0, nil
+	}
+	for _, current := range handled {
+		resolved, err := rez.ResolveAlertsEventPayload(ctx, device, current.Relationship, alerts)
+		if err != nil {
+			return nil, uint(dmproto.FailureReason_ApiCallFailed), err
+		}
+		result, err := rez.MergeRelationshipToResolveEvent(device, current.Relationship, alerts, resolved)
+		if err != nil {
+			return nil, uint(dmproto.FailureReason_ApiCallFailed), err
+		}
+		if current.Hop != nil {
+			*result = rez.FanOutResolvedEvent(*result, *current.Hop)
+		}
+		results = append(results, *result)
+	}
+	return results, 0, nil
+}
+
+// Hold an event from an unregistered device so that the device may be approved later.
+func (rez *EventResolver) HandlePendingDeviceEvent(ctx context.Context,
+	unrez *esmodel.UnresolvedEvent) ([]EventResolutionResults, uint, error) {
+	payload, err := esproto.MarshalUnresolvedEvent(unrez)
+	if err != nil {
+		return nil, uint(dmproto.FailureReason_Invalid), err
+	}
+
+	// Keep a human-readable copy of the event as a sample.
+	var sample *string
+	jevent, err := json.Marshal(unrez)
+	if err == nil {
+		jstr := string(jevent)
+		sample = &jstr
+	}
+
+	var pending *model.PendingDevice
+	var held bool
+	err = rez.Retry.Do(ctx, "RecordPendingDeviceEvent", func() (err error) {
+		pending, held, err = rez.Api.RecordPendingDeviceEvent(ctx, &model.PendingDeviceEventCreateRequest{
+			Token:         unrez.Device,
+			Source:        unrez.Source,
+			SamplePayload: sample,
+			Payload:       payload,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, uint(dmproto.FailureReason_ApiCallFailed), err
+	}
+	if pending.Rejected {
+		return nil, uint(dmproto.FailureReason_DeviceRejected), fmt.Errorf("device '%s' has been rejected", unrez.Device)
+	}
+	if !held {
+		return nil, uint(dmproto.FailureReason_DeviceNotFound),
+			fmt.Errorf("device '%s' not found and held event limit reached", unrez.Device)
+	}
+	return []EventResolutionResults{}, 0, nil
+}
+
+// Verify that the status of a device allows its events to be resolved.
+func (rez *EventResolver) CheckDeviceStatus(device *model.Device) (uint, error) {
+	switch device.Status {
+	case model.DEVICE_STATUS_SUSPENDED:
+		return uint(dmproto.FailureReason_DeviceSuspended), fmt.Errorf("device is suspended: %s", device.Token)
+	case model.DEVICE_STATUS_DECOMMISSIONED:
+		return uint(dmproto.FailureReason_DeviceDecommissioned), fmt.Errorf("device is decommissioned: %s", device.Token)
+	case model.DEVICE_STATUS_MANUFACTURED:
+		return uint(dmproto.FailureReason_DeviceNotProvisioned), fmt.Errorf("device is not provisioned: %s", device.Token)
+	}
+	return 0, nil
+}
+
 // Execute logic to resolve event.
-func (rez *EventResolver) ResolveEvent(ctx context.Context, unrez *esmodel.UnresolvedEvent) ([]EventResolutionResults, uint, error) {
-	matches, err := rez.Api.DevicesByToken(context.Background(), []string{unrez.Device})
-	if err != nil || len(matches) == 0 {
-		return nil, uint(dmproto.FailureReason_DeviceNotFound), err
+func (rez *EventResolver) ResolveEvent(ctx context.Context,
+	unrez *esmodel.UnresolvedEvent) (_ []EventResolutionResults, _ uint, err error) {
+	ctx, span := startSpan(ctx, "ResolveEvent", attribute.String("device.token", unrez.Device),
+		attribute.String("event.type", unrez.EventType.String()))
+	defer func() { endSpan(span, err) }()
+
+	var matches []*model.Device
+	err = rez.Retry.Do(ctx, "DevicesByToken", func() (err error) {
+		matches, err = rez.Api.DevicesByToken(ctx, []string{unrez.Device})
+		return err
+	})
+	if err != nil {
+		return nil, uint(dmproto.FailureReason_ApiCallFailed), err
+	}
+	if len(matches) == 0 {
+		return rez.HandlePendingDeviceEvent(ctx, unrez)
+	}
+	rez.Limiter.Configure(matches[0])
+	reason, err := rez.CheckDeviceStatus(matches[0])
+	if err != nil {
+		return nil, reason, err
+	}
+
+	// Apply transforms for the device type which may drop or split the event.
+	events, err := rez.Transformer.Apply(matches[0], unrez)
+	if err != nil {
+		return nil, uint(dmproto.FailureReason_TransformFailed), err
 	}
-	return rez.HandleEvent(ctx, matches[0], unrez)
+	results := make([]EventResolutionResults, 0)
+	for _, event := range events {
+		handled, reason, err := rez.HandleEvent(ctx, matches[0], event)
+		if err != nil {
+			return nil, reason, err
+		}
+		results = append(results, handled...)
+		reason, err = rez.RecordAlerts(ctx, matches[0], event)
+		if err != nil {
+			return nil, reason, err
+		}
+
+		// Evaluate alert rules against measurements once they have been resolved.
+		alerts, rollback := rez.Alerts.Evaluate(matches[0], event)
+		generated, reason, err := rez.ResolveGeneratedAlerts(ctx, matches[0], alerts, handled)
+		if err != nil {
+			rollback()
+			return nil, reason, err
+		}
+		results = append(results, generated...)
+		reason, err = rez.RecordAlerts(ctx, matches[0], alerts)
+		if err != nil {
+			rollback()
+			return nil, reason, err
+		}
+	}
+	return results, 0, nil
 }
 
 // Converts unresolved events into resolved events.
@@ -325,12 +549,30 @@ func (rez *EventResolver) Process(ctx context.Context) {
 				}
 			}
 
-			// Attempt to resolve event.
-			resolved, reason, err := rez.ResolveEvent(ctx, event)
+			// Drop events already seen unless they are being replayed.
+			if !IsReplayed(unresolved) && rez.Dedup.IsDuplicate(ctx, event) {
+				log.Debug().Msg(fmt.Sprintf("Dropped duplicate event for device %s", event.Device))
+				rez.Resolved(unresolved, []EventResolutionResults{}, nil)
+				continue
+			}
+
+			// Send events over the device rate limit to failed events without looking up the device.
+			if !IsReplayed(unresolved) && !rez.Limiter.Allow(event.Device) {
+				rez.Dedup.Release(ctx, event)
+				rez.Failed(unresolved, uint(dmproto.FailureReason_RateLimited), *event,
+					fmt.Errorf("device exceeded inbound event rate limit: %s", event.Device))
+				continue
+			}
+
+			// Attempt to resolve event, continuing the trace started when the message was received.
+			msgctx := ExtractTraceContext(ctx, unresolved)
+			resolved, reason, err := rez.ResolveEvent(msgctx, event)
 			if err != nil {
-				rez.Failed(reason, *event, err)
+				rez.Dedup.Release(ctx, event)
+				rez.Failed(unresolved, reason, *event, err)
 			} else {
-				rez.Resolved(resolved)
+				// Keep the deduplication key for the full window only once the results are written.
+				rez.Resolved(unresolved, resolved, func() { rez.Dedup.Confirm(ctx, event) })
 			}
 		} else {
 			log.Debug().Msg("Event resolver received shutdown signal.")
@@ -17,18 +17,35 @@
 package processor
 
 import (
+	"context"
+	"syscall"
 	"testing"
 
+	"github.com/devicechain-io/dc-device-management/config"
+	dmodel "github.com/devicechain-io/dc-device-management/model"
+	dmproto "github.com/devicechain-io/dc-device-management/proto"
+	dmtest "github.com/devicechain-io/dc-device-management/test"
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"github.com/jackc/pgconn"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 )
 
 type EventResolverTestSuite struct {
 	suite.Suite
+	API      *dmtest.MockApi
+	Resolver *EventResolver
 }
 
 // Perform common setup tasks.
 func (suite *EventResolverTestSuite) SetupTest() {
+	suite.API = new(dmtest.MockApi)
+	retry := NewRetrier(dmtest.DeviceManagementMicroservice, config.RetryConfiguration{
+		MaxRetries:       2,
+		InitialBackoffMs: 1,
+		MaxBackoffMs:     2,
+	})
+	suite.Resolver = NewEventResolver(1, suite.API, retry, PipelineStages{}, nil, nil, nil, nil)
 }
 
 // Test 1
@@ -41,6 +58,151 @@ func (suite *EventResolverTestSuite) Test2() {
 	assert.Equal(suite.T(), 2, 2)
 }
 
+// Test event from unknown device is held pending approval.
+func (suite *EventResolverTestSuite) TestPendingDeviceEventHeld() {
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{}, nil)
+	suite.API.Mock.On("RecordPendingDeviceEvent").Return(&dmodel.PendingDevice{}, true, nil)
+
+	results, reason, err := suite.Resolver.ResolveEvent(context.Background(), buildLocationsEvent())
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(0), reason)
+	assert.Empty(suite.T(), results)
+	suite.API.AssertCalled(suite.T(), "RecordPendingDeviceEvent")
+}
+
+// Test event from unknown device fails once held event limit is reached.
+func (suite *EventResolverTestSuite) TestPendingDeviceEventLimit() {
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{}, nil)
+	suite.API.Mock.On("RecordPendingDeviceEvent").Return(&dmodel.PendingDevice{}, false, nil)
+
+	_, reason, err := suite.Resolver.ResolveEvent(context.Background(), buildLocationsEvent())
+
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), uint(dmproto.FailureReason_DeviceNotFound), reason)
+}
+
+// Test event from rejected device fails.
+func (suite *EventResolverTestSuite) TestRejectedDeviceEvent() {
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{}, nil)
+	suite.API.Mock.On("RecordPendingDeviceEvent").Return(&dmodel.PendingDevice{Rejected: true}, false, nil)
+
+	_, reason, err := suite.Resolver.ResolveEvent(context.Background(), buildLocationsEvent())
+
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), uint(dmproto.FailureReason_DeviceRejected), reason)
+}
+
+// Test event for suspended device.
+func (suite *EventResolverTestSuite) TestSuspendedDeviceEvent() {
+	device := buildDevice()
+	device.Status = dmodel.DEVICE_STATUS_SUSPENDED
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{device}, nil)
+
+	_, reason, err := suite.Resolver.ResolveEvent(context.Background(), buildLocationsEvent())
+
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), uint(dmproto.FailureReason_DeviceSuspended), reason)
+	suite.API.Mock.AssertNotCalled(suite.T(), "DeviceRelationships")
+}
+
+// Test event for decommissioned device.
+func (suite *EventResolverTestSuite) TestDecommissionedDeviceEvent() {
+	device := buildDevice()
+	device.Status = dmodel.DEVICE_STATUS_DECOMMISSIONED
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{device}, nil)
+
+	_, reason, err := suite.Resolver.ResolveEvent(context.Background(), buildLocationsEvent())
+
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), uint(dmproto.FailureReason_DeviceDecommissioned), reason)
+}
+
+// Test transient api failure is retried before event is resolved.
+func (suite *EventResolverTestSuite) TestTransientFailureRetried() {
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{}, syscall.ECONNRESET).Once()
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{buildDevice()}, nil).Once()
+	suite.API.Mock.On("DeviceRelationships").Return(buildDeviceRelationshipSearchResults(), nil)
+
+	results, _, err := suite.Resolver.ResolveEvent(context.Background(), buildLocationsEvent())
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 1, len(results))
+	suite.API.AssertNumberOfCalls(suite.T(), "DevicesByToken", 2)
+}
+
+// Test event fails once retry budget is exhausted.
+func (suite *EventResolverTestSuite) TestTransientFailureExhausted() {
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{}, &pgconn.PgError{Code: "40P01"})
+
+	_, reason, err := suite.Resolver.ResolveEvent(context.Background(), buildLocationsEvent())
+
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), uint(dmproto.FailureReason_ApiCallFailed), reason)
+	suite.API.AssertNumberOfCalls(suite.T(), "DevicesByToken", 3)
+}
+
+// Test permanent api failure is not retried.
+func (suite *EventResolverTestSuite) TestPermanentFailureNotRetried() {
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{}, &pgconn.PgError{Code: "42P01"})
+
+	_, reason, err := suite.Resolver.ResolveEvent(context.Background(), buildLocationsEvent())
+
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), uint(dmproto.FailureReason_ApiCallFailed), reason)
+	suite.API.AssertNumberOfCalls(suite.T(), "DevicesByToken", 1)
+}
+
+// Test resolved events carry no snapshots unless enrichment is enabled.
+func (suite *EventResolverTestSuite) TestEnrichmentDisabled() {
+	results, err := suite.Resolver.MergeRelationshipToResolveEvent(buildDevice(), buildDeviceRelationship(),
+		buildLocationsEvent(), &dmodel.ResolvedLocationsPayload{})
+
+	assert.Nil(suite.T(), err)
+	assert.Nil(suite.T(), results.Resolved.Enrichment)
+}
+
+// Test enriched events include tokens, types and selected metadata of related entities.
+func (suite *EventResolverTestSuite) TestEnrichmentEnabled() {
+	device := buildDevice()
+	device.DeviceType = &dmodel.DeviceType{TokenReference: rdb.TokenReference{Token: "sensor"}}
+	metadata := `{"site":"north","floor":3,"secret":"x"}`
+	device.Metadata = rdb.MetadataStrOf(&metadata)
+
+	keys := `["site","floor"]`
+	relation := buildDeviceRelationship()
+	relation.RelationshipType.Enriched = true
+	relation.RelationshipType.EnrichedMetadataKeys = rdb.MetadataStrOf(&keys)
+	relation.TargetAsset = &dmodel.Asset{
+		TokenReference: rdb.TokenReference{Token: "truck-1"},
+		AssetType:      &dmodel.AssetType{TokenReference: rdb.TokenReference{Token: "truck"}},
+	}
+
+	results, err := suite.Resolver.MergeRelationshipToResolveEvent(device, relation,
+		buildLocationsEvent(), &dmodel.ResolvedLocationsPayload{})
+	assert.Nil(suite.T(), err)
+
+	enrichment := results.Resolved.Enrichment
+	assert.NotNil(suite.T(), enrichment)
+	assert.Equal(suite.T(), "TEST-123", enrichment.SourceDevice.Token)
+	assert.Equal(suite.T(), "sensor", *enrichment.SourceDevice.TypeToken)
+	assert.Equal(suite.T(), "Test 123", *enrichment.SourceDevice.Name)
+	assert.Equal(suite.T(), map[string]string{"site": "north", "floor": "3"}, enrichment.SourceDevice.Metadata)
+	assert.Equal(suite.T(), "truck-1", enrichment.TargetAsset.Token)
+	assert.Equal(suite.T(), "truck", *enrichment.TargetAsset.TypeToken)
+	assert.Nil(suite.T(), enrichment.TargetArea)
+
+	// Verify snapshots survive protobuf encoding.
+	results.Resolved.Payload = &dmodel.ResolvedLocationsPayload{Entries: []dmodel.ResolvedLocationEntry{}}
+	bytes, err := dmproto.MarshalResolvedEvent(results.Resolved)
+	assert.Nil(suite.T(), err)
+	decoded, err := dmproto.UnmarshalResolvedEvent(bytes)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), enrichment.SourceDevice, decoded.Enrichment.SourceDevice)
+	assert.Equal(suite.T(), "truck", *decoded.Enrichment.TargetAsset.TypeToken)
+	assert.Nil(suite.T(), decoded.Enrichment.TargetArea)
+}
+
 // Run all tests.
 func TestWorkerTestSuite(t *testing.T) {
 	suite.Run(t, new(EventResolverTestSuite))
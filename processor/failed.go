@@ -0,0 +1,340 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/devicechain-io/dc-device-management/config"
+	dmodel "github.com/devicechain-io/dc-device-management/model"
+	"github.com/devicechain-io/dc-device-management/proto"
+	esproto "github.com/devicechain-io/dc-event-sources/proto"
+	"github.com/devicechain-io/dc-microservice/core"
+	kcore "github.com/devicechain-io/dc-microservice/kafka"
+	"github.com/rs/zerolog/log"
+	"github.com/segmentio/kafka-go"
+)
+
+const (
+	REPLAY_BATCH_SIZE = 100 // Number of dead letter events replayed per batch
+)
+
+var (
+	ErrFailedEventsProcessorStopped = errors.New("failed events processor is not running")
+)
+
+type FailedEventsProcessor struct {
+	Microservice       *core.Microservice
+	FailedEventsReader kcore.KafkaReader
+	Api                dmodel.DeviceManagementApi
+	Retry              *Retrier
+	Replay             func(ctx context.Context, payloads [][]byte) error // Requeues events for resolution
+
+	lifecycle core.LifecycleManager
+	mutex     sync.Mutex
+	running   context.Context
+	stop      context.CancelFunc
+	replays   sync.WaitGroup
+}
+
+// Create a new failed events processor.
+func NewFailedEventsProcessor(ms *core.Microservice, failed kcore.KafkaReader, retry config.RetryConfiguration,
+	replay func(ctx context.Context, payloads [][]byte) error, callbacks core.LifecycleCallbacks, api dmodel.DeviceManagementApi) *FailedEventsProcessor {
+	fproc := &FailedEventsProcessor{
+		Microservice:       ms,
+		FailedEventsReader: failed,
+		Api:                api,
+		Retry:              NewRetrier(ms, retry),
+		Replay:             replay,
+	}
+
+	// Create lifecycle manager.
+	fpname := fmt.Sprintf("%s-%s", ms.FunctionalArea, "failed-event-proc")
+	fproc.lifecycle = core.NewLifecycleManager(fpname, fproc, callbacks)
+	return fproc
+}
+
+// Get the device token from a failed event if the original event can be parsed.
+func deviceTokenForFailedEvent(failed *dmodel.FailedEvent) *string {
+	if failed.Reason == uint(proto.FailureReason_Invalid) {
+		return nil
+	}
+	unrez, err := esproto.UnmarshalUnresolvedEvent(failed.Payload)
+	if err != nil || unrez.Device == "" {
+		return nil
+	}
+	return &unrez.Device
+}
+
+// Read a failed event and store it so that it may be replayed. When the reader supports explicit
+// commits, the offset is only committed once the event has been stored, so events are not lost
+// while the database is unavailable.
+func (fproc *FailedEventsProcessor) ProcessMessage(ctx context.Context) bool {
+	var msg kafka.Message
+	var err error
+	committer, committing := fproc.FailedEventsReader.(CommittingKafkaReader)
+	if committing {
+		msg, err = committer.FetchMessage(ctx)
+	} else {
+		msg, err = fproc.FailedEventsReader.ReadMessage(ctx)
+	}
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			log.Info().Msg("Detected EOF on failed events stream")
+			return true
+		} else if errors.Is(err, context.Canceled) {
+			log.Info().Msg("Stopped reading failed events stream")
+			return true
+		}
+		fproc.FailedEventsReader.HandleResponse(err)
+		return false
+	}
+
+	err = fproc.recordDeadLetterEvent(ctx, msg)
+	if errors.Is(err, context.Canceled) {
+		return true
+	}
+	if committing {
+		err = committer.CommitMessages(ctx, msg)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to commit failed event offset")
+		}
+	}
+	return false
+}
+
+// Store a failed event as a dead letter event. Transient errors are retried until the event is
+// stored or the processor is stopped. Events that can never be stored are logged and skipped.
+func (fproc *FailedEventsProcessor) recordDeadLetterEvent(ctx context.Context, msg kafka.Message) error {
+	failed, err := proto.UnmarshalFailedEvent(msg.Value)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to unmarshal failed event")
+		return nil
+	}
+
+	// Rate limited events are summarized by throttle events rather than stored individually, so
+	// that a flooding device does not turn into a flood of database writes.
+	if failed.Reason == uint(proto.FailureReason_RateLimited) {
+		return nil
+	}
+	failedTime := msg.Time
+	if failedTime.IsZero() {
+		failedTime = time.Now()
+	}
+	request := &dmodel.DeadLetterEventCreateRequest{
+		Reason:      failed.Reason,
+		Service:     failed.Service,
+		Message:     failed.Message,
+		Error:       failed.Error,
+		DeviceToken: deviceTokenForFailedEvent(failed),
+		Payload:     failed.Payload,
+		FailedTime:  failedTime,
+	}
+	for {
+		err = fproc.Retry.Do(ctx, "RecordDeadLetterEvent", func() error {
+			_, err := fproc.Api.RecordDeadLetterEvent(ctx, request)
+			return err
+		})
+		if err == nil {
+			return nil
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !IsTransientError(err) {
+			log.Error().Err(err).Msg("unable to record dead letter event")
+			return nil
+		}
+		log.Warn().Err(err).Msg("unable to record dead letter event, will retry")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(fproc.Retry.MaxBackoff):
+		}
+	}
+}
+
+// Start replaying failed events that match the request. Replay continues in the background
+// until it completes or the processor is stopped, and progress is recorded on the job.
+func (fproc *FailedEventsProcessor) StartReplay(ctx context.Context,
+	request *dmodel.FailedEventReplayRequest) (*dmodel.FailedEventReplayJob, error) {
+	fproc.mutex.Lock()
+	defer fproc.mutex.Unlock()
+	if fproc.running == nil || fproc.running.Err() != nil {
+		return nil, ErrFailedEventsProcessorStopped
+	}
+	job, err := fproc.Api.CreateFailedEventReplayJob(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	started := *job
+	fproc.replays.Add(1)
+	go func() {
+		defer fproc.replays.Done()
+		fproc.RunReplay(fproc.running, job)
+	}()
+	return &started, nil
+}
+
+// Mark a replay job as failed. The job is updated even if the replay was stopped by cancelling
+// its context.
+func (fproc *FailedEventsProcessor) failReplay(ctx context.Context, job *dmodel.FailedEventReplayJob, cause error) {
+	log.Error().Err(cause).Uint("job", job.ID).Msg("failed event replay stopped")
+	job.Status = dmodel.REPLAY_JOB_STATUS_FAILED
+	job.Error = sql.NullString{String: cause.Error(), Valid: true}
+	job.CompletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	err := fproc.Api.UpdateFailedEventReplayJob(context.Background(), job)
+	if err != nil {
+		log.Error().Err(err).Uint("job", job.ID).Msg("unable to update failed event replay job")
+	}
+}
+
+// Replay all dead letter events eligible for a job. Events that have reached the replay
+// limit are quarantined rather than replayed. Events are only marked as replayed once they
+// have been requeued, so a replay that fails leaves them eligible for another job.
+func (fproc *FailedEventsProcessor) RunReplay(ctx context.Context, job *dmodel.FailedEventReplayJob) {
+	total, err := fproc.Api.CountReplayableDeadLetterEvents(ctx, job)
+	if err != nil {
+		fproc.failReplay(ctx, job, err)
+		return
+	}
+	job.Total = uint(total)
+
+	for {
+		if ctx.Err() != nil {
+			fproc.failReplay(ctx, job, ctx.Err())
+			return
+		}
+		batch, err := fproc.Api.ReplayableDeadLetterEvents(ctx, job, REPLAY_BATCH_SIZE)
+		if err != nil {
+			fproc.failReplay(ctx, job, err)
+			return
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		replayed := make([]*dmodel.DeadLetterEvent, 0)
+		payloads := make([][]byte, 0)
+		for _, event := range batch {
+			if event.Replayable() {
+				replayed = append(replayed, event)
+				payloads = append(payloads, event.Payload)
+				continue
+			}
+			err := fproc.Api.QuarantineDeadLetterEvent(ctx, event)
+			if err != nil {
+				fproc.failReplay(ctx, job, err)
+				return
+			}
+			job.Quarantined++
+		}
+		if len(payloads) > 0 {
+			err = fproc.Replay(ctx, payloads)
+			if err != nil {
+				fproc.failReplay(ctx, job, err)
+				return
+			}
+			err = fproc.Api.MarkDeadLetterEventsReplayed(ctx, replayed)
+			if err != nil {
+				fproc.failReplay(ctx, job, err)
+				return
+			}
+			job.Replayed += uint(len(replayed))
+		}
+
+		// Record progress after each batch.
+		err = fproc.Api.UpdateFailedEventReplayJob(ctx, job)
+		if err != nil {
+			log.Error().Err(err).Uint("job", job.ID).Msg("unable to update failed event replay job")
+		}
+	}
+
+	job.Status = dmodel.REPLAY_JOB_STATUS_COMPLETED
+	job.CompletedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	err = fproc.Api.UpdateFailedEventReplayJob(ctx, job)
+	if err != nil {
+		log.Error().Err(err).Uint("job", job.ID).Msg("unable to update failed event replay job")
+	}
+}
+
+// Initialize component.
+func (fproc *FailedEventsProcessor) Initialize(ctx context.Context) error {
+	return fproc.lifecycle.Initialize(ctx)
+}
+
+// Lifecycle callback that runs initialization logic.
+func (fproc *FailedEventsProcessor) ExecuteInitialize(ctx context.Context) error {
+	return nil
+}
+
+// Start component.
+func (fproc *FailedEventsProcessor) Start(ctx context.Context) error {
+	return fproc.lifecycle.Start(ctx)
+}
+
+// Lifecycle callback that runs startup logic.
+func (fproc *FailedEventsProcessor) ExecuteStart(context.Context) error {
+	// Processing and replays run until the processor is stopped.
+	fproc.mutex.Lock()
+	fproc.running, fproc.stop = context.WithCancel(context.Background())
+	running := fproc.running
+	fproc.mutex.Unlock()
+
+	// Processing loop for failed events.
+	go func() {
+		for {
+			eof := fproc.ProcessMessage(running)
+			if eof {
+				break
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop component.
+func (fproc *FailedEventsProcessor) Stop(ctx context.Context) error {
+	return fproc.lifecycle.Stop(ctx)
+}
+
+// Lifecycle callback that runs shutdown logic. Replays in progress are cancelled and marked failed.
+func (fproc *FailedEventsProcessor) ExecuteStop(context.Context) error {
+	fproc.mutex.Lock()
+	if fproc.stop != nil {
+		fproc.stop()
+	}
+	fproc.mutex.Unlock()
+	fproc.replays.Wait()
+	return nil
+}
+
+// Terminate component.
+func (fproc *FailedEventsProcessor) Terminate(ctx context.Context) error {
+	return fproc.lifecycle.Terminate(ctx)
+}
+
+// Lifecycle callback that runs termination logic.
+func (fproc *FailedEventsProcessor) ExecuteTerminate(context.Context) error {
+	return nil
+}
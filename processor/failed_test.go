@@ -0,0 +1,240 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/devicechain-io/dc-device-management/config"
+	dmodel "github.com/devicechain-io/dc-device-management/model"
+	dmproto "github.com/devicechain-io/dc-device-management/proto"
+	dmtest "github.com/devicechain-io/dc-device-management/test"
+	esproto "github.com/devicechain-io/dc-event-sources/proto"
+	"github.com/devicechain-io/dc-microservice/core"
+	test "github.com/devicechain-io/dc-microservice/test"
+	"github.com/jackc/pgconn"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type FailedEventsProcessorTestSuite struct {
+	suite.Suite
+	FP       *FailedEventsProcessor
+	Failed   *test.MockKafkaReader
+	API      *dmtest.MockApi
+	Replayed [][]byte
+	Requeue  error // Error returned when events are requeued
+}
+
+// Perform common setup tasks.
+func (suite *FailedEventsProcessorTestSuite) SetupTest() {
+	suite.Failed = new(test.MockKafkaReader)
+	suite.API = new(dmtest.MockApi)
+	suite.Replayed = make([][]byte, 0)
+	suite.FP = NewFailedEventsProcessor(
+		dmtest.DeviceManagementMicroservice,
+		suite.Failed,
+		config.RetryConfiguration{MaxRetries: 1, InitialBackoffMs: 1, MaxBackoffMs: 1},
+		func(ctx context.Context, payloads [][]byte) error {
+			if suite.Requeue != nil {
+				return suite.Requeue
+			}
+			suite.Replayed = append(suite.Replayed, payloads...)
+			return nil
+		},
+		core.NewNoOpLifecycleCallbacks(),
+		suite.API)
+	suite.FP.Initialize(context.Background())
+}
+
+// Test processing loop termination on EOF.
+func (suite *FailedEventsProcessorTestSuite) TestProcessingLoopEof() {
+	suite.Failed.Mock.On("ReadMessage", mock.Anything).Return(kafka.Message{}, io.EOF)
+
+	eof := suite.FP.ProcessMessage(context.Background())
+
+	assert.Equal(suite.T(), true, eof)
+}
+
+// Test failed event is recorded as a dead letter event.
+func (suite *FailedEventsProcessorTestSuite) TestRecordFailedEvent() {
+	payload, err := esproto.MarshalUnresolvedEvent(buildLocationsEvent())
+	assert.Nil(suite.T(), err)
+	failed := dmodel.NewFailedEvent(uint(dmproto.FailureReason_DeviceNotFound), "device-management",
+		"event could not be resolved", errors.New("not found"), payload)
+	encoded, err := dmproto.MarshalFailedEvent(failed)
+	assert.Nil(suite.T(), err)
+
+	suite.Failed.Mock.On("ReadMessage", mock.Anything).Return(kafka.Message{Value: encoded}, nil)
+	suite.API.Mock.On("RecordDeadLetterEvent").Return(&dmodel.DeadLetterEvent{}, nil)
+
+	eof := suite.FP.ProcessMessage(context.Background())
+
+	assert.Equal(suite.T(), false, eof)
+	suite.API.AssertCalled(suite.T(), "RecordDeadLetterEvent")
+	assert.Equal(suite.T(), "TEST-123", *deviceTokenForFailedEvent(failed))
+}
+
+// Build a kafka message containing a failed event.
+func (suite *FailedEventsProcessorTestSuite) failedEventMessage() kafka.Message {
+	payload, err := esproto.MarshalUnresolvedEvent(buildLocationsEvent())
+	assert.Nil(suite.T(), err)
+	failed := dmodel.NewFailedEvent(uint(dmproto.FailureReason_DeviceNotFound), "device-management",
+		"event could not be resolved", errors.New("not found"), payload)
+	encoded, err := dmproto.MarshalFailedEvent(failed)
+	assert.Nil(suite.T(), err)
+	return kafka.Message{Value: encoded}
+}
+
+// Test rate limited events are not stored individually.
+func (suite *FailedEventsProcessorTestSuite) TestRateLimitedNotStored() {
+	failed := dmodel.NewFailedEvent(uint(dmproto.FailureReason_RateLimited), "device-management",
+		"device exceeded rate limit", errors.New("rate limited"), []byte("payload"))
+	encoded, err := dmproto.MarshalFailedEvent(failed)
+	assert.Nil(suite.T(), err)
+	suite.Failed.Mock.On("ReadMessage", mock.Anything).Return(kafka.Message{Value: encoded}, nil)
+
+	eof := suite.FP.ProcessMessage(context.Background())
+
+	assert.Equal(suite.T(), false, eof)
+	suite.API.AssertNotCalled(suite.T(), "RecordDeadLetterEvent")
+}
+
+// Test offset is only committed once the dead letter event has been stored.
+func (suite *FailedEventsProcessorTestSuite) TestCommitAfterDatabaseRecovers() {
+	reader := new(dmtest.MockCommittingKafkaReader)
+	suite.FP.FailedEventsReader = reader
+	msg := suite.failedEventMessage()
+	reader.Mock.On("FetchMessage").Return(msg, nil)
+	reader.Mock.On("CommitMessages", mock.Anything).Return(nil)
+	suite.API.Mock.On("RecordDeadLetterEvent").Return((*dmodel.DeadLetterEvent)(nil), &pgconn.PgError{Code: "08006"}).Times(3)
+	suite.API.Mock.On("RecordDeadLetterEvent").Return(&dmodel.DeadLetterEvent{}, nil).Once()
+
+	eof := suite.FP.ProcessMessage(context.Background())
+
+	assert.Equal(suite.T(), false, eof)
+	suite.API.AssertNumberOfCalls(suite.T(), "RecordDeadLetterEvent", 4)
+	reader.AssertCalled(suite.T(), "CommitMessages", []kafka.Message{msg})
+}
+
+// Test offset is not committed if the processor stops before the dead letter event is stored.
+func (suite *FailedEventsProcessorTestSuite) TestNoCommitWhenStopped() {
+	reader := new(dmtest.MockCommittingKafkaReader)
+	suite.FP.FailedEventsReader = reader
+	reader.Mock.On("FetchMessage").Return(suite.failedEventMessage(), nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	suite.API.Mock.On("RecordDeadLetterEvent").Return((*dmodel.DeadLetterEvent)(nil), &pgconn.PgError{Code: "08006"}).
+		Run(func(mock.Arguments) { cancel() })
+
+	eof := suite.FP.ProcessMessage(ctx)
+
+	assert.Equal(suite.T(), true, eof)
+	reader.AssertNotCalled(suite.T(), "CommitMessages", mock.Anything)
+}
+
+// Test stopping the processor cancels replays in progress.
+func (suite *FailedEventsProcessorTestSuite) TestStopCancelsReplay() {
+	counting := make(chan bool)
+	proceed := make(chan bool)
+	suite.Failed.Mock.On("ReadMessage", mock.Anything).Return(kafka.Message{}, io.EOF)
+	suite.API.Mock.On("CreateFailedEventReplayJob").Return(&dmodel.FailedEventReplayJob{}, nil)
+	suite.API.Mock.On("CountReplayableDeadLetterEvents").Return(int64(1), nil).Run(func(mock.Arguments) {
+		close(counting)
+		<-proceed
+	})
+	suite.API.Mock.On("UpdateFailedEventReplayJob").Return(nil)
+
+	err := suite.FP.Start(context.Background())
+	assert.Nil(suite.T(), err)
+	_, err = suite.FP.StartReplay(context.Background(), &dmodel.FailedEventReplayRequest{})
+	assert.Nil(suite.T(), err)
+	<-counting
+
+	stopped := make(chan error)
+	go func() { stopped <- suite.FP.Stop(context.Background()) }()
+	assert.Eventually(suite.T(), func() bool { return suite.FP.running.Err() != nil }, time.Second, time.Millisecond)
+	close(proceed)
+
+	assert.Nil(suite.T(), <-stopped)
+	suite.API.AssertNotCalled(suite.T(), "ReplayableDeadLetterEvents")
+	_, err = suite.FP.StartReplay(context.Background(), &dmodel.FailedEventReplayRequest{})
+	assert.ErrorIs(suite.T(), err, ErrFailedEventsProcessorStopped)
+}
+
+// Test replay feeds events back for resolution and quarantines events over the replay limit.
+func (suite *FailedEventsProcessorTestSuite) TestReplay() {
+	batch := []*dmodel.DeadLetterEvent{
+		{Payload: []byte("first")},
+		{Payload: []byte("second"), ReplayCount: dmodel.DEAD_LETTER_MAX_REPLAYS},
+	}
+	suite.API.Mock.On("CountReplayableDeadLetterEvents").Return(int64(2), nil)
+	suite.API.Mock.On("ReplayableDeadLetterEvents").Return(batch, nil).Once()
+	suite.API.Mock.On("ReplayableDeadLetterEvents").Return([]*dmodel.DeadLetterEvent{}, nil).Once()
+	suite.API.Mock.On("QuarantineDeadLetterEvent").Return(nil).Once()
+	suite.API.Mock.On("MarkDeadLetterEventsReplayed", batch[:1]).Return(nil).Once()
+	suite.API.Mock.On("UpdateFailedEventReplayJob").Return(nil)
+
+	job := &dmodel.FailedEventReplayJob{Status: dmodel.REPLAY_JOB_STATUS_RUNNING}
+	suite.FP.RunReplay(context.Background(), job)
+
+	assert.Equal(suite.T(), dmodel.REPLAY_JOB_STATUS_COMPLETED, job.Status)
+	assert.Equal(suite.T(), uint(2), job.Total)
+	assert.Equal(suite.T(), uint(1), job.Replayed)
+	assert.Equal(suite.T(), uint(1), job.Quarantined)
+	assert.Equal(suite.T(), [][]byte{[]byte("first")}, suite.Replayed)
+	suite.API.AssertCalled(suite.T(), "MarkDeadLetterEventsReplayed", batch[:1])
+}
+
+// Test events are not marked as replayed when they can not be requeued.
+func (suite *FailedEventsProcessorTestSuite) TestReplayRequeueFailure() {
+	batch := []*dmodel.DeadLetterEvent{{Payload: []byte("first")}}
+	suite.Requeue = ErrInboundEventsProcessorStopped
+	suite.API.Mock.On("CountReplayableDeadLetterEvents").Return(int64(1), nil)
+	suite.API.Mock.On("ReplayableDeadLetterEvents").Return(batch, nil).Once()
+	suite.API.Mock.On("UpdateFailedEventReplayJob").Return(nil)
+
+	job := &dmodel.FailedEventReplayJob{Status: dmodel.REPLAY_JOB_STATUS_RUNNING}
+	suite.FP.RunReplay(context.Background(), job)
+
+	assert.Equal(suite.T(), dmodel.REPLAY_JOB_STATUS_FAILED, job.Status)
+	assert.Equal(suite.T(), uint(0), job.Replayed)
+	suite.API.AssertNotCalled(suite.T(), "MarkDeadLetterEventsReplayed", mock.Anything)
+}
+
+// Test replay job is marked failed when events can not be loaded.
+func (suite *FailedEventsProcessorTestSuite) TestReplayFailure() {
+	suite.API.Mock.On("CountReplayableDeadLetterEvents").Return(int64(0), errors.New("db down"))
+	suite.API.Mock.On("UpdateFailedEventReplayJob").Return(nil)
+
+	job := &dmodel.FailedEventReplayJob{Status: dmodel.REPLAY_JOB_STATUS_RUNNING}
+	suite.FP.RunReplay(context.Background(), job)
+
+	assert.Equal(suite.T(), dmodel.REPLAY_JOB_STATUS_FAILED, job.Status)
+	assert.Equal(suite.T(), "db down", job.Error.String)
+}
+
+// Run all tests.
+func TestFailedEventsProcessorTestSuite(t *testing.T) {
+	suite.Run(t, new(FailedEventsProcessorTestSuite))
+}
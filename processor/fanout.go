@@ -0,0 +1,276 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/devicechain-io/dc-device-management/config"
+	"github.com/devicechain-io/dc-device-management/model"
+	"github.com/devicechain-io/dc-microservice/core"
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	fanOutMetricsOnce sync.Once
+	fannedOutEvents   *prometheus.CounterVec
+	fanOutCycles      prometheus.Counter
+	trackedRelsLoaded prometheus.Gauge
+)
+
+// Tracked relationship reached while fanning out an event from a device relationship.
+type RelationshipHop struct {
+	SourceKind   string
+	Relationship *model.EntityRelationship
+	Depth        int
+}
+
+// Entity reached while fanning out an event.
+type fanOutNode struct {
+	kind  string
+	id    uint
+	depth int
+}
+
+// Key used to detect entities that have already been reached.
+func (node fanOutNode) key() string {
+	return fanOutKey(node.kind, node.id)
+}
+
+// Key for an entity of the given kind.
+func fanOutKey(kind string, id uint) string {
+	return fmt.Sprintf("%s:%d", kind, id)
+}
+
+// Follows tracked asset, area and customer relationships from the targets of tracked device
+// relationships so that events reach entities beyond those a device is directly related to.
+// Tracked relationships are cached and reloaded when changed so that fan-out does not require
+// api calls.
+type RelationshipFanOut struct {
+	Api model.DeviceManagementApi
+
+	mutex    sync.RWMutex
+	maxDepth int
+	tracked  map[string][]*model.EntityRelationship
+}
+
+// Create a new relationship fan-out.
+func NewRelationshipFanOut(ms *core.Microservice, api model.DeviceManagementApi,
+	cfg config.FanOutConfiguration) *RelationshipFanOut {
+	fanOutMetricsOnce.Do(func() {
+		fannedOutEvents = ms.NewCounterVec("fanned_out_events_total",
+			"Number of resolved events fanned out along tracked entity relationships", []string{"kind"})
+		fanOutCycles = ms.NewCounter("fan_out_cycles_total",
+			"Number of tracked relationships skipped because they lead back to an entity on the path to them",
+			[]string{})
+		trackedRelsLoaded = ms.NewGauge("tracked_relationships_loaded",
+			"Number of tracked asset, area and customer relationships currently cached for fan-out", []string{})
+	})
+	return &RelationshipFanOut{
+		Api:      api,
+		maxDepth: cfg.MaxDepth,
+		tracked:  make(map[string][]*model.EntityRelationship),
+	}
+}
+
+// Update settings applied to events fanned out after the change.
+func (fo *RelationshipFanOut) SetConfiguration(cfg config.FanOutConfiguration) {
+	if fo == nil {
+		return
+	}
+	fo.mutex.Lock()
+	defer fo.mutex.Unlock()
+	fo.maxDepth = cfg.MaxDepth
+}
+
+// Get the number of relationships followed beyond a device relationship.
+func (fo *RelationshipFanOut) MaxDepth() int {
+	fo.mutex.RLock()
+	defer fo.mutex.RUnlock()
+	return fo.maxDepth
+}
+
+// Load the current set of tracked asset, area and customer relationships.
+func (fo *RelationshipFanOut) Reload(ctx context.Context) error {
+	if fo == nil {
+		return nil
+	}
+	yes := true
+	all := rdb.Pagination{
+		PageNumber: 1,
+		PageSize:   0,
+	}
+	tracked := make(map[string][]*model.EntityRelationship)
+	loaded := 0
+	assets, err := fo.Api.AssetRelationships(ctx, model.AssetRelationshipSearchCriteria{
+		Pagination: all,
+		Tracked:    &yes,
+	})
+	if err != nil {
+		return err
+	}
+	for i := range assets.Results {
+		key := fanOutKey(model.ENTITY_KIND_ASSET, assets.Results[i].SourceAssetId)
+		tracked[key] = append(tracked[key], &assets.Results[i].EntityRelationship)
+		loaded++
+	}
+	areas, err := fo.Api.AreaRelationships(ctx, model.AreaRelationshipSearchCriteria{
+		Pagination: all,
+		Tracked:    &yes,
+	})
+	if err != nil {
+		return err
+	}
+	for i := range areas.Results {
+		key := fanOutKey(model.ENTITY_KIND_AREA, areas.Results[i].SourceAreaId)
+		tracked[key] = append(tracked[key], &areas.Results[i].EntityRelationship)
+		loaded++
+	}
+	customers, err := fo.Api.CustomerRelationships(ctx, model.CustomerRelationshipSearchCriteria{
+		Pagination: all,
+		Tracked:    &yes,
+	})
+	if err != nil {
+		return err
+	}
+	for i := range customers.Results {
+		key := fanOutKey(model.ENTITY_KIND_CUSTOMER, customers.Results[i].SourceCustomerId)
+		tracked[key] = append(tracked[key], &customers.Results[i].EntityRelationship)
+		loaded++
+	}
+	fo.mutex.Lock()
+	fo.tracked = tracked
+	fo.mutex.Unlock()
+	trackedRelsLoaded.Set(float64(loaded))
+	return nil
+}
+
+// Get cached tracked relationships with an entity as their source.
+func (fo *RelationshipFanOut) trackedFrom(node fanOutNode) []*model.EntityRelationship {
+	fo.mutex.RLock()
+	defer fo.mutex.RUnlock()
+	return fo.tracked[node.key()]
+}
+
+// Get the targets of a relationship which may have tracked relationships of their own.
+func fanOutTargets(relation *model.EntityRelationship, depth int) []fanOutNode {
+	nodes := make([]fanOutNode, 0)
+	if relation.TargetAssetId != nil {
+		nodes = append(nodes, fanOutNode{model.ENTITY_KIND_ASSET, *relation.TargetAssetId, depth})
+	}
+	if relation.TargetAreaId != nil {
+		nodes = append(nodes, fanOutNode{model.ENTITY_KIND_AREA, *relation.TargetAreaId, depth})
+	}
+	if relation.TargetCustomerId != nil {
+		nodes = append(nodes, fanOutNode{model.ENTITY_KIND_CUSTOMER, *relation.TargetCustomerId, depth})
+	}
+	return nodes
+}
+
+// Fan-out of a single event across all of the device relationships it was resolved for. Entities
+// are reached at most once per event, so an entity reachable along several paths (including as
+// the direct target of another device relationship) receives the event once.
+type FanOutWalk struct {
+	fanout   *RelationshipFanOut
+	maxDepth int
+	reached  map[string]bool
+	expanded map[string]bool
+	parents  map[string]string // Entity each reached entity was first reached from ("" for direct targets)
+}
+
+// Start fanning out an event resolved for the given device relationships.
+func (fo *RelationshipFanOut) NewWalk(relations []model.DeviceRelationship) *FanOutWalk {
+	if fo == nil {
+		return nil
+	}
+	walk := &FanOutWalk{
+		fanout:   fo,
+		maxDepth: fo.MaxDepth(),
+		reached:  make(map[string]bool),
+		expanded: make(map[string]bool),
+		parents:  make(map[string]string),
+	}
+	for i := range relations {
+		for _, node := range fanOutTargets(&relations[i].EntityRelationship, 0) {
+			walk.reached[node.key()] = true
+			walk.parents[node.key()] = ""
+		}
+	}
+	return walk
+}
+
+// Indicates whether an entity is on the path by which another entity was first reached.
+func (walk *FanOutWalk) isAncestor(key string, of string) bool {
+	for current := of; current != ""; current = walk.parents[current] {
+		if current == key {
+			return true
+		}
+	}
+	return false
+}
+
+// Walk tracked relationships reachable from the targets of a device relationship, breadth first
+// and no deeper than the configured maximum. Each entity is expanded once per event and
+// relationships that only lead to entities already reached are skipped. Those that lead back to
+// an entity on the path to them close a cycle and are counted.
+func (walk *FanOutWalk) From(relation *model.DeviceRelationship) []RelationshipHop {
+	hops := make([]RelationshipHop, 0)
+	if walk == nil {
+		return hops
+	}
+	queue := fanOutTargets(&relation.EntityRelationship, 0)
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		if node.depth >= walk.maxDepth || walk.expanded[node.key()] {
+			continue
+		}
+		walk.expanded[node.key()] = true
+		for _, current := range walk.fanout.trackedFrom(node) {
+			targets := fanOutTargets(current, node.depth+1)
+			unreached := make([]fanOutNode, 0)
+			cycle := false
+			for _, target := range targets {
+				if !walk.reached[target.key()] {
+					unreached = append(unreached, target)
+				} else if walk.isAncestor(target.key(), node.key()) {
+					cycle = true
+				}
+			}
+			if len(targets) > 0 && len(unreached) == 0 {
+				if cycle {
+					fanOutCycles.Inc()
+				}
+				continue
+			}
+			hops = append(hops, RelationshipHop{
+				SourceKind:   node.kind,
+				Relationship: current,
+				Depth:        node.depth + 1,
+			})
+			for _, target := range unreached {
+				walk.reached[target.key()] = true
+				walk.parents[target.key()] = node.key()
+				queue = append(queue, target)
+			}
+		}
+	}
+	return hops
+}
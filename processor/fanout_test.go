@@ -0,0 +1,276 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devicechain-io/dc-device-management/config"
+	dmodel "github.com/devicechain-io/dc-device-management/model"
+	dmtest "github.com/devicechain-io/dc-device-management/test"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"gorm.io/gorm"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type RelationshipFanOutTestSuite struct {
+	suite.Suite
+	API       *dmtest.MockApi
+	FanOut    *RelationshipFanOut
+	Resolver  *EventResolver
+	Assets    []dmodel.AssetRelationship
+	Areas     []dmodel.AreaRelationship
+	Customers []dmodel.CustomerRelationship
+}
+
+// Perform common setup tasks.
+func (suite *RelationshipFanOutTestSuite) SetupTest() {
+	suite.API = new(dmtest.MockApi)
+	suite.FanOut = NewRelationshipFanOut(dmtest.DeviceManagementMicroservice, suite.API, config.NewFanOutConfiguration())
+	retry := NewRetrier(dmtest.DeviceManagementMicroservice, config.RetryConfiguration{})
+	suite.Resolver = NewEventResolver(1, suite.API, retry, PipelineStages{FanOut: suite.FanOut}, nil, nil, nil, nil)
+	suite.Assets = nil
+	suite.Areas = nil
+	suite.Customers = nil
+}
+
+// Build relationship targets referencing an asset, area or customer.
+func buildFanOutTargets(kind string, id uint) dmodel.EntityRelationship {
+	targets := dmodel.EntityRelationship{}
+	switch kind {
+	case dmodel.ENTITY_KIND_ASSET:
+		targets.TargetAssetId = &id
+	case dmodel.ENTITY_KIND_AREA:
+		targets.TargetAreaId = &id
+	case dmodel.ENTITY_KIND_CUSTOMER:
+		targets.TargetCustomerId = &id
+	}
+	return targets
+}
+
+// Build a tracked device relationship targeting an entity.
+func buildTrackerRelationship(id uint, kind string, targetId uint) *dmodel.DeviceRelationship {
+	rel := buildDeviceRelationship()
+	rel.EntityRelationship = buildFanOutTargets(kind, targetId)
+	rel.Model = gorm.Model{ID: id}
+	return rel
+}
+
+// Build a tracked device relationship targeting asset 10.
+func buildAssetTrackerRelationship() *dmodel.DeviceRelationship {
+	return buildTrackerRelationship(1, dmodel.ENTITY_KIND_ASSET, 10)
+}
+
+// Add a tracked asset relationship with the given target.
+func (suite *RelationshipFanOutTestSuite) asset(id uint, source uint, kind string, targetId uint) {
+	rel := dmodel.AssetRelationship{EntityRelationship: buildFanOutTargets(kind, targetId), SourceAssetId: source}
+	rel.Model = gorm.Model{ID: id}
+	suite.Assets = append(suite.Assets, rel)
+}
+
+// Add a tracked area relationship with the given target.
+func (suite *RelationshipFanOutTestSuite) area(id uint, source uint, kind string, targetId uint) {
+	rel := dmodel.AreaRelationship{EntityRelationship: buildFanOutTargets(kind, targetId), SourceAreaId: source}
+	rel.Model = gorm.Model{ID: id}
+	suite.Areas = append(suite.Areas, rel)
+}
+
+// Load the tracked relationships added by the test.
+func (suite *RelationshipFanOutTestSuite) reload() {
+	suite.API.Mock.On("AssetRelationships", mock.MatchedBy(func(criteria dmodel.AssetRelationshipSearchCriteria) bool {
+		return *criteria.Tracked
+	})).Return(&dmodel.AssetRelationshipSearchResults{Results: suite.Assets}, nil)
+	suite.API.Mock.On("AreaRelationships", mock.MatchedBy(func(criteria dmodel.AreaRelationshipSearchCriteria) bool {
+		return *criteria.Tracked
+	})).Return(&dmodel.AreaRelationshipSearchResults{Results: suite.Areas}, nil)
+	suite.API.Mock.On("CustomerRelationships", mock.MatchedBy(func(criteria dmodel.CustomerRelationshipSearchCriteria) bool {
+		return *criteria.Tracked
+	})).Return(&dmodel.CustomerRelationshipSearchResults{Results: suite.Customers}, nil)
+	assert.Nil(suite.T(), suite.FanOut.Reload(context.Background()))
+}
+
+// Walk tracked relationships for a single device relationship.
+func (suite *RelationshipFanOutTestSuite) walk(drel *dmodel.DeviceRelationship) []RelationshipHop {
+	return suite.FanOut.NewWalk([]dmodel.DeviceRelationship{*drel}).From(drel)
+}
+
+// Test an event on a device assigned to an asset fans out to the customer of the asset.
+func (suite *RelationshipFanOutTestSuite) TestAssetToCustomer() {
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships").Return(&dmodel.DeviceRelationshipSearchResults{
+		Results: []dmodel.DeviceRelationship{*buildAssetTrackerRelationship()},
+	}, nil)
+	suite.asset(20, 10, dmodel.ENTITY_KIND_CUSTOMER, 30)
+	suite.reload()
+
+	results, reason, err := suite.Resolver.ResolveEvent(context.Background(), buildLocationsEvent())
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(0), reason)
+	assert.Equal(suite.T(), 2, len(results))
+	assert.Nil(suite.T(), results[0].Resolved.FanOut)
+	assert.Equal(suite.T(), uint(10), *results[0].Resolved.TargetAssetId)
+
+	fanned := results[1].Resolved
+	assert.Equal(suite.T(), uint(1), fanned.DeviceRelationshipId)
+	assert.Nil(suite.T(), fanned.TargetAssetId)
+	assert.Equal(suite.T(), uint(30), *fanned.TargetCustomerId)
+	assert.Equal(suite.T(), &dmodel.ResolvedEventFanOut{
+		SourceKind:     dmodel.ENTITY_KIND_ASSET,
+		RelationshipId: 20,
+		Depth:          1,
+	}, fanned.FanOut)
+	assert.Equal(suite.T(), uint(1), results[1].Relationship.ID)
+}
+
+// Test an entity reachable through more than one device relationship receives the event once.
+func (suite *RelationshipFanOutTestSuite) TestSharedTargetReachedOnce() {
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships").Return(&dmodel.DeviceRelationshipSearchResults{
+		Results: []dmodel.DeviceRelationship{
+			*buildTrackerRelationship(1, dmodel.ENTITY_KIND_ASSET, 10),
+			*buildTrackerRelationship(2, dmodel.ENTITY_KIND_AREA, 40),
+		},
+	}, nil)
+	suite.asset(20, 10, dmodel.ENTITY_KIND_CUSTOMER, 30)
+	suite.area(21, 40, dmodel.ENTITY_KIND_CUSTOMER, 30)
+	suite.reload()
+	cycles := testutil.ToFloat64(fanOutCycles)
+
+	results, _, err := suite.Resolver.ResolveEvent(context.Background(), buildLocationsEvent())
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 3, len(results))
+	customers := 0
+	for _, result := range results {
+		if result.Resolved.TargetCustomerId != nil && *result.Resolved.TargetCustomerId == 30 {
+			customers++
+		}
+	}
+	assert.Equal(suite.T(), 1, customers)
+	assert.Equal(suite.T(), cycles, testutil.ToFloat64(fanOutCycles))
+}
+
+// Test fan-out stops at the configured depth.
+func (suite *RelationshipFanOutTestSuite) TestMaxDepth() {
+	suite.FanOut.SetConfiguration(config.FanOutConfiguration{MaxDepth: 1})
+	suite.asset(20, 10, dmodel.ENTITY_KIND_AREA, 40)
+	suite.area(21, 40, dmodel.ENTITY_KIND_CUSTOMER, 30)
+	suite.reload()
+
+	hops := suite.walk(buildAssetTrackerRelationship())
+
+	assert.Equal(suite.T(), 1, len(hops))
+	assert.Equal(suite.T(), dmodel.ENTITY_KIND_ASSET, hops[0].SourceKind)
+}
+
+// Test fan-out follows relationships across entity kinds.
+func (suite *RelationshipFanOutTestSuite) TestMultipleHops() {
+	suite.asset(20, 10, dmodel.ENTITY_KIND_AREA, 40)
+	suite.area(21, 40, dmodel.ENTITY_KIND_CUSTOMER, 30)
+	suite.reload()
+
+	hops := suite.walk(buildAssetTrackerRelationship())
+
+	assert.Equal(suite.T(), 2, len(hops))
+	assert.Equal(suite.T(), dmodel.ENTITY_KIND_AREA, hops[1].SourceKind)
+	assert.Equal(suite.T(), uint(21), hops[1].Relationship.ID)
+	assert.Equal(suite.T(), 2, hops[1].Depth)
+}
+
+// Test relationships leading back to entities on the path to them are not followed.
+func (suite *RelationshipFanOutTestSuite) TestCycleDetected() {
+	suite.asset(20, 10, dmodel.ENTITY_KIND_ASSET, 11)
+	suite.asset(21, 11, dmodel.ENTITY_KIND_ASSET, 10)
+	suite.reload()
+	cycles := testutil.ToFloat64(fanOutCycles)
+
+	hops := suite.walk(buildAssetTrackerRelationship())
+
+	assert.Equal(suite.T(), 1, len(hops))
+	assert.Equal(suite.T(), uint(20), hops[0].Relationship.ID)
+	assert.Equal(suite.T(), cycles+1, testutil.ToFloat64(fanOutCycles))
+}
+
+// Test a second path into an entity already reached is skipped without counting a cycle.
+func (suite *RelationshipFanOutTestSuite) TestDiamondNotCycle() {
+	suite.asset(20, 10, dmodel.ENTITY_KIND_AREA, 40)
+	suite.asset(21, 10, dmodel.ENTITY_KIND_ASSET, 11)
+	suite.asset(22, 11, dmodel.ENTITY_KIND_AREA, 40)
+	suite.reload()
+	cycles := testutil.ToFloat64(fanOutCycles)
+
+	hops := suite.walk(buildAssetTrackerRelationship())
+
+	assert.Equal(suite.T(), 2, len(hops))
+	assert.Equal(suite.T(), cycles, testutil.ToFloat64(fanOutCycles))
+}
+
+// Test walking uses cached relationships rather than calling the api.
+func (suite *RelationshipFanOutTestSuite) TestWalkUsesCache() {
+	suite.asset(20, 10, dmodel.ENTITY_KIND_AREA, 40)
+	suite.reload()
+
+	suite.walk(buildAssetTrackerRelationship())
+	suite.walk(buildAssetTrackerRelationship())
+
+	suite.API.Mock.AssertNumberOfCalls(suite.T(), "AssetRelationships", 1)
+	suite.API.Mock.AssertNumberOfCalls(suite.T(), "AreaRelationships", 1)
+}
+
+// Test no relationships are followed when fan-out is disabled.
+func (suite *RelationshipFanOutTestSuite) TestDisabled() {
+	suite.FanOut.SetConfiguration(config.FanOutConfiguration{MaxDepth: 0})
+	suite.asset(20, 10, dmodel.ENTITY_KIND_AREA, 40)
+	suite.reload()
+
+	hops := suite.walk(buildAssetTrackerRelationship())
+
+	assert.Empty(suite.T(), hops)
+}
+
+// Test generated alerts follow the same fanned out relationships as the measurements.
+func (suite *RelationshipFanOutTestSuite) TestGeneratedAlertsFanOut() {
+	rel := dmodel.AssetRelationship{EntityRelationship: buildFanOutTargets(dmodel.ENTITY_KIND_CUSTOMER, 30)}
+	rel.Model = gorm.Model{ID: 20}
+	drel := buildAssetTrackerRelationship()
+	handled, err := suite.Resolver.MergeRelationshipToResolveEvent(buildDevice(), drel, buildMeasurementsEvent(),
+		&dmodel.ResolvedMeasurementsPayload{})
+	assert.Nil(suite.T(), err)
+	fanned := suite.Resolver.FanOutResolvedEvent(*handled, RelationshipHop{
+		SourceKind:   dmodel.ENTITY_KIND_ASSET,
+		Relationship: &rel.EntityRelationship,
+		Depth:        1,
+	})
+
+	results, _, err := suite.Resolver.ResolveGeneratedAlerts(context.Background(), buildDevice(), buildAlertsEvent(),
+		[]EventResolutionResults{*handled, fanned})
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 2, len(results))
+	assert.Nil(suite.T(), results[0].Resolved.FanOut)
+	assert.Equal(suite.T(), uint(30), *results[1].Resolved.TargetCustomerId)
+	assert.Equal(suite.T(), uint(20), results[1].Resolved.FanOut.RelationshipId)
+}
+
+func TestRelationshipFanOutTestSuite(t *testing.T) {
+	suite.Run(t, new(RelationshipFanOutTestSuite))
+}
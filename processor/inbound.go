@@ -20,9 +20,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"io"
 	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/devicechain-io/dc-device-management/config"
 	dmodel "github.com/devicechain-io/dc-device-management/model"
 	"github.com/devicechain-io/dc-device-management/proto"
 	esmodel "github.com/devicechain-io/dc-event-sources/model"
@@ -31,136 +36,592 @@ import (
 	kcore "github.com/devicechain-io/dc-microservice/kafka"
 	"github.com/rs/zerolog/log"
 	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
-	EVENT_RESOLVER_COUNT        = 5   // Number of event resolvers running in parallel
-	KAFKA_BACKLOG_SIZE          = 100 // Number of Kafka messages that can be read and waiting to be processed
-	FAILED_EVENT_BACKLOG_SIZE   = 100 // Number of failed events that can be waiting to push to kafka
-	RESOLVED_EVENT_BACKLOG_SIZE = 100 // Number of resolved events that can be waiting to push to kafka
+	OFFSET_COMMIT_INTERVAL = time.Second
+	THROTTLE_BACKLOG_SIZE  = 100         // Number of throttle events that can be waiting to push to kafka
+	REPLAY_HEADER          = "dc-replay" // Header that marks messages replayed from held or failed events
 )
 
+var (
+	ErrInboundEventsProcessorStopped = errors.New("inbound events processor is not running")
+)
+
+// Failed event waiting to be written along with the inbound message it originated from.
+type outboundFailedEvent struct {
+	Event  dmodel.FailedEvent
+	Source kafka.Message
+}
+
+// Resolved event waiting to be written along with the inbound message it originated from.
+type outboundResolvedEvent struct {
+	Event     dmodel.ResolvedEvent
+	Source    kafka.Message
+	Routes    []string // Additional topics the event is written to
+	OnWritten func()   // Called once the event has been written to the resolved events topic (optional)
+}
+
+// Number of events waiting in a pipeline stage.
+type Backlog struct {
+	Name      string
+	Depth     int
+	Capacity  int
+	Saturated bool // No more events can be queued in at least one channel without blocking
+}
+
+// Channels between pipeline stages. Replaced as a unit when the pipeline is resized.
+type pipelineChannels struct {
+	messages []chan kafka.Message
+	resolved chan outboundResolvedEvent
+	failed   chan outboundFailedEvent
+}
+
+// Snapshot of the state of the inbound event pipeline.
+type PipelineStatus struct {
+	Running        bool
+	StartedAt      time.Time
+	LastResolution time.Time // Zero if no event has been resolved since starting
+	LastProgress   time.Time // Zero if no event has been resolved or failed since starting
+	LastDropped    time.Time // Zero if no failed event has been dropped since starting
+	Backlogs       []Backlog
+}
+
+// Get the backlog for a pipeline stage by name.
+func (status PipelineStatus) Backlog(name string) Backlog {
+	for _, backlog := range status.Backlogs {
+		if backlog.Name == name {
+			return backlog
+		}
+	}
+	return Backlog{Name: name}
+}
+
 type InboundEventsProcessor struct {
 	Microservice         *core.Microservice
 	InboundEventsReader  kcore.KafkaReader
 	ResolvedEventsWriter kcore.KafkaWriter
 	FailedEventsWriter   kcore.KafkaWriter
+	ThrottleEventsWriter kcore.KafkaWriter
+	ReplayEventsWriter   kcore.KafkaWriter // Writes held events back to the inbound topic (optional)
 	Api                  dmodel.DeviceManagementApi
+	Retry                *Retrier
+	Sizing               config.ProcessorConfiguration
+	PipelineStages
 
-	messages  chan kafka.Message
-	failed    chan dmodel.FailedEvent
-	resolved  chan dmodel.ResolvedEvent
+	messages  []chan kafka.Message
+	failed    chan outboundFailedEvent
+	resolved  chan outboundResolvedEvent
+	throttles chan dmodel.ThrottleEvent
 	resolvers []*EventResolver
+	offsets   *OffsetTracker
+
+	stopReading   context.CancelFunc
+	stopCommits   chan struct{}
+	reading       sync.WaitGroup
+	resolving     sync.WaitGroup
+	publishing    sync.WaitGroup
+	writing       sync.WaitGroup
+	committing    sync.WaitGroup
+	dispatchMutex sync.RWMutex
+	ctx           context.Context // Lifecycle context used by resolvers and writers, including after a resize
+	channels      atomic.Value    // Current pipelineChannels, read by health checks without the dispatch lock
+	startedAt     int64           // Unix time in nanoseconds the processor was started (zero until started)
+	stopped       int32           // Set to one once the processor has been stopped
+	lastResolved  int64           // Unix time in nanoseconds of last successful resolution
+	lastProgress  int64           // Unix time in nanoseconds an event was last resolved or failed
+	lastDropped   int64           // Unix time in nanoseconds a failed event was last dropped after write retries
 
 	lifecycle core.LifecycleManager
 }
 
 // Create a new inbound events processor.
 func NewInboundEventsProcessor(ms *core.Microservice, inbound kcore.KafkaReader, resolved kcore.KafkaWriter,
-	failed kcore.KafkaWriter, callbacks core.LifecycleCallbacks, api dmodel.DeviceManagementApi) *InboundEventsProcessor {
+	failed kcore.KafkaWriter, throttle kcore.KafkaWriter, sizing config.ProcessorConfiguration,
+	retry config.RetryConfiguration, stages PipelineStages, callbacks core.LifecycleCallbacks,
+	api dmodel.DeviceManagementApi) *InboundEventsProcessor {
 	iproc := &InboundEventsProcessor{
 		Microservice:         ms,
 		InboundEventsReader:  inbound,
 		ResolvedEventsWriter: resolved,
 		FailedEventsWriter:   failed,
+		ThrottleEventsWriter: throttle,
 		Api:                  api,
+		Retry:                NewRetrier(ms, retry),
+		Sizing:               sizing,
+		PipelineStages:       stages,
+		offsets:              NewOffsetTracker(),
+	}
+	if stages.Limiter != nil {
+		stages.Limiter.OnThrottleChange = iproc.OnThrottleChange
 	}
 
+	initializePipelineMetrics(ms)
+
 	// Create lifecycle manager.
 	ipname := fmt.Sprintf("%s-%s", ms.FunctionalArea, "inbound-event-proc")
 	iproc.lifecycle = core.NewLifecycleManager(ipname, iproc, callbacks)
 	return iproc
 }
 
-// Handle case where event failed to process.
+// Write a batch of failed events.
 func (iproc *InboundEventsProcessor) ProcessFailedEvent(ctx context.Context) bool {
-	failed, more := <-iproc.failed
-	log.Debug().Msg(fmt.Sprintf("received failed event: %s", failed.Message))
-	if more {
-		// Marshal event message to protobuf.
+	batch, more := iproc.nextFailedBatch()
+	if !more {
+		return true
+	}
+	writes := make([]pendingWrite, 0, len(batch))
+	for _, outbound := range batch {
+		failed := outbound.Event
+		log.Debug().Msg(fmt.Sprintf("received failed event: %s", failed.Message))
+
+		// Marshal event message to protobuf. Events that can not be marshaled are dropped rather
+		// than writing an empty message.
 		bytes, err := proto.MarshalFailedEvent(&failed)
 		if err != nil {
-			log.Error().Err(err).Msg("unable to marshal event to protobuf")
+			log.Error().Err(err).Uint("reason", failed.Reason).
+				Msg("unable to marshal failed event to protobuf. dropping event")
+			iproc.offsets.Done(outbound.Source)
+			continue
 		}
-
-		// Create and deliver message.
 		msg := kafka.Message{
 			Key:   []byte(strconv.FormatInt(int64(failed.Reason), 10)),
 			Value: bytes,
 		}
-		err = iproc.FailedEventsWriter.WriteMessages(ctx, msg)
-		iproc.FailedEventsWriter.HandleResponse(err)
-		return false
-	} else {
-		return true
+		InjectTraceContext(ExtractTraceContext(ctx, outbound.Source), &msg)
+		writes = append(writes, pendingWrite{
+			Message: msg,
+			Source:  outbound.Source,
+		})
+	}
+	if len(writes) > 0 {
+		iproc.WriteBatch(ctx, iproc.FailedEventsWriter, writes, iproc.dropUndelivered)
 	}
+	return false
 }
 
 // Called when a message can not be unmarshaled to an event.
 func (iproc *InboundEventsProcessor) OnInvalidEvent(err error, msg kafka.Message) {
 	failed := dmodel.NewFailedEvent(uint(proto.FailureReason_Invalid), iproc.Microservice.FunctionalArea,
 		"message could not be parsed", err, msg.Value)
-	iproc.failed <- *failed
+	recordFailedEvent(failed.Reason, EVENT_TYPE_UNKNOWN)
+	iproc.recordProgress()
+	iproc.failed <- outboundFailedEvent{Event: *failed, Source: msg}
 }
 
 // Called when an event can not be resolved.
-func (iproc *InboundEventsProcessor) OnUnresolvedEvent(reason uint, unrez esmodel.UnresolvedEvent, rezerr error) {
+func (iproc *InboundEventsProcessor) OnUnresolvedEvent(source kafka.Message, reason uint,
+	unrez esmodel.UnresolvedEvent, rezerr error) {
+	recordFailedEvent(reason, unrez.EventType.String())
+	iproc.recordProgress()
+
 	// Marshal event message to protobuf.
 	bytes, err := esproto.MarshalUnresolvedEvent(&unrez)
 	if err != nil {
 		log.Error().Err(err).Msg("unable to marshal unresolved event to protobuf")
+		iproc.offsets.Done(source)
 	} else {
 		failed := dmodel.NewFailedEvent(reason, iproc.Microservice.FunctionalArea,
 			"event could not be resolved", rezerr, bytes)
-		iproc.failed <- *failed
+		iproc.failed <- outboundFailedEvent{Event: *failed, Source: source}
 	}
 }
 
-// Handle case where event was successfully resolved.
+// Write a batch of resolved events.
 func (iproc *InboundEventsProcessor) ProcessResolvedEvent(ctx context.Context) bool {
-	resolved, more := <-iproc.resolved
-	if more {
+	batch, more := iproc.nextResolvedBatch()
+	if !more {
+		return true
+	}
+	writes := make([]pendingWrite, 0, len(batch))
+	routed := make(map[string][]pendingWrite)
+	for _, outbound := range batch {
+		resolved, onWritten := outbound.Event, outbound.OnWritten
 		bytes, err := proto.MarshalResolvedEvent(&resolved)
 		if err != nil {
 			log.Error().Err(err).Msg("unable to marshal resolved event to protobuf")
-		} else {
-			msg := kafka.Message{
-				Key:   []byte(strconv.FormatInt(int64(resolved.SourceDeviceId), 10)),
-				Value: bytes,
+			for i := 0; i <= len(outbound.Routes); i++ {
+				iproc.offsets.Done(outbound.Source)
 			}
-			err = iproc.ResolvedEventsWriter.WriteMessages(ctx, msg)
-			iproc.ResolvedEventsWriter.HandleResponse(err)
+			continue
+		}
+		msg := kafka.Message{
+			Key:   []byte(strconv.FormatInt(int64(resolved.SourceDeviceId), 10)),
+			Value: bytes,
+		}
+		InjectTraceContext(ExtractTraceContext(ctx, outbound.Source), &msg)
+		writes = append(writes, pendingWrite{
+			Message: msg,
+			Source:  outbound.Source,
+			OnWritten: func() {
+				recordResolvedEventPublished(resolved.EventType, resolved.ProcessedTime)
+				if onWritten != nil {
+					onWritten()
+				}
+			},
+		})
+		for _, topic := range outbound.Routes {
+			routed[topic] = append(routed[topic], pendingWrite{Message: msg, Source: outbound.Source})
 		}
-		return false
-	} else {
-		return true
 	}
+	if len(writes) > 0 {
+		iproc.WriteBatch(ctx, iproc.ResolvedEventsWriter, writes, iproc.divertUndelivered)
+	}
+	for topic, pending := range routed {
+		iproc.writeRouted(ctx, topic, pending)
+	}
+	return false
 }
 
-// Called when an event is successfully resolved.
-func (iproc *InboundEventsProcessor) OnResolvedEvent(events []EventResolutionResults) {
+// Write a batch of resolved events to a routed topic. If the topic writer can not be created,
+// the events are skipped so that routing problems do not hold up the resolved events topic.
+func (iproc *InboundEventsProcessor) writeRouted(ctx context.Context, topic string, batch []pendingWrite) {
+	writer, err := iproc.Router.Writer(topic)
+	if err != nil {
+		log.Error().Err(err).Str("topic", topic).Msg(fmt.Sprintf("unable to route %d resolved events", len(batch)))
+		for _, write := range batch {
+			iproc.offsets.Done(write.Source)
+		}
+		return
+	}
+	for i := range batch {
+		batch[i].OnWritten = func() { routedEvents.WithLabelValues(topic).Inc() }
+	}
+	iproc.WriteBatch(ctx, writer, batch, iproc.divertUndelivered)
+}
+
+// Called when an event is successfully resolved. The written callback (if any) is called once
+// every resolved event has been written to the resolved events topic.
+func (iproc *InboundEventsProcessor) OnResolvedEvent(source kafka.Message, events []EventResolutionResults,
+	written func()) {
+	atomic.StoreInt64(&iproc.lastResolved, time.Now().UnixNano())
+	iproc.recordProgress()
+	onWritten := allWritten(len(events), written)
+
+	// Each resolved event must be written to every topic before the source offset can be committed.
+	outbound := make([]outboundResolvedEvent, 0, len(events))
+	writes := 0
 	for _, event := range events {
-		iproc.resolved <- *event.Resolved
+		routes := iproc.Router.Route(event)
+		writes += 1 + len(routes)
+		outbound = append(outbound, outboundResolvedEvent{Event: *event.Resolved, Source: source, Routes: routes,
+			OnWritten: onWritten})
+	}
+	iproc.offsets.Add(source, writes)
+	iproc.offsets.Done(source)
+	for i, event := range events {
+		recordResolvedEvent(event.Resolved)
+		iproc.resolved <- outbound[i]
+	}
+}
+
+// Get a callback to be called as each of a number of writes completes, which calls back once all
+// of them have completed. Calls back immediately if there is nothing to write.
+func allWritten(count int, written func()) func() {
+	if written == nil {
+		return nil
+	}
+	if count == 0 {
+		written()
+		return nil
+	}
+	remaining := int32(count)
+	return func() {
+		if atomic.AddInt32(&remaining, -1) == 0 {
+			written()
+		}
+	}
+}
+
+// Write a throttle state change event.
+func (iproc *InboundEventsProcessor) ProcessThrottleEvent(ctx context.Context) bool {
+	event, more := <-iproc.throttles
+	if !more {
+		return true
+	}
+	bytes, err := proto.MarshalThrottleEvent(&event)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to marshal throttle event to protobuf")
+		return false
+	}
+	err = iproc.ThrottleEventsWriter.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(event.DeviceToken),
+		Value: bytes,
+	})
+	if err != nil {
+		log.Error().Err(err).Msg("unable to write throttle event")
+	}
+	return false
+}
+
+// Called when a device starts or stops being throttled. Throttle events are informational,
+// so they are dropped rather than holding up event resolution if the backlog is full.
+func (iproc *InboundEventsProcessor) OnThrottleChange(event dmodel.ThrottleEvent) {
+	select {
+	case iproc.throttles <- event:
+	default:
+		log.Warn().Str("device", event.DeviceToken).Msg("throttle event backlog full. dropping throttle event")
+	}
+}
+
+// Create a message that marks a payload as replayed, keyed by device token when it can be parsed.
+func replayMessage(payload []byte) kafka.Message {
+	msg := kafka.Message{
+		Value:   payload,
+		Headers: []kafka.Header{{Key: REPLAY_HEADER, Value: []byte("true")}},
+	}
+	if unrez, err := esproto.UnmarshalUnresolvedEvent(payload); err == nil {
+		msg.Key = []byte(unrez.Device)
+	}
+	return msg
+}
+
+// Write events back to the inbound topic so they are resolved like any other event. Events are
+// durable once this returns without error.
+func (iproc *InboundEventsProcessor) RequeueEvents(ctx context.Context, payloads [][]byte) error {
+	if iproc.isStopped() {
+		return ErrInboundEventsProcessorStopped
+	}
+	if iproc.ReplayEventsWriter == nil {
+		return errors.New("no writer is available to requeue events")
+	}
+	msgs := make([]kafka.Message, 0, len(payloads))
+	for _, payload := range payloads {
+		msgs = append(msgs, replayMessage(payload))
+	}
+	return iproc.ReplayEventsWriter.WriteMessages(ctx, msgs...)
+}
+
+// Indicates whether a message was replayed rather than read from the inbound topic.
+func IsReplayed(msg kafka.Message) bool {
+	for _, header := range msg.Headers {
+		if header.Key == REPLAY_HEADER {
+			return true
+		}
+	}
+	return false
+}
+
+// Choose the resolver for a message. Messages are keyed by device token, so all events
+// for a device are handled by the same resolver and stay in order.
+func (iproc *InboundEventsProcessor) ResolverIndex(msg kafka.Message) int {
+	hash := fnv.New32a()
+	hash.Write(msg.Key)
+	return int(hash.Sum32() % uint32(len(iproc.messages)))
+}
+
+// Hand a message to the resolver responsible for its key. Returns false if the processor was stopped.
+func (iproc *InboundEventsProcessor) Dispatch(msg kafka.Message) bool {
+	iproc.dispatchMutex.RLock()
+	defer iproc.dispatchMutex.RUnlock()
+	if iproc.isStopped() {
+		return false
+	}
+	iproc.messages[iproc.ResolverIndex(msg)] <- msg
+	return true
+}
+
+// Resize the resolver pool and backlogs. The pipeline is drained before the new sizing is
+// applied so that per-device ordering is preserved. Inbound messages wait while resizing. The
+// rebuilt resolvers and writers use the lifecycle context of the processor so that they are
+// able to drain when the processor is stopped.
+func (iproc *InboundEventsProcessor) Resize(sizing config.ProcessorConfiguration) error {
+	iproc.dispatchMutex.Lock()
+	defer iproc.dispatchMutex.Unlock()
+	if iproc.isStopped() {
+		return errors.New("unable to resize inbound events processor after it has been stopped")
+	}
+
+	// Drain resolvers and writers.
+	iproc.drainEventResolvers()
+	if iproc.isStarted() {
+		iproc.drainOutboundProcessing()
+	}
+
+	// Recreate pipeline with new sizing.
+	iproc.Sizing = sizing
+	iproc.initializeEventResolvers(iproc.ctx)
+	iproc.initializeOutboundProcessing(iproc.ctx)
+	iproc.publishChannels()
+	if iproc.isStarted() {
+		iproc.startOutboundProcessing(iproc.ctx)
+	}
+	log.Info().Msg(fmt.Sprintf("Resized inbound events processor to %d resolvers", len(iproc.resolvers)))
+	return nil
+}
+
+// Make the current pipeline channels visible to health checks. Callers must hold the dispatch
+// lock or be initializing the processor.
+func (iproc *InboundEventsProcessor) publishChannels() {
+	iproc.channels.Store(pipelineChannels{
+		messages: iproc.messages,
+		resolved: iproc.resolved,
+		failed:   iproc.failed,
+	})
+}
+
+// Get the depth of each pipeline channel. Does not take the dispatch lock, which is held
+// while the pipeline drains, so that health checks never block.
+func (iproc *InboundEventsProcessor) backlogs() []Backlog {
+	channels, _ := iproc.channels.Load().(pipelineChannels)
+	messages := Backlog{Name: "messages"}
+	for _, channel := range channels.messages {
+		messages.Depth += len(channel)
+		messages.Capacity += cap(channel)
+		messages.Saturated = messages.Saturated || isFull(len(channel), cap(channel))
+	}
+	resolved := Backlog{Name: "resolved", Depth: len(channels.resolved), Capacity: cap(channels.resolved)}
+	resolved.Saturated = isFull(resolved.Depth, resolved.Capacity)
+	failed := Backlog{Name: "failed", Depth: len(channels.failed), Capacity: cap(channels.failed)}
+	failed.Saturated = isFull(failed.Depth, failed.Capacity)
+	return []Backlog{messages, resolved, failed}
+}
+
+// Indicates whether a buffered channel is full.
+func isFull(depth int, capacity int) bool {
+	return capacity > 0 && depth >= capacity
+}
+
+// Indicates whether the processor has been started.
+func (iproc *InboundEventsProcessor) isStarted() bool {
+	return atomic.LoadInt64(&iproc.startedAt) > 0
+}
+
+// Indicates whether the processor has been stopped.
+func (iproc *InboundEventsProcessor) isStopped() bool {
+	return atomic.LoadInt32(&iproc.stopped) == 1
+}
+
+// Note that an event has made it through resolution, whether or not it was resolved.
+func (iproc *InboundEventsProcessor) recordProgress() {
+	atomic.StoreInt64(&iproc.lastProgress, time.Now().UnixNano())
+}
+
+// Get a snapshot of pipeline state for health checks. Only reads state that is safe to access
+// without locking so that probes are answered while the pipeline is resizing or blocked.
+func (iproc *InboundEventsProcessor) Status() PipelineStatus {
+	status := PipelineStatus{
+		Running:  iproc.isStarted() && !iproc.isStopped(),
+		Backlogs: iproc.backlogs(),
+	}
+	if started := atomic.LoadInt64(&iproc.startedAt); started > 0 {
+		status.StartedAt = time.Unix(0, started)
+	}
+	if last := atomic.LoadInt64(&iproc.lastResolved); last > 0 {
+		status.LastResolution = time.Unix(0, last)
+	}
+	if last := atomic.LoadInt64(&iproc.lastProgress); last > 0 {
+		status.LastProgress = time.Unix(0, last)
+	}
+	if last := atomic.LoadInt64(&iproc.lastDropped); last > 0 {
+		status.LastDropped = time.Unix(0, last)
+	}
+	return status
+}
+
+// Commit offsets for inbound messages that have been completely processed.
+func (iproc *InboundEventsProcessor) CommitOffsets(ctx context.Context) {
+	committer, ok := iproc.InboundEventsReader.(CommittingKafkaReader)
+	if !ok {
+		return
+	}
+	msgs := iproc.offsets.Committable()
+	if len(msgs) == 0 {
+		return
+	}
+	err := committer.CommitMessages(ctx, msgs...)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to commit inbound event offsets")
+		iproc.offsets.Restore(msgs)
 	}
 }
 
 // Initialize pool of workers for resolving events.
 func (iproc *InboundEventsProcessor) initializeEventResolvers(ctx context.Context) {
-	// Make channels and workers for distributed processing.
-	iproc.messages = make(chan kafka.Message, KAFKA_BACKLOG_SIZE)
+	// Make a channel per worker so that messages with the same key are resolved in order.
+	iproc.messages = make([]chan kafka.Message, 0)
 	iproc.resolvers = make([]*EventResolver, 0)
-	for w := 1; w <= EVENT_RESOLVER_COUNT; w++ {
-		resolver := NewEventResolver(w, iproc.Api, iproc.messages,
+	count := iproc.Sizing.ResolverCount
+	if count < 1 {
+		count = 1
+	}
+	for w := 1; w <= count; w++ {
+		messages := make(chan kafka.Message, iproc.Sizing.InboundBacklogSize)
+		iproc.messages = append(iproc.messages, messages)
+		resolver := NewEventResolver(w, iproc.Api, iproc.Retry, iproc.PipelineStages, messages,
 			iproc.OnInvalidEvent, iproc.OnResolvedEvent, iproc.OnUnresolvedEvent)
 		iproc.resolvers = append(iproc.resolvers, resolver)
-		go resolver.Process(ctx)
+		iproc.resolving.Add(1)
+		go func() {
+			defer iproc.resolving.Done()
+			activeResolvers.Inc()
+			defer activeResolvers.Dec()
+			resolver.Process(ctx)
+		}()
+	}
+}
+
+// Close resolver channels and wait for resolvers to process messages already queued.
+func (iproc *InboundEventsProcessor) drainEventResolvers() {
+	for _, messages := range iproc.messages {
+		close(messages)
 	}
+	iproc.resolving.Wait()
 }
 
 // Initialize outbound processing.
 func (iproc *InboundEventsProcessor) initializeOutboundProcessing(ctx context.Context) {
-	iproc.failed = make(chan dmodel.FailedEvent, FAILED_EVENT_BACKLOG_SIZE)
-	iproc.resolved = make(chan dmodel.ResolvedEvent, RESOLVED_EVENT_BACKLOG_SIZE)
+	iproc.failed = make(chan outboundFailedEvent, iproc.Sizing.FailedBacklogSize)
+	iproc.resolved = make(chan outboundResolvedEvent, iproc.Sizing.ResolvedBacklogSize)
+	iproc.throttles = make(chan dmodel.ThrottleEvent, THROTTLE_BACKLOG_SIZE)
+}
+
+// Start processing loops that write failed and resolved events.
+func (iproc *InboundEventsProcessor) startOutboundProcessing(ctx context.Context) {
+	iproc.writing.Add(2)
+	iproc.publishing.Add(1)
+	// Processing loop for failed events.
+	go func() {
+		defer iproc.writing.Done()
+		for {
+			eof := iproc.ProcessFailedEvent(ctx)
+			if eof {
+				break
+			}
+		}
+	}()
+	// Processing loop for resolved events.
+	go func() {
+		defer iproc.publishing.Done()
+		for {
+			eof := iproc.ProcessResolvedEvent(ctx)
+			if eof {
+				break
+			}
+		}
+	}()
+	// Processing loop for throttle events.
+	go func() {
+		defer iproc.writing.Done()
+		for {
+			eof := iproc.ProcessThrottleEvent(ctx)
+			if eof {
+				break
+			}
+		}
+	}()
+}
+
+// Close outbound channels and wait for queued events to be written. Resolved events are drained
+// first since those that can not be written are sent to failed events.
+func (iproc *InboundEventsProcessor) drainOutboundProcessing() {
+	close(iproc.resolved)
+	iproc.publishing.Wait()
+	close(iproc.failed)
+	close(iproc.throttles)
+	iproc.writing.Wait()
 }
 
 // Initialize component.
@@ -171,10 +632,12 @@ func (iproc *InboundEventsProcessor) Initialize(ctx context.Context) error {
 // Lifecycle callback that runs initialization logic.
 func (iproc *InboundEventsProcessor) ExecuteInitialize(ctx context.Context) error {
 	// Initialize pool of event resolvers.
+	iproc.ctx = ctx
 	iproc.initializeEventResolvers(ctx)
 
 	// Initialize outbound processing channels.
 	iproc.initializeOutboundProcessing(ctx)
+	iproc.publishChannels()
 	return nil
 }
 
@@ -185,44 +648,85 @@ func (iproc *InboundEventsProcessor) Start(ctx context.Context) error {
 
 // Execute primary processing loop. This is done in a goroutine since it runs indefinitely.
 func (iproc *InboundEventsProcessor) ProcessMessage(ctx context.Context) bool {
-	msg, err := iproc.InboundEventsReader.ReadMessage(ctx)
+	var msg kafka.Message
+	var err error
+	if committer, ok := iproc.InboundEventsReader.(CommittingKafkaReader); ok {
+		// Offsets are committed separately once processing completes.
+		msg, err = committer.FetchMessage(ctx)
+		if err == nil {
+			iproc.offsets.Track(msg)
+		}
+	} else {
+		msg, err = iproc.InboundEventsReader.ReadMessage(ctx)
+	}
 	if err != nil {
 		if errors.Is(err, io.EOF) {
 			log.Info().Msg("Detected EOF on inbound events stream")
 			return true
+		} else if errors.Is(err, context.Canceled) {
+			log.Info().Msg("Stopped reading inbound events stream")
+			return true
 		} else {
 			iproc.InboundEventsReader.HandleResponse(err)
 		}
 	} else {
-		iproc.messages <- msg
+		eventsConsumed.Inc()
+		iproc.receive(ctx, msg)
 	}
 	return false
 }
 
+// Start a span for a received message, continuing any trace from the producer, and pass the
+// span context to the resolver in the message headers.
+func (iproc *InboundEventsProcessor) receive(ctx context.Context, msg kafka.Message) {
+	msgctx, span := tracer().Start(ExtractTraceContext(ctx, msg), "inbound-events receive",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "kafka"),
+			attribute.String("messaging.destination", msg.Topic),
+			attribute.Int("messaging.kafka.partition", msg.Partition),
+			attribute.Int64("messaging.kafka.offset", msg.Offset)))
+	defer span.End()
+	InjectTraceContext(msgctx, &msg)
+	iproc.Dispatch(msg)
+}
+
 // Lifecycle callback that runs startup logic.
 func (iproc *InboundEventsProcessor) ExecuteStart(ctx context.Context) error {
-	// Processing loop for failed events.
-	go func() {
-		for {
-			eof := iproc.ProcessFailedEvent(ctx)
-			if eof {
-				break
-			}
-		}
-	}()
-	// Processing loop for resolved events.
+	// Processing loops for outbound events.
+	iproc.dispatchMutex.Lock()
+	iproc.ctx = ctx
+	atomic.StoreInt64(&iproc.startedAt, time.Now().UnixNano())
+	iproc.startOutboundProcessing(ctx)
+	iproc.dispatchMutex.Unlock()
+
+	// Periodically commit offsets for completed messages, sample backlog depth and release
+	// rate limits held for idle devices.
+	iproc.stopCommits = make(chan struct{})
+	iproc.committing.Add(1)
 	go func() {
+		defer iproc.committing.Done()
+		ticker := time.NewTicker(OFFSET_COMMIT_INTERVAL)
+		defer ticker.Stop()
 		for {
-			eof := iproc.ProcessResolvedEvent(ctx)
-			if eof {
-				break
+			select {
+			case <-ticker.C:
+				iproc.CommitOffsets(ctx)
+				iproc.recordBacklogDepth()
+				iproc.Limiter.ExpireIdle(time.Now())
+			case <-iproc.stopCommits:
+				return
 			}
 		}
 	}()
 	// Processing loop for inbound messages.
+	readctx, cancel := context.WithCancel(ctx)
+	iproc.stopReading = cancel
+	iproc.reading.Add(1)
 	go func() {
+		defer iproc.reading.Done()
 		for {
-			eof := iproc.ProcessMessage(ctx)
+			eof := iproc.ProcessMessage(readctx)
 			if eof {
 				break
 			}
@@ -237,10 +741,28 @@ func (iproc *InboundEventsProcessor) Stop(ctx context.Context) error {
 }
 
 // Lifecycle callback that runs shutdown logic.
-func (iproc *InboundEventsProcessor) ExecuteStop(context.Context) error {
-	close(iproc.messages)
-	close(iproc.resolved)
-	close(iproc.failed)
+func (iproc *InboundEventsProcessor) ExecuteStop(ctx context.Context) error {
+	// Stop reading new messages.
+	if iproc.stopReading != nil {
+		iproc.stopReading()
+	}
+	iproc.reading.Wait()
+
+	// Stop periodic work, which may report throttle changes, before outbound channels are closed.
+	if iproc.stopCommits != nil {
+		close(iproc.stopCommits)
+		iproc.committing.Wait()
+	}
+
+	// Let resolvers and writers drain any messages already read.
+	iproc.dispatchMutex.Lock()
+	atomic.StoreInt32(&iproc.stopped, 1)
+	iproc.drainEventResolvers()
+	iproc.drainOutboundProcessing()
+	iproc.dispatchMutex.Unlock()
+
+	// Commit offsets for everything that was written.
+	iproc.CommitOffsets(ctx)
 	return nil
 }
 
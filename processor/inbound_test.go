@@ -19,17 +19,23 @@ package processor
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
+	"strconv"
 	"testing"
 	"time"
 
+	"github.com/devicechain-io/dc-device-management/config"
 	dmodel "github.com/devicechain-io/dc-device-management/model"
+	dmproto "github.com/devicechain-io/dc-device-management/proto"
 	dmtest "github.com/devicechain-io/dc-device-management/test"
 	"github.com/devicechain-io/dc-event-sources/model"
 	esproto "github.com/devicechain-io/dc-event-sources/proto"
 	"github.com/devicechain-io/dc-microservice/core"
+	kcore "github.com/devicechain-io/dc-microservice/kafka"
 	"github.com/devicechain-io/dc-microservice/rdb"
 	test "github.com/devicechain-io/dc-microservice/test"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/rs/zerolog"
 	"github.com/segmentio/kafka-go"
 	"gorm.io/gorm"
@@ -45,6 +51,7 @@ type InboundEventsProcessorTestSuite struct {
 	Inbound  *test.MockKafkaReader
 	Resolved *test.MockKafkaWriter
 	Failed   *test.MockKafkaWriter
+	Throttle *test.MockKafkaWriter
 	API      *dmtest.MockApi
 }
 
@@ -53,12 +60,17 @@ func (suite *InboundEventsProcessorTestSuite) SetupTest() {
 	suite.Inbound = new(test.MockKafkaReader)
 	suite.Resolved = new(test.MockKafkaWriter)
 	suite.Failed = new(test.MockKafkaWriter)
+	suite.Throttle = new(test.MockKafkaWriter)
 	suite.API = new(dmtest.MockApi)
 	suite.IP = NewInboundEventsProcessor(
 		dmtest.DeviceManagementMicroservice,
 		suite.Inbound,
 		suite.Resolved,
 		suite.Failed,
+		suite.Throttle,
+		config.NewProcessorConfiguration(),
+		config.NewRetryConfiguration(),
+		PipelineStages{},
 		core.NewNoOpLifecycleCallbacks(),
 		suite.API)
 	ctx := context.Background()
@@ -114,6 +126,25 @@ func (suite *InboundEventsProcessorTestSuite) TestInvalidEvent() {
 	suite.Failed.AssertCalled(suite.T(), "WriteMessages", mock.Anything, mock.Anything)
 }
 
+// Test pipeline metrics are recorded for consumed, resolved and failed events.
+func (suite *InboundEventsProcessorTestSuite) TestPipelineMetrics() {
+	consumed := testutil.ToFloat64(eventsConsumed)
+	resolved := testutil.ToFloat64(eventsResolved.WithLabelValues(model.Location.String()))
+	invalid := testutil.ToFloat64(eventsFailed.WithLabelValues("Invalid", EVENT_TYPE_UNKNOWN))
+
+	loc := buildLocationsEvent()
+	loc.ProcessedTime = time.Now()
+	bytes, err := esproto.MarshalUnresolvedEvent(loc)
+	assert.Nil(suite.T(), err)
+	suite.SuccessEventFlowFor(kafka.Message{Key: []byte(loc.Device), Value: bytes})
+	suite.IP.OnInvalidEvent(errors.New("bad"), kafka.Message{})
+
+	assert.Equal(suite.T(), consumed+1, testutil.ToFloat64(eventsConsumed))
+	assert.Equal(suite.T(), resolved+1, testutil.ToFloat64(eventsResolved.WithLabelValues(model.Location.String())))
+	assert.Equal(suite.T(), invalid+1, testutil.ToFloat64(eventsFailed.WithLabelValues("Invalid", EVENT_TYPE_UNKNOWN)))
+	assert.GreaterOrEqual(suite.T(), testutil.CollectAndCount(resolutionLatency), 1)
+}
+
 // Build a new assignment event.
 func buildNewAssignmentEvent() *model.UnresolvedEvent {
 	dreltype := "controls"
@@ -235,6 +266,38 @@ func buildDevice() *dmodel.Device {
 	return device
 }
 
+// Build a device relationship.
+func buildDeviceRelationship() *dmodel.DeviceRelationship {
+	rel := &dmodel.DeviceRelationship{
+		EntityRelationship: dmodel.EntityRelationship{
+			Model: gorm.Model{
+				ID:        1,
+				CreatedAt: time.Now(),
+				UpdatedAt: time.Now(),
+			},
+			TokenReference: rdb.TokenReference{
+				Token: "REL-123",
+			},
+		},
+		SourceDeviceId:     1,
+		SourceDevice:       *buildDevice(),
+		RelationshipTypeId: 1,
+	}
+	return rel
+}
+
+// Build search results containing a single device relationship.
+func buildDeviceRelationshipSearchResults() *dmodel.DeviceRelationshipSearchResults {
+	return &dmodel.DeviceRelationshipSearchResults{
+		Results: []dmodel.DeviceRelationship{*buildDeviceRelationship()},
+		Pagination: rdb.SearchResultsPagination{
+			PageStart:    1,
+			PageEnd:      1,
+			TotalRecords: 1,
+		},
+	}
+}
+
 // Test valid location event.
 func (suite *InboundEventsProcessorTestSuite) TestUnresolvableLocationsEvent() {
 	loc := buildLocationsEvent()
@@ -248,7 +311,7 @@ func (suite *InboundEventsProcessorTestSuite) TestUnresolvableLocationsEvent() {
 	// Emulate kafka read/write.
 	suite.Inbound.Mock.On("ReadMessage", mock.Anything).Return(msg, nil)
 	suite.Failed.Mock.On("WriteMessages", mock.Anything, mock.Anything).Return(nil)
-	suite.API.Mock.On("DeviceByToken", mock.Anything, mock.Anything).Return(&dmodel.Device{}, errors.New("not found"))
+	suite.API.Mock.On("DevicesByToken", mock.Anything, mock.Anything).Return([]*dmodel.Device{}, errors.New("not found"))
 
 	// Send message and wait for event to be processed by resolver.
 	ctx := context.Background()
@@ -259,12 +322,27 @@ func (suite *InboundEventsProcessorTestSuite) TestUnresolvableLocationsEvent() {
 	suite.Failed.AssertCalled(suite.T(), "WriteMessages", mock.Anything, mock.Anything)
 }
 
+// Test failed events that can not be marshaled are dropped without writing an empty message.
+func (suite *InboundEventsProcessorTestSuite) TestUnmarshalableFailedEventDropped() {
+	suite.Failed.Mock.On("WriteMessages").Return(nil)
+	failed := dmodel.NewFailedEvent(uint(dmproto.FailureReason_Invalid), "device-management",
+		"message could not be parsed", errors.New("bad byte \xff"), []byte{})
+	suite.IP.failed <- outboundFailedEvent{Event: *failed, Source: kafka.Message{}}
+
+	eof := suite.IP.ProcessFailedEvent(context.Background())
+	assert.False(suite.T(), eof)
+	suite.Failed.AssertNotCalled(suite.T(), "WriteMessages")
+}
+
 // Test valid event flow for a given message.
 func (suite *InboundEventsProcessorTestSuite) SuccessEventFlowFor(msg kafka.Message) {
 	// Emulate kafka read/write.
 	suite.Inbound.Mock.On("ReadMessage", mock.Anything).Return(msg, nil)
 	suite.Resolved.Mock.On("WriteMessages", mock.Anything, mock.Anything).Return(nil)
-	suite.API.Mock.On("DeviceByToken", mock.Anything, mock.Anything).Return(buildDevice(), nil)
+	suite.API.Mock.On("DevicesByToken", mock.Anything, mock.Anything).Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships", mock.Anything, mock.Anything).Return(buildDeviceRelationshipSearchResults(), nil)
+	suite.API.Mock.On("CreateDeviceRelationship", mock.Anything, mock.Anything).Return(buildDeviceRelationship(), nil)
+	suite.API.Mock.On("RecordAlertOccurrence", mock.Anything).Return(&dmodel.Alert{}, true, nil)
 
 	// Send message and wait for event to be processed by resolver.
 	ctx := context.Background()
@@ -319,6 +397,381 @@ func (suite *InboundEventsProcessorTestSuite) TestValidAlertsEvent() {
 	suite.SuccessEventFlowFor(msg)
 }
 
+// Create a processor that reads with explicit offset commits.
+func (suite *InboundEventsProcessorTestSuite) committingProcessor(reader *dmtest.MockCommittingKafkaReader) *InboundEventsProcessor {
+	iproc := NewInboundEventsProcessor(
+		dmtest.DeviceManagementMicroservice,
+		reader,
+		suite.Resolved,
+		suite.Failed,
+		suite.Throttle,
+		config.NewProcessorConfiguration(),
+		config.NewRetryConfiguration(),
+		PipelineStages{},
+		core.NewNoOpLifecycleCallbacks(),
+		suite.API)
+	iproc.Initialize(context.Background())
+	return iproc
+}
+
+// Test inbound offset is only committed after resolved event is written.
+func (suite *InboundEventsProcessorTestSuite) TestCommitAfterWrite() {
+	bytes, err := esproto.MarshalUnresolvedEvent(buildLocationsEvent())
+	assert.Nil(suite.T(), err)
+	msg := kafka.Message{Topic: "inbound-events", Partition: 2, Offset: 42, Value: bytes}
+
+	reader := new(dmtest.MockCommittingKafkaReader)
+	reader.Mock.On("FetchMessage").Return(msg, nil)
+	reader.Mock.On("CommitMessages", mock.Anything).Return(nil)
+	suite.Resolved.Mock.On("WriteMessages", mock.Anything, mock.Anything).Return(nil)
+	suite.API.Mock.On("DevicesByToken", mock.Anything, mock.Anything).Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships", mock.Anything, mock.Anything).Return(buildDeviceRelationshipSearchResults(), nil)
+	iproc := suite.committingProcessor(reader)
+
+	ctx := context.Background()
+	iproc.ProcessMessage(ctx)
+	iproc.CommitOffsets(ctx)
+	reader.AssertNotCalled(suite.T(), "CommitMessages", mock.Anything)
+
+	iproc.ProcessResolvedEvent(ctx)
+	iproc.CommitOffsets(ctx)
+	reader.AssertCalled(suite.T(), "CommitMessages",
+		[]kafka.Message{{Topic: "inbound-events", Partition: 2, Offset: 42}})
+}
+
+// Test inbound offset is released once a failed event can not be written and the loss is reported.
+func (suite *InboundEventsProcessorTestSuite) TestCommitOnWriteFailure() {
+	msg := kafka.Message{Topic: "inbound-events", Partition: 0, Offset: 7, Value: []byte("badvalue")}
+
+	reader := new(dmtest.MockCommittingKafkaReader)
+	reader.Mock.On("FetchMessage").Return(msg, nil)
+	reader.Mock.On("CommitMessages", mock.Anything).Return(nil)
+	suite.Failed.Mock.On("WriteMessages", mock.Anything, mock.Anything).Return(errors.New("broker unavailable"))
+	iproc := suite.committingProcessor(reader)
+	iproc.Retry.MaxRetries = 0
+
+	ctx := context.Background()
+	iproc.ProcessMessage(ctx)
+	iproc.ProcessFailedEvent(ctx)
+	iproc.CommitOffsets(ctx)
+
+	reader.AssertCalled(suite.T(), "CommitMessages", []kafka.Message{{Topic: "inbound-events", Partition: 0, Offset: 7}})
+	assert.Equal(suite.T(), 0, iproc.offsets.Pending())
+	assert.False(suite.T(), iproc.Status().LastDropped.IsZero())
+}
+
+// Test stop drains in-flight events and commits their offsets.
+func (suite *InboundEventsProcessorTestSuite) TestStopDrainsAndCommits() {
+	bytes, err := esproto.MarshalUnresolvedEvent(buildLocationsEvent())
+	assert.Nil(suite.T(), err)
+	msg := kafka.Message{Topic: "inbound-events", Partition: 0, Offset: 3, Value: bytes}
+
+	reader := new(dmtest.MockCommittingKafkaReader)
+	reader.Mock.On("FetchMessage").Return(msg, nil).Once()
+	reader.Mock.On("FetchMessage").Return(kafka.Message{}, io.EOF)
+	reader.Mock.On("CommitMessages", mock.Anything).Return(nil)
+	suite.Resolved.Mock.On("WriteMessages", mock.Anything, mock.Anything).Return(nil)
+	suite.API.Mock.On("DevicesByToken", mock.Anything, mock.Anything).Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships", mock.Anything, mock.Anything).Return(buildDeviceRelationshipSearchResults(), nil)
+	iproc := suite.committingProcessor(reader)
+
+	ctx := context.Background()
+	err = iproc.Start(ctx)
+	assert.Nil(suite.T(), err)
+	err = iproc.Stop(ctx)
+	assert.Nil(suite.T(), err)
+
+	suite.Resolved.AssertCalled(suite.T(), "WriteMessages", mock.Anything, mock.Anything)
+	reader.AssertCalled(suite.T(), "CommitMessages",
+		[]kafka.Message{{Topic: "inbound-events", Partition: 0, Offset: 3}})
+}
+
+// Test messages for the same device are always routed to the same resolver.
+func (suite *InboundEventsProcessorTestSuite) TestResolverRouting() {
+	first := suite.IP.ResolverIndex(kafka.Message{Key: []byte("TEST-123")})
+	for i := 0; i < 10; i++ {
+		assert.Equal(suite.T(), first, suite.IP.ResolverIndex(kafka.Message{Key: []byte("TEST-123")}))
+	}
+
+	indexes := make(map[int]bool)
+	for i := 0; i < 100; i++ {
+		indexes[suite.IP.ResolverIndex(kafka.Message{Key: []byte(fmt.Sprintf("DEVICE-%d", i))})] = true
+	}
+	assert.Equal(suite.T(), suite.IP.Sizing.ResolverCount, len(indexes))
+}
+
+// Test events for a device are resolved in the order they were read.
+func (suite *InboundEventsProcessorTestSuite) TestPerDeviceOrdering() {
+	suite.API.Mock.On("DevicesByToken", mock.Anything, mock.Anything).Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships", mock.Anything, mock.Anything).Return(buildDeviceRelationshipSearchResults(), nil)
+
+	count := 20
+	for i := 0; i < count; i++ {
+		event := buildLocationsEvent()
+		altid := strconv.Itoa(i)
+		event.AltId = &altid
+		bytes, err := esproto.MarshalUnresolvedEvent(event)
+		assert.Nil(suite.T(), err)
+		suite.IP.Dispatch(kafka.Message{Key: []byte(event.Device), Value: bytes})
+	}
+
+	for i := 0; i < count; i++ {
+		outbound := <-suite.IP.resolved
+		assert.Equal(suite.T(), strconv.Itoa(i), *outbound.Event.AltId)
+	}
+}
+
+// Test pipeline status reports saturated backlogs and last resolution time.
+func (suite *InboundEventsProcessorTestSuite) TestStatus() {
+	status := suite.IP.Status()
+	assert.False(suite.T(), status.Running)
+	assert.True(suite.T(), status.LastResolution.IsZero())
+	assert.False(suite.T(), status.Backlog("resolved").Saturated)
+
+	for i := 0; i < cap(suite.IP.resolved); i++ {
+		suite.IP.OnResolvedEvent(kafka.Message{}, []EventResolutionResults{{Resolved: &dmodel.ResolvedEvent{}}}, nil)
+	}
+	status = suite.IP.Status()
+	assert.False(suite.T(), status.LastResolution.IsZero())
+	assert.True(suite.T(), status.Backlog("resolved").Saturated)
+	assert.Equal(suite.T(), cap(suite.IP.resolved), status.Backlog("resolved").Depth)
+	assert.False(suite.T(), status.Backlog("messages").Saturated)
+}
+
+// Test failed events count as pipeline progress even though nothing was resolved.
+func (suite *InboundEventsProcessorTestSuite) TestStatusProgressOnFailure() {
+	assert.True(suite.T(), suite.IP.Status().LastProgress.IsZero())
+
+	suite.IP.OnUnresolvedEvent(kafka.Message{}, uint(dmproto.FailureReason_RateLimited),
+		model.UnresolvedEvent{Device: "TEST-123"}, errors.New("device exceeded inbound event rate limit"))
+	status := suite.IP.Status()
+	assert.True(suite.T(), status.LastResolution.IsZero())
+	assert.False(suite.T(), status.LastProgress.IsZero())
+}
+
+// Test pipeline status is available while the dispatch lock is held for a resize or drain.
+func (suite *InboundEventsProcessorTestSuite) TestStatusWhileLocked() {
+	suite.IP.dispatchMutex.Lock()
+	defer suite.IP.dispatchMutex.Unlock()
+
+	done := make(chan PipelineStatus)
+	go func() { done <- suite.IP.Status() }()
+	select {
+	case status := <-done:
+		assert.Equal(suite.T(), cap(suite.IP.resolved), status.Backlog("resolved").Capacity)
+	case <-time.After(time.Second):
+		suite.T().Fatal("status blocked on dispatch lock")
+	}
+}
+
+// Test throttle state changes are written to the throttle events topic.
+func (suite *InboundEventsProcessorTestSuite) TestThrottleEventWritten() {
+	suite.Throttle.Mock.On("WriteMessages").Return(nil)
+
+	suite.IP.OnThrottleChange(dmodel.ThrottleEvent{DeviceToken: "TEST-123", Throttled: true, OccurredTime: time.Now()})
+	eof := suite.IP.ProcessThrottleEvent(context.Background())
+
+	assert.False(suite.T(), eof)
+	suite.Throttle.AssertNumberOfCalls(suite.T(), "WriteMessages", 1)
+}
+
+// Test resolver pool is resized without losing queued events.
+func (suite *InboundEventsProcessorTestSuite) TestResize() {
+	suite.Resolved.Mock.On("WriteMessages", mock.Anything, mock.Anything).Return(nil)
+	suite.API.Mock.On("DevicesByToken", mock.Anything, mock.Anything).Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships", mock.Anything, mock.Anything).Return(buildDeviceRelationshipSearchResults(), nil)
+	suite.Inbound.Mock.On("ReadMessage", mock.Anything).Return(kafka.Message{}, io.EOF)
+
+	ctx := context.Background()
+	err := suite.IP.Start(ctx)
+	assert.Nil(suite.T(), err)
+
+	bytes, err := esproto.MarshalUnresolvedEvent(buildLocationsEvent())
+	assert.Nil(suite.T(), err)
+	for i := 0; i < 10; i++ {
+		suite.IP.Dispatch(kafka.Message{Key: []byte("TEST-123"), Value: bytes})
+	}
+
+	sizing := config.NewProcessorConfiguration()
+	sizing.ResolverCount = 2
+	sizing.InboundBacklogSize = 10
+	err = suite.IP.Resize(sizing)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 2, len(suite.IP.resolvers))
+	assert.Equal(suite.T(), 10, cap(suite.IP.messages[0]))
+	suite.Resolved.AssertCalled(suite.T(), "WriteMessages", mock.Anything, mock.Anything)
+	assert.Equal(suite.T(), 0, len(suite.IP.resolved))
+
+	err = suite.IP.Stop(ctx)
+	assert.Nil(suite.T(), err)
+	err = suite.IP.Resize(sizing)
+	assert.NotNil(suite.T(), err)
+}
+
+// Test events in flight after a resize are written when the processor is stopped. The writer
+// rejects cancelled contexts, so this fails if the rebuilt pipeline does not use the lifecycle
+// context of the processor.
+func (suite *InboundEventsProcessorTestSuite) TestStopAfterResize() {
+	writer := new(dmtest.MockRecordingKafkaWriter)
+	writer.Mock.On("WriteMessages").Return(nil)
+	suite.IP.ResolvedEventsWriter = writer
+	suite.API.Mock.On("DevicesByToken", mock.Anything, mock.Anything).Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships", mock.Anything, mock.Anything).Return(buildDeviceRelationshipSearchResults(), nil)
+	suite.Inbound.Mock.On("ReadMessage", mock.Anything).Return(kafka.Message{}, io.EOF)
+
+	ctx := context.Background()
+	err := suite.IP.Start(ctx)
+	assert.Nil(suite.T(), err)
+
+	sizing := config.NewProcessorConfiguration()
+	sizing.ResolverCount = 2
+	err = suite.IP.Resize(sizing)
+	assert.Nil(suite.T(), err)
+
+	bytes, err := esproto.MarshalUnresolvedEvent(buildLocationsEvent())
+	assert.Nil(suite.T(), err)
+	for i := 0; i < 10; i++ {
+		suite.IP.Dispatch(kafka.Message{Key: []byte(fmt.Sprintf("TEST-%d", i)), Value: bytes})
+	}
+	err = suite.IP.Stop(ctx)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 10, len(writer.Written))
+}
+
+// Test outbound writers are synchronous and partition messages by key.
+func (suite *InboundEventsProcessorTestSuite) TestSynchronousWriter() {
+	sizing := config.NewProcessorConfiguration()
+	writer := &kcore.DeviceChainKafkaWriter{}
+	writer.Async = true
+	SynchronousWriter(writer, sizing)
+	assert.False(suite.T(), writer.Async)
+	assert.IsType(suite.T(), &kafka.Hash{}, writer.Balancer)
+	assert.Equal(suite.T(), sizing.WriterBatchSize, writer.BatchSize)
+}
+
+// Test events can not be requeued once the processor has been stopped.
+func (suite *InboundEventsProcessorTestSuite) TestRequeueAfterStop() {
+	writer := new(dmtest.MockRecordingKafkaWriter)
+	writer.Mock.On("WriteMessages").Return(nil)
+	suite.IP.ReplayEventsWriter = writer
+	suite.Inbound.Mock.On("ReadMessage", mock.Anything).Return(kafka.Message{}, io.EOF)
+
+	ctx := context.Background()
+	assert.Nil(suite.T(), suite.IP.RequeueEvents(ctx, [][]byte{[]byte("first")}))
+	assert.Equal(suite.T(), 1, len(writer.Written))
+	assert.True(suite.T(), IsReplayed(writer.Written[0]))
+
+	assert.Nil(suite.T(), suite.IP.Start(ctx))
+	assert.Nil(suite.T(), suite.IP.Stop(ctx))
+	err := suite.IP.RequeueEvents(ctx, [][]byte{[]byte("second")})
+	assert.ErrorIs(suite.T(), err, ErrInboundEventsProcessorStopped)
+	assert.Equal(suite.T(), 1, len(writer.Written))
+}
+
+// Test resolved events are accumulated and written in a single call.
+func (suite *InboundEventsProcessorTestSuite) TestBatchedWrites() {
+	suite.Resolved.Mock.On("WriteMessages", mock.Anything, mock.Anything).Return(nil)
+	for i := 0; i < 10; i++ {
+		suite.IP.resolved <- outboundResolvedEvent{Event: dmodel.ResolvedEvent{
+			SourceDeviceId: 1,
+			EventType:      model.Location,
+			Payload:        &dmodel.ResolvedLocationsPayload{},
+		}}
+	}
+
+	eof := suite.IP.ProcessResolvedEvent(context.Background())
+
+	assert.Equal(suite.T(), false, eof)
+	suite.Resolved.AssertNumberOfCalls(suite.T(), "WriteMessages", 1)
+	assert.Equal(suite.T(), 0, len(suite.IP.resolved))
+}
+
+// Build writes for messages fetched from the inbound topic.
+func (suite *InboundEventsProcessorTestSuite) trackedWrites(count int) []pendingWrite {
+	writes := make([]pendingWrite, 0)
+	for i := 0; i < count; i++ {
+		source := kafka.Message{Topic: "inbound-events", Partition: 0, Offset: int64(i), Value: []byte(fmt.Sprintf("source-%d", i))}
+		suite.IP.offsets.Track(source)
+		writes = append(writes, pendingWrite{Message: kafka.Message{Value: []byte(fmt.Sprintf("event-%d", i))}, Source: source})
+	}
+	return writes
+}
+
+// Use a retry budget suitable for write tests.
+func (suite *InboundEventsProcessorTestSuite) writeRetries(max int) {
+	suite.IP.Retry = NewRetrier(dmtest.DeviceManagementMicroservice, config.RetryConfiguration{
+		MaxRetries:       max,
+		InitialBackoffMs: 1,
+		MaxBackoffMs:     2,
+	})
+}
+
+// Get the values of written messages in order.
+func writtenValues(msgs []kafka.Message) []string {
+	values := make([]string, 0)
+	for _, msg := range msgs {
+		values = append(values, string(msg.Value))
+	}
+	return values
+}
+
+// Test a partly failed batch is written again from the first failed message.
+func (suite *InboundEventsProcessorTestSuite) TestBatchPartialFailure() {
+	suite.writeRetries(2)
+	writer := new(dmtest.MockRecordingKafkaWriter)
+	writer.Mock.On("WriteMessages").Return(kafka.WriteErrors{nil, errors.New("leader not available"), nil}).Once()
+	writer.Mock.On("WriteMessages").Return(nil)
+
+	suite.IP.WriteBatch(context.Background(), writer, suite.trackedWrites(3), suite.IP.dropUndelivered)
+
+	writer.AssertNumberOfCalls(suite.T(), "WriteMessages", 2)
+	assert.Equal(suite.T(), []string{"event-0", "event-2", "event-1", "event-2"}, writtenValues(writer.Written))
+	assert.Equal(suite.T(), 0, suite.IP.offsets.Pending())
+}
+
+// Test messages are handed off and their offsets released once write retries are exhausted.
+func (suite *InboundEventsProcessorTestSuite) TestBatchRetriesExhausted() {
+	suite.writeRetries(2)
+	suite.Resolved.Mock.On("WriteMessages", mock.Anything, mock.Anything).
+		Return(kafka.WriteErrors{errors.New("message too large"), nil})
+	undelivered := make([]string, 0)
+
+	suite.IP.WriteBatch(context.Background(), suite.Resolved, suite.trackedWrites(2),
+		func(write pendingWrite, err error) { undelivered = append(undelivered, string(write.Message.Value)) })
+
+	suite.Resolved.AssertNumberOfCalls(suite.T(), "WriteMessages", 3)
+	assert.Equal(suite.T(), []string{"event-0"}, undelivered)
+	assert.Equal(suite.T(), 0, suite.IP.offsets.Pending())
+	assert.Equal(suite.T(), []kafka.Message{{Topic: "inbound-events", Partition: 0, Offset: 1}},
+		suite.IP.offsets.Committable())
+}
+
+// Test resolved events that can not be written are sent to failed events.
+func (suite *InboundEventsProcessorTestSuite) TestUndeliveredDiverted() {
+	suite.writeRetries(0)
+	suite.Resolved.Mock.On("WriteMessages", mock.Anything, mock.Anything).Return(errors.New("message too large"))
+
+	suite.IP.WriteBatch(context.Background(), suite.Resolved, suite.trackedWrites(1), suite.IP.divertUndelivered)
+
+	assert.Equal(suite.T(), 1, len(suite.IP.failed))
+	assert.Equal(suite.T(), 1, suite.IP.offsets.Pending())
+	failed := <-suite.IP.failed
+	assert.Equal(suite.T(), []byte("source-0"), failed.Event.Payload)
+	suite.IP.offsets.Done(failed.Source)
+	assert.Equal(suite.T(), 0, suite.IP.offsets.Pending())
+}
+
+// Test dropped failed events are reported in pipeline status.
+func (suite *InboundEventsProcessorTestSuite) TestUndeliveredDropped() {
+	suite.writeRetries(0)
+	suite.Failed.Mock.On("WriteMessages", mock.Anything, mock.Anything).Return(errors.New("message too large"))
+
+	suite.IP.WriteBatch(context.Background(), suite.Failed, suite.trackedWrites(1), suite.IP.dropUndelivered)
+
+	assert.False(suite.T(), suite.IP.Status().LastDropped.IsZero())
+	assert.Equal(suite.T(), 0, suite.IP.offsets.Pending())
+}
+
 // Run all tests.
 func TestInboundEventsProcessorTestSuite(t *testing.T) {
 	zerolog.SetGlobalLevel(zerolog.Disabled)
@@ -0,0 +1,104 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	dmodel "github.com/devicechain-io/dc-device-management/model"
+	"github.com/devicechain-io/dc-device-management/proto"
+	esmodel "github.com/devicechain-io/dc-event-sources/model"
+	"github.com/devicechain-io/dc-microservice/core"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	EVENT_TYPE_UNKNOWN = "Unknown" // Event type label used when a message can not be parsed
+)
+
+var (
+	pipelineMetricsOnce sync.Once
+	eventsConsumed      prometheus.Counter
+	eventsResolved      *prometheus.CounterVec
+	eventsFailed        *prometheus.CounterVec
+	resolutionLatency   *prometheus.HistogramVec
+	backlogDepth        *prometheus.GaugeVec
+	activeResolvers     prometheus.Gauge
+	eventsUndelivered   *prometheus.CounterVec
+)
+
+// Create a new histogram vector with the namespace and subsystem filled in the same way as
+// other microservice metrics.
+func newHistogramVec(ms *core.Microservice, name string, help string, buckets []float64,
+	labels []string) *prometheus.HistogramVec {
+	return promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: core.METRICS_NAMESPACE,
+		Subsystem: strings.ReplaceAll(ms.FunctionalArea, "-", ""),
+		Name:      name,
+		Help:      help,
+		Buckets:   buckets,
+	}, labels)
+}
+
+// Register metrics for the inbound event pipeline. These are served on the metrics endpoint.
+func initializePipelineMetrics(ms *core.Microservice) {
+	pipelineMetricsOnce.Do(func() {
+		eventsConsumed = ms.NewCounter("events_consumed_total",
+			"Number of messages consumed from the inbound events topic", []string{})
+		eventsResolved = ms.NewCounterVec("events_resolved_total",
+			"Number of resolved events by event type", []string{"event_type"})
+		eventsFailed = ms.NewCounterVec("events_failed_total",
+			"Number of events that failed resolution by reason and event type", []string{"reason", "event_type"})
+		resolutionLatency = newHistogramVec(ms, "event_resolution_latency_seconds",
+			"Time from event being processed by the event source until the resolved event is published",
+			prometheus.ExponentialBuckets(0.005, 2, 14), []string{"event_type"})
+		backlogDepth = ms.NewGaugeVec("pipeline_backlog_depth",
+			"Number of events waiting in each stage of the pipeline", []string{"channel"})
+		activeResolvers = ms.NewGauge("active_event_resolvers",
+			"Number of event resolvers currently running", []string{})
+		eventsUndelivered = ms.NewCounterVec("events_undelivered_total",
+			"Number of outbound events not written after exhausting retries by disposition", []string{"disposition"})
+	})
+}
+
+// Record a resolved event.
+func recordResolvedEvent(event *dmodel.ResolvedEvent) {
+	eventsResolved.WithLabelValues(event.EventType.String()).Inc()
+}
+
+// Record that a resolved event was published.
+func recordResolvedEventPublished(eventType esmodel.EventType, processed time.Time) {
+	if processed.IsZero() {
+		return
+	}
+	resolutionLatency.WithLabelValues(eventType.String()).Observe(time.Since(processed).Seconds())
+}
+
+// Record an event that failed resolution.
+func recordFailedEvent(reason uint, eventType string) {
+	eventsFailed.WithLabelValues(proto.FailureReason(reason).String(), eventType).Inc()
+}
+
+// Sample the number of events waiting in pipeline channels.
+func (iproc *InboundEventsProcessor) recordBacklogDepth() {
+	for _, backlog := range iproc.backlogs() {
+		backlogDepth.WithLabelValues(backlog.Name).Set(float64(backlog.Depth))
+	}
+}
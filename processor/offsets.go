@@ -0,0 +1,166 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"context"
+	"sync"
+
+	kcore "github.com/devicechain-io/dc-microservice/kafka"
+	"github.com/segmentio/kafka-go"
+)
+
+// Reader that allows messages to be fetched without committing offsets.
+type CommittingKafkaReader interface {
+	kcore.KafkaReader
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+}
+
+// Identifies a partition of a topic.
+type topicPartition struct {
+	Topic     string
+	Partition int
+}
+
+// Offsets fetched for a partition in the order they were received.
+type partitionOffsets struct {
+	order       []int64
+	outstanding map[int64]int
+}
+
+// Tracks outstanding work for fetched messages so that offsets are only committed once
+// all writes for a message (and every message before it in the partition) are acknowledged.
+type OffsetTracker struct {
+	mutex      sync.Mutex
+	partitions map[topicPartition]*partitionOffsets
+	ready      map[topicPartition]int64
+}
+
+// Create a new offset tracker.
+func NewOffsetTracker() *OffsetTracker {
+	return &OffsetTracker{
+		partitions: make(map[topicPartition]*partitionOffsets),
+		ready:      make(map[topicPartition]int64),
+	}
+}
+
+// Replayed messages are not read from a topic and have no offset to commit.
+func isTracked(msg kafka.Message) bool {
+	return msg.Topic != ""
+}
+
+// Start tracking a fetched message with a single outstanding unit of work.
+func (ot *OffsetTracker) Track(msg kafka.Message) {
+	if !isTracked(msg) {
+		return
+	}
+	ot.mutex.Lock()
+	defer ot.mutex.Unlock()
+
+	tp := topicPartition{Topic: msg.Topic, Partition: msg.Partition}
+	poffs, ok := ot.partitions[tp]
+	if !ok {
+		poffs = &partitionOffsets{
+			order:       make([]int64, 0),
+			outstanding: make(map[int64]int),
+		}
+		ot.partitions[tp] = poffs
+	}
+	poffs.order = append(poffs.order, msg.Offset)
+	poffs.outstanding[msg.Offset] = 1
+}
+
+// Add units of outstanding work for a message.
+func (ot *OffsetTracker) Add(msg kafka.Message, count int) {
+	if !isTracked(msg) || count == 0 {
+		return
+	}
+	ot.mutex.Lock()
+	defer ot.mutex.Unlock()
+
+	tp := topicPartition{Topic: msg.Topic, Partition: msg.Partition}
+	if poffs, ok := ot.partitions[tp]; ok {
+		if _, ok := poffs.outstanding[msg.Offset]; ok {
+			poffs.outstanding[msg.Offset] += count
+		}
+	}
+}
+
+// Mark a unit of work for a message as complete.
+func (ot *OffsetTracker) Done(msg kafka.Message) {
+	if !isTracked(msg) {
+		return
+	}
+	ot.mutex.Lock()
+	defer ot.mutex.Unlock()
+
+	tp := topicPartition{Topic: msg.Topic, Partition: msg.Partition}
+	poffs, ok := ot.partitions[tp]
+	if !ok {
+		return
+	}
+	if _, ok := poffs.outstanding[msg.Offset]; !ok {
+		return
+	}
+	poffs.outstanding[msg.Offset]--
+
+	// Advance over all leading messages with no outstanding work.
+	for len(poffs.order) > 0 && poffs.outstanding[poffs.order[0]] <= 0 {
+		ot.ready[tp] = poffs.order[0]
+		delete(poffs.outstanding, poffs.order[0])
+		poffs.order = poffs.order[1:]
+	}
+}
+
+// Number of tracked messages with outstanding work.
+func (ot *OffsetTracker) Pending() int {
+	ot.mutex.Lock()
+	defer ot.mutex.Unlock()
+
+	pending := 0
+	for _, poffs := range ot.partitions {
+		pending += len(poffs.order)
+	}
+	return pending
+}
+
+// Get messages marking the latest committable offset for each partition.
+func (ot *OffsetTracker) Committable() []kafka.Message {
+	ot.mutex.Lock()
+	defer ot.mutex.Unlock()
+
+	msgs := make([]kafka.Message, 0)
+	for tp, offset := range ot.ready {
+		msgs = append(msgs, kafka.Message{Topic: tp.Topic, Partition: tp.Partition, Offset: offset})
+	}
+	ot.ready = make(map[topicPartition]int64)
+	return msgs
+}
+
+// Return offsets which could not be committed so they are retried later.
+func (ot *OffsetTracker) Restore(msgs []kafka.Message) {
+	ot.mutex.Lock()
+	defer ot.mutex.Unlock()
+
+	for _, msg := range msgs {
+		tp := topicPartition{Topic: msg.Topic, Partition: msg.Partition}
+		if offset, ok := ot.ready[tp]; !ok || offset < msg.Offset {
+			ot.ready[tp] = msg.Offset
+		}
+	}
+}
@@ -0,0 +1,108 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type OffsetTrackerTestSuite struct {
+	suite.Suite
+	Tracker *OffsetTracker
+}
+
+// Perform common setup tasks.
+func (suite *OffsetTrackerTestSuite) SetupTest() {
+	suite.Tracker = NewOffsetTracker()
+}
+
+// Build a message fetched from the inbound topic.
+func fetchedMessage(partition int, offset int64) kafka.Message {
+	return kafka.Message{Topic: "inbound-events", Partition: partition, Offset: offset}
+}
+
+// Test offsets are only committable once all earlier messages complete.
+func (suite *OffsetTrackerTestSuite) TestOutOfOrderCompletion() {
+	for offset := int64(10); offset < 13; offset++ {
+		suite.Tracker.Track(fetchedMessage(0, offset))
+	}
+
+	suite.Tracker.Done(fetchedMessage(0, 11))
+	assert.Empty(suite.T(), suite.Tracker.Committable())
+
+	suite.Tracker.Done(fetchedMessage(0, 10))
+	committable := suite.Tracker.Committable()
+	assert.Equal(suite.T(), 1, len(committable))
+	assert.Equal(suite.T(), int64(11), committable[0].Offset)
+	assert.Equal(suite.T(), 1, suite.Tracker.Pending())
+}
+
+// Test message is not complete until all of its writes are acknowledged.
+func (suite *OffsetTrackerTestSuite) TestMultipleWrites() {
+	msg := fetchedMessage(0, 5)
+	suite.Tracker.Track(msg)
+	suite.Tracker.Add(msg, 2)
+	suite.Tracker.Done(msg)
+	suite.Tracker.Done(msg)
+	assert.Empty(suite.T(), suite.Tracker.Committable())
+
+	suite.Tracker.Done(msg)
+	committable := suite.Tracker.Committable()
+	assert.Equal(suite.T(), 1, len(committable))
+	assert.Equal(suite.T(), int64(5), committable[0].Offset)
+}
+
+// Test partitions are tracked independently.
+func (suite *OffsetTrackerTestSuite) TestPartitions() {
+	suite.Tracker.Track(fetchedMessage(0, 1))
+	suite.Tracker.Track(fetchedMessage(1, 7))
+	suite.Tracker.Done(fetchedMessage(1, 7))
+
+	committable := suite.Tracker.Committable()
+	assert.Equal(suite.T(), 1, len(committable))
+	assert.Equal(suite.T(), 1, committable[0].Partition)
+	assert.Equal(suite.T(), int64(7), committable[0].Offset)
+}
+
+// Test replayed messages are not tracked.
+func (suite *OffsetTrackerTestSuite) TestReplayedMessagesIgnored() {
+	msg := kafka.Message{Value: []byte("replayed")}
+	suite.Tracker.Track(msg)
+	suite.Tracker.Done(msg)
+
+	assert.Equal(suite.T(), 0, suite.Tracker.Pending())
+	assert.Empty(suite.T(), suite.Tracker.Committable())
+}
+
+// Test offsets that failed to commit are retried.
+func (suite *OffsetTrackerTestSuite) TestRestore() {
+	suite.Tracker.Track(fetchedMessage(0, 3))
+	suite.Tracker.Done(fetchedMessage(0, 3))
+	failed := suite.Tracker.Committable()
+
+	suite.Tracker.Restore(failed)
+	assert.Equal(suite.T(), failed, suite.Tracker.Committable())
+}
+
+// Run all tests.
+func TestOffsetTrackerTestSuite(t *testing.T) {
+	suite.Run(t, new(OffsetTrackerTestSuite))
+}
@@ -0,0 +1,292 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"container/list"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/devicechain-io/dc-device-management/config"
+	"github.com/devicechain-io/dc-device-management/model"
+	"github.com/devicechain-io/dc-microservice/core"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+)
+
+var (
+	rateLimitMetricsOnce sync.Once
+	rateLimitedEvents    prometheus.Counter
+)
+
+// Limit on the rate of inbound events for a device. A rate of zero or less is unlimited.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// Indicates whether the limit allows an unlimited rate.
+func (limit RateLimit) Unlimited() bool {
+	return limit.Rate <= 0
+}
+
+// Get the burst size, which must allow at least one event when a rate is set.
+func (limit RateLimit) burst() int {
+	if limit.Burst > 0 {
+		return limit.Burst
+	}
+	return int(math.Max(1, math.Ceil(limit.Rate)))
+}
+
+// Get the token bucket limit.
+func (limit RateLimit) limit() rate.Limit {
+	if limit.Unlimited() {
+		return rate.Inf
+	}
+	return rate.Limit(limit.Rate)
+}
+
+// Token bucket for a single device along with its throttle state.
+type tokenBucket struct {
+	token     string
+	limit     RateLimit
+	limiter   *rate.Limiter
+	throttled bool
+	dropped   uint64
+	seen      time.Time
+}
+
+// Enforces token bucket rate limits on inbound events for each device. Buckets for devices that
+// have been idle longer than the idle timeout are removed, and the least recently seen buckets are
+// removed once there are more than the maximum number of buckets.
+type RateLimiter struct {
+	OnThrottleChange func(model.ThrottleEvent)
+
+	mutex        sync.Mutex
+	defaultLimit RateLimit
+	idleTimeout  time.Duration
+	maxBuckets   int
+	buckets      map[string]*list.Element
+	order        *list.List
+}
+
+// Create a new rate limiter.
+func NewRateLimiter(ms *core.Microservice, cfg config.RateLimitConfiguration) *RateLimiter {
+	rateLimitMetricsOnce.Do(func() {
+		rateLimitedEvents = ms.NewCounter("rate_limited_events_total",
+			"Number of inbound events dropped for exceeding device rate limits", []string{})
+	})
+	return &RateLimiter{
+		defaultLimit: RateLimit{Rate: cfg.DefaultRate, Burst: cfg.DefaultBurst},
+		idleTimeout:  time.Duration(cfg.IdleTimeoutMs) * time.Millisecond,
+		maxBuckets:   cfg.MaxDevices,
+		buckets:      make(map[string]*list.Element),
+		order:        list.New(),
+	}
+}
+
+// Update the limit used for devices that do not have one set on the device or device type.
+func (rl *RateLimiter) SetDefault(cfg config.RateLimitConfiguration) {
+	if rl == nil {
+		return
+	}
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+	rl.defaultLimit = RateLimit{Rate: cfg.DefaultRate, Burst: cfg.DefaultBurst}
+	rl.idleTimeout = time.Duration(cfg.IdleTimeoutMs) * time.Millisecond
+	rl.maxBuckets = cfg.MaxDevices
+}
+
+// Get the limit that applies to a device. Values set on the device override those set on
+// its device type, which override the configured default.
+func (rl *RateLimiter) LimitFor(device *model.Device) RateLimit {
+	rl.mutex.Lock()
+	limit := rl.defaultLimit
+	rl.mutex.Unlock()
+
+	if dtype := device.DeviceType; dtype != nil {
+		if dtype.RateLimit.Valid {
+			limit.Rate = dtype.RateLimit.Float64
+		}
+		if dtype.RateBurst.Valid {
+			limit.Burst = int(dtype.RateBurst.Int64)
+		}
+	}
+	if device.RateLimit.Valid {
+		limit.Rate = device.RateLimit.Float64
+	}
+	if device.RateBurst.Valid {
+		limit.Burst = int(device.RateBurst.Int64)
+	}
+	return limit
+}
+
+// Get the bucket for a device if one exists, marking it as recently seen.
+func (rl *RateLimiter) bucket(token string, now time.Time) *tokenBucket {
+	elem, ok := rl.buckets[token]
+	if !ok {
+		return nil
+	}
+	bucket := elem.Value.(*tokenBucket)
+	bucket.seen = now
+	rl.order.MoveToFront(elem)
+	return bucket
+}
+
+// Create a bucket for a device with the given limit. Creating a bucket may evict the least
+// recently seen buckets, in which case events are returned for any that were throttled.
+func (rl *RateLimiter) addBucket(token string, limit RateLimit, now time.Time) (*tokenBucket, []model.ThrottleEvent) {
+	bucket := &tokenBucket{
+		token:   token,
+		limit:   limit,
+		limiter: rate.NewLimiter(limit.limit(), limit.burst()),
+		seen:    now,
+	}
+	rl.buckets[token] = rl.order.PushFront(bucket)
+	changes := make([]model.ThrottleEvent, 0)
+	for rl.maxBuckets > 0 && rl.order.Len() > rl.maxBuckets {
+		if change := rl.remove(rl.order.Back()); change != nil {
+			changes = append(changes, *change)
+		}
+	}
+	return bucket, changes
+}
+
+// Remove a bucket. A bucket that was throttled is released, and the event for the change is returned.
+func (rl *RateLimiter) remove(elem *list.Element) *model.ThrottleEvent {
+	bucket := elem.Value.(*tokenBucket)
+	rl.order.Remove(elem)
+	delete(rl.buckets, bucket.token)
+	if !bucket.throttled {
+		return nil
+	}
+	bucket.throttled = false
+	return bucket.throttleEvent()
+}
+
+// Report changes in throttle state to the callback.
+func (rl *RateLimiter) notify(changes []model.ThrottleEvent) {
+	if rl.OnThrottleChange == nil {
+		return
+	}
+	for _, change := range changes {
+		rl.OnThrottleChange(change)
+	}
+}
+
+// Remove buckets for devices that have not sent events within the idle timeout. Devices that were
+// throttled are reported as no longer throttled, since no further events will do so.
+func (rl *RateLimiter) ExpireIdle(now time.Time) {
+	if rl == nil {
+		return
+	}
+	rl.mutex.Lock()
+	changes := make([]model.ThrottleEvent, 0)
+	for rl.idleTimeout > 0 && rl.order.Len() > 0 {
+		oldest := rl.order.Back()
+		if now.Sub(oldest.Value.(*tokenBucket).seen) < rl.idleTimeout {
+			break
+		}
+		if change := rl.remove(oldest); change != nil {
+			changes = append(changes, *change)
+		}
+	}
+	rl.mutex.Unlock()
+	rl.notify(changes)
+}
+
+// Number of devices with buckets.
+func (rl *RateLimiter) Tracked() int {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+	return rl.order.Len()
+}
+
+// Apply the limit for a device once it has been looked up. Buckets are only created here so
+// that tokens which do not belong to a device can not displace buckets for real devices. The
+// event that led to the lookup is counted against a new bucket since it was allowed without one.
+func (rl *RateLimiter) Configure(device *model.Device) {
+	if rl == nil {
+		return
+	}
+	limit := rl.LimitFor(device)
+
+	now := time.Now()
+	rl.mutex.Lock()
+	var changes []model.ThrottleEvent
+	bucket := rl.bucket(device.Token, now)
+	if bucket == nil {
+		bucket, changes = rl.addBucket(device.Token, limit, now)
+		bucket.limiter.AllowN(now, 1)
+	} else if bucket.limit != limit {
+		bucket.limit = limit
+		bucket.limiter.SetLimitAt(now, limit.limit())
+		bucket.limiter.SetBurstAt(now, limit.burst())
+	}
+	rl.mutex.Unlock()
+	rl.notify(changes)
+}
+
+// Check whether an event for a device is allowed. Events are allowed for devices without a
+// bucket, since the device has not been looked up yet. Changes in throttle state are reported
+// to the callback so that they may be used for alerting.
+func (rl *RateLimiter) Allow(token string) bool {
+	if rl == nil {
+		return true
+	}
+	now := time.Now()
+	rl.mutex.Lock()
+	bucket := rl.bucket(token, now)
+	if bucket == nil {
+		rl.mutex.Unlock()
+		return true
+	}
+	changes := make([]model.ThrottleEvent, 0)
+	allowed := bucket.limiter.AllowN(now, 1)
+
+	if !allowed {
+		bucket.dropped++
+		if !bucket.throttled {
+			bucket.throttled = true
+			changes = append(changes, *bucket.throttleEvent())
+		}
+	} else if bucket.throttled {
+		bucket.throttled = false
+		changes = append(changes, *bucket.throttleEvent())
+		bucket.dropped = 0
+	}
+	rl.mutex.Unlock()
+
+	if !allowed {
+		rateLimitedEvents.Inc()
+	}
+	rl.notify(changes)
+	return allowed
+}
+
+// Create an event describing the current throttle state.
+func (bucket *tokenBucket) throttleEvent() *model.ThrottleEvent {
+	return &model.ThrottleEvent{
+		DeviceToken:  bucket.token,
+		Throttled:    bucket.throttled,
+		Rate:         bucket.limit.Rate,
+		Burst:        bucket.limit.burst(),
+		Dropped:      bucket.dropped,
+		OccurredTime: time.Now(),
+	}
+}
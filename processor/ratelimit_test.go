@@ -0,0 +1,211 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/devicechain-io/dc-device-management/config"
+	dmodel "github.com/devicechain-io/dc-device-management/model"
+	dmproto "github.com/devicechain-io/dc-device-management/proto"
+	dmtest "github.com/devicechain-io/dc-device-management/test"
+	esmodel "github.com/devicechain-io/dc-event-sources/model"
+	esproto "github.com/devicechain-io/dc-event-sources/proto"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type RateLimitTestSuite struct {
+	suite.Suite
+	API     *dmtest.MockApi
+	Limiter *RateLimiter
+	Changes []dmodel.ThrottleEvent
+}
+
+// Perform common setup tasks.
+func (suite *RateLimitTestSuite) SetupTest() {
+	suite.API = new(dmtest.MockApi)
+	suite.Limiter = NewRateLimiter(dmtest.DeviceManagementMicroservice,
+		config.RateLimitConfiguration{DefaultRate: 0.001, DefaultBurst: 2, IdleTimeoutMs: 60000, MaxDevices: 3})
+	suite.Changes = make([]dmodel.ThrottleEvent, 0)
+	suite.Limiter.OnThrottleChange = func(event dmodel.ThrottleEvent) {
+		suite.Changes = append(suite.Changes, event)
+	}
+}
+
+// Run messages through a resolver and collect failure reasons.
+func (suite *RateLimitTestSuite) resolve(msgs ...kafka.Message) (int, []uint) {
+	unrez := make(chan kafka.Message, len(msgs))
+	for _, msg := range msgs {
+		unrez <- msg
+	}
+	close(unrez)
+
+	resolved := 0
+	reasons := make([]uint, 0)
+	rez := NewEventResolver(1, suite.API, NewRetrier(dmtest.DeviceManagementMicroservice, config.RetryConfiguration{}),
+		PipelineStages{Limiter: suite.Limiter}, unrez, nil,
+		func(msg kafka.Message, results []EventResolutionResults, written func()) { resolved += len(results) },
+		func(msg kafka.Message, reason uint, event esmodel.UnresolvedEvent, err error) {
+			reasons = append(reasons, reason)
+		})
+	rez.Process(context.Background())
+	return resolved, reasons
+}
+
+// Build a message for a locations event.
+func (suite *RateLimitTestSuite) locationsMessage() kafka.Message {
+	bytes, err := esproto.MarshalUnresolvedEvent(buildLocationsEvent())
+	assert.Nil(suite.T(), err)
+	return kafka.Message{Key: []byte("TEST-123"), Value: bytes}
+}
+
+// Configure the limiter for a device as if it had been looked up.
+func (suite *RateLimitTestSuite) configure(token string) *dmodel.Device {
+	device := buildDevice()
+	device.Token = token
+	suite.Limiter.Configure(device)
+	return device
+}
+
+// Test events over the burst are throttled and state changes are reported.
+func (suite *RateLimitTestSuite) TestThrottleTransitions() {
+	assert.True(suite.T(), suite.Limiter.Allow("dev"))
+	device := suite.configure("dev")
+	assert.True(suite.T(), suite.Limiter.Allow("dev"))
+	assert.False(suite.T(), suite.Limiter.Allow("dev"))
+	assert.False(suite.T(), suite.Limiter.Allow("dev"))
+	assert.Equal(suite.T(), 1, len(suite.Changes))
+	assert.True(suite.T(), suite.Changes[0].Throttled)
+	assert.Equal(suite.T(), uint64(1), suite.Changes[0].Dropped)
+
+	// Removing the limit ends throttling and reports the number of events dropped.
+	device.RateLimit = sql.NullFloat64{Float64: 0, Valid: true}
+	suite.Limiter.Configure(device)
+	assert.True(suite.T(), suite.Limiter.Allow("dev"))
+	assert.Equal(suite.T(), 2, len(suite.Changes))
+	assert.False(suite.T(), suite.Changes[1].Throttled)
+	assert.Equal(suite.T(), uint64(2), suite.Changes[1].Dropped)
+}
+
+// Test device values override device type values, which override the default.
+func (suite *RateLimitTestSuite) TestLimitPrecedence() {
+	device := buildDevice()
+	assert.Equal(suite.T(), RateLimit{Rate: 0.001, Burst: 2}, suite.Limiter.LimitFor(device))
+
+	device.DeviceType = &dmodel.DeviceType{}
+	device.DeviceType.RateLimit = sql.NullFloat64{Float64: 10, Valid: true}
+	device.DeviceType.RateBurst = sql.NullInt64{Int64: 20, Valid: true}
+	assert.Equal(suite.T(), RateLimit{Rate: 10, Burst: 20}, suite.Limiter.LimitFor(device))
+
+	device.RateLimit = sql.NullFloat64{Float64: 5, Valid: true}
+	assert.Equal(suite.T(), RateLimit{Rate: 5, Burst: 20}, suite.Limiter.LimitFor(device))
+}
+
+// Test events over the limit fail without looking up the device.
+func (suite *RateLimitTestSuite) TestRateLimitedEventsFailed() {
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships").Return(buildDeviceRelationshipSearchResults(), nil)
+
+	msg := suite.locationsMessage()
+	resolved, reasons := suite.resolve(msg, msg, msg)
+
+	assert.Equal(suite.T(), 2, resolved)
+	assert.Equal(suite.T(), []uint{uint(dmproto.FailureReason_RateLimited)}, reasons)
+	suite.API.AssertNumberOfCalls(suite.T(), "DevicesByToken", 2)
+}
+
+// Test replayed events are not rate limited.
+func (suite *RateLimitTestSuite) TestReplayNotLimited() {
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships").Return(buildDeviceRelationshipSearchResults(), nil)
+
+	msg := suite.locationsMessage()
+	msg.Headers = []kafka.Header{{Key: REPLAY_HEADER, Value: []byte("true")}}
+	resolved, reasons := suite.resolve(msg, msg, msg)
+
+	assert.Equal(suite.T(), 3, resolved)
+	assert.Equal(suite.T(), 0, len(reasons))
+}
+
+// Test devices that go quiet while throttled are released once idle.
+func (suite *RateLimitTestSuite) TestIdleDevicesExpired() {
+	suite.configure("dev")
+	for i := 0; i < 2; i++ {
+		suite.Limiter.Allow("dev")
+	}
+	suite.configure("other")
+	assert.Equal(suite.T(), 1, len(suite.Changes))
+
+	suite.Limiter.ExpireIdle(time.Now())
+	assert.Equal(suite.T(), 2, suite.Limiter.Tracked())
+
+	suite.Limiter.ExpireIdle(time.Now().Add(time.Minute))
+	assert.Equal(suite.T(), 0, suite.Limiter.Tracked())
+	assert.Equal(suite.T(), 2, len(suite.Changes))
+	assert.Equal(suite.T(), "dev", suite.Changes[1].DeviceToken)
+	assert.False(suite.T(), suite.Changes[1].Throttled)
+	assert.Equal(suite.T(), uint64(1), suite.Changes[1].Dropped)
+}
+
+// Test the number of devices tracked is bounded, releasing the least recently seen first.
+func (suite *RateLimitTestSuite) TestMaxDevices() {
+	suite.configure("dev")
+	for i := 0; i < 2; i++ {
+		suite.Limiter.Allow("dev")
+	}
+	for i := 0; i < 100; i++ {
+		suite.configure(fmt.Sprintf("device-%d", i))
+	}
+
+	assert.Equal(suite.T(), 3, suite.Limiter.Tracked())
+	assert.Equal(suite.T(), 2, len(suite.Changes))
+	assert.False(suite.T(), suite.Changes[1].Throttled)
+}
+
+// Test tokens that do not belong to a device do not create buckets or release throttled devices.
+func (suite *RateLimitTestSuite) TestUnknownTokensNotTracked() {
+	suite.configure("dev")
+	for i := 0; i < 2; i++ {
+		suite.Limiter.Allow("dev")
+	}
+	for i := 0; i < 100; i++ {
+		assert.True(suite.T(), suite.Limiter.Allow(fmt.Sprintf("random-%d", i)))
+	}
+
+	assert.Equal(suite.T(), 1, suite.Limiter.Tracked())
+	assert.Equal(suite.T(), 1, len(suite.Changes))
+	assert.False(suite.T(), suite.Limiter.Allow("dev"))
+}
+
+// Test a nil limiter allows all events.
+func (suite *RateLimitTestSuite) TestNilLimiter() {
+	var limiter *RateLimiter
+	assert.True(suite.T(), limiter.Allow("dev"))
+	limiter.Configure(buildDevice())
+}
+
+// Run all tests.
+func TestRateLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitTestSuite))
+}
@@ -0,0 +1,59 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Stored rules cached in memory so that event processing does not require api calls.
+type ReloadableCache interface {
+	Reload(ctx context.Context) error
+}
+
+// Cache reloaded by WatchCaches along with the name used when reporting errors.
+type WatchedCache struct {
+	Name  string
+	Cache ReloadableCache
+}
+
+// Periodically reload caches until the context is cancelled. Changes made through this instance
+// are applied right away, so this picks up changes made through other instances.
+func WatchCaches(ctx context.Context, interval time.Duration, caches ...WatchedCache) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for _, watched := range caches {
+				err := watched.Cache.Reload(ctx)
+				if err != nil {
+					log.Error().Err(err).Msg(fmt.Sprintf("unable to reload %s", watched.Name))
+				}
+			}
+		}
+	}
+}
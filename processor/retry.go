@@ -0,0 +1,157 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/devicechain-io/dc-device-management/config"
+	"github.com/devicechain-io/dc-microservice/core"
+	"github.com/jackc/pgconn"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+var (
+	retryMetricsOnce sync.Once
+	retryAttempts    *prometheus.CounterVec
+	retryExhausted   *prometheus.CounterVec
+	apiCallDuration  *prometheus.HistogramVec
+)
+
+// Postgres error classes and codes that indicate a condition which may clear on retry.
+var transientPgCodes = []string{
+	"08",    // Connection exception class
+	"40001", // Serialization failure
+	"40P01", // Deadlock detected
+	"53300", // Too many connections
+	"55P03", // Lock not available
+	"57014", // Query canceled (statement timeout)
+	"57P01", // Admin shutdown
+	"57P02", // Crash shutdown
+	"57P03", // Cannot connect now
+}
+
+// Retries api calls that fail with transient errors using jittered exponential backoff.
+type Retrier struct {
+	MaxRetries     int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Create a new retrier based on configuration.
+func NewRetrier(ms *core.Microservice, cfg config.RetryConfiguration) *Retrier {
+	retryMetricsOnce.Do(func() {
+		retryAttempts = ms.NewCounterVec("api_retries_total",
+			"Number of api calls retried after transient errors", []string{"operation"})
+		retryExhausted = ms.NewCounterVec("api_retries_exhausted_total",
+			"Number of api calls that failed after exhausting retry budget", []string{"operation"})
+		apiCallDuration = newHistogramVec(ms, "api_call_duration_seconds",
+			"Duration of api calls made while resolving events", prometheus.DefBuckets, []string{"operation"})
+	})
+	return &Retrier{
+		MaxRetries:     cfg.MaxRetries,
+		InitialBackoff: time.Duration(cfg.InitialBackoffMs) * time.Millisecond,
+		MaxBackoff:     time.Duration(cfg.MaxBackoffMs) * time.Millisecond,
+	}
+}
+
+// Determine whether an error is likely to clear if the operation is retried.
+func IsTransientError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+	var pgerr *pgconn.PgError
+	if errors.As(err, &pgerr) {
+		for _, code := range transientPgCodes {
+			if strings.HasPrefix(pgerr.Code, code) {
+				return true
+			}
+		}
+		return false
+	}
+	var neterr net.Error
+	if errors.As(err, &neterr) && neterr.Timeout() {
+		return true
+	}
+	if pgconn.Timeout(err) {
+		return true
+	}
+	return false
+}
+
+// Compute backoff before the given retry attempt (starting at zero) with full jitter.
+func (r *Retrier) Backoff(attempt int) time.Duration {
+	ceiling := r.InitialBackoff
+	for i := 0; i < attempt && ceiling < r.MaxBackoff; i++ {
+		ceiling *= 2
+	}
+	if ceiling > r.MaxBackoff {
+		ceiling = r.MaxBackoff
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(ceiling) + 1))
+}
+
+// Execute an operation, retrying transient failures until the retry budget is exhausted.
+func (r *Retrier) Do(ctx context.Context, operation string, call func() error) (result error) {
+	_, span := startSpan(ctx, operation)
+	defer func() { endSpan(span, result) }()
+
+	for attempt := 0; ; attempt++ {
+		span.SetAttributes(attribute.Int("retry.attempt", attempt))
+		started := time.Now()
+		err := call()
+		apiCallDuration.WithLabelValues(operation).Observe(time.Since(started).Seconds())
+		if err == nil || !IsTransientError(err) {
+			return err
+		}
+		if attempt >= r.MaxRetries {
+			retryExhausted.WithLabelValues(operation).Inc()
+			return fmt.Errorf("%s failed after %d retries: %w", operation, attempt, err)
+		}
+		retryAttempts.WithLabelValues(operation).Inc()
+		backoff := r.Backoff(attempt)
+		log.Debug().Err(err).Msg(fmt.Sprintf("retrying %s in %s after transient error", operation, backoff))
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+	}
+}
@@ -0,0 +1,154 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	"github.com/devicechain-io/dc-microservice/core"
+	kcore "github.com/devicechain-io/dc-microservice/kafka"
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	routingMetricsOnce sync.Once
+	routedEvents       *prometheus.CounterVec
+	routingRulesLoaded prometheus.Gauge
+)
+
+// Creates a writer for a routed topic name.
+type RoutedWriterFactory func(topic string) (kcore.KafkaWriter, error)
+
+// Sends resolved events to additional topics based on routing rules stored in the database.
+// Rules are cached and reloaded when changed so that routing does not require api calls.
+type EventRouter struct {
+	Api       model.DeviceManagementApi
+	NewWriter RoutedWriterFactory
+
+	rulesMutex   sync.RWMutex
+	rules        []model.EventRoutingRule
+	writersMutex sync.Mutex
+	writers      map[string]kcore.KafkaWriter
+}
+
+// Create a new event router.
+func NewEventRouter(ms *core.Microservice, api model.DeviceManagementApi, factory RoutedWriterFactory) *EventRouter {
+	routingMetricsOnce.Do(func() {
+		routedEvents = ms.NewCounterVec("routed_events_total",
+			"Number of resolved events written to routed topics", []string{"topic"})
+		routingRulesLoaded = ms.NewGauge("event_routing_rules_loaded",
+			"Number of enabled event routing rules currently applied", []string{})
+	})
+	return &EventRouter{
+		Api:       api,
+		NewWriter: factory,
+		rules:     make([]model.EventRoutingRule, 0),
+		writers:   make(map[string]kcore.KafkaWriter),
+	}
+}
+
+// Load the current set of enabled routing rules.
+func (router *EventRouter) Reload(ctx context.Context) error {
+	if router == nil {
+		return nil
+	}
+	found, err := router.Api.EventRoutingRules(ctx, model.EventRoutingRuleSearchCriteria{
+		Pagination: rdb.Pagination{
+			PageNumber: 1,
+			PageSize:   0,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	router.rulesMutex.Lock()
+	router.rules = found.Results
+	router.rulesMutex.Unlock()
+	routingRulesLoaded.Set(float64(len(found.Results)))
+	return nil
+}
+
+// Indicates whether an optional rule criterion matches an optional value.
+func matchesId(criterion *uint, value *uint) bool {
+	return criterion == nil || (value != nil && *value == *criterion)
+}
+
+// Indicates whether a resolved event matches every criterion set on a rule. Customers and areas
+// are matched against the targets of the resolved event, which are those of the tracked
+// relationship for events reached by fan-out.
+func ruleMatches(rule *model.EventRoutingRule, result EventResolutionResults) bool {
+	if rule.EventType.Valid && rule.EventType.String != result.Resolved.EventType.String() {
+		return false
+	}
+	var deviceTypeId, relationshipTypeId *uint
+	if result.Device != nil {
+		deviceTypeId = &result.Device.DeviceTypeId
+	}
+	if result.Relationship != nil {
+		relationshipTypeId = &result.Relationship.RelationshipTypeId
+	}
+	return matchesId(rule.DeviceTypeId, deviceTypeId) &&
+		matchesId(rule.RelationshipTypeId, relationshipTypeId) &&
+		matchesId(rule.CustomerId, result.Resolved.TargetCustomerId) &&
+		matchesId(rule.AreaId, result.Resolved.TargetAreaId)
+}
+
+// Get the topics a resolved event is routed to. Each topic is included once even if
+// matched by multiple rules.
+func (router *EventRouter) Route(result EventResolutionResults) []string {
+	if router == nil {
+		return nil
+	}
+	router.rulesMutex.RLock()
+	defer router.rulesMutex.RUnlock()
+	var topics []string
+	seen := make(map[string]bool)
+	for i := range router.rules {
+		rule := &router.rules[i]
+		if !ruleMatches(rule, result) {
+			continue
+		}
+		for _, topic := range rule.TopicNames() {
+			if !seen[topic] {
+				seen[topic] = true
+				topics = append(topics, topic)
+			}
+		}
+	}
+	return topics
+}
+
+// Get the writer for a routed topic, creating it on first use.
+func (router *EventRouter) Writer(topic string) (kcore.KafkaWriter, error) {
+	router.writersMutex.Lock()
+	defer router.writersMutex.Unlock()
+	if writer, ok := router.writers[topic]; ok {
+		return writer, nil
+	}
+	writer, err := router.NewWriter(topic)
+	if err != nil {
+		return nil, err
+	}
+	log.Info().Msg(fmt.Sprintf("Created writer for routed topic '%s'", topic))
+	router.writers[topic] = writer
+	return writer, nil
+}
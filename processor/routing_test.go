@@ -0,0 +1,165 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/devicechain-io/dc-device-management/config"
+	dmodel "github.com/devicechain-io/dc-device-management/model"
+	dmtest "github.com/devicechain-io/dc-device-management/test"
+	esproto "github.com/devicechain-io/dc-event-sources/proto"
+	"github.com/devicechain-io/dc-microservice/core"
+	kcore "github.com/devicechain-io/dc-microservice/kafka"
+	test "github.com/devicechain-io/dc-microservice/test"
+	"github.com/segmentio/kafka-go"
+	"gorm.io/datatypes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type EventRoutingTestSuite struct {
+	suite.Suite
+	API      *dmtest.MockApi
+	Inbound  *test.MockKafkaReader
+	Resolved *dmtest.MockRecordingKafkaWriter
+	Routed   map[string]*dmtest.MockRecordingKafkaWriter
+	Router   *EventRouter
+}
+
+// Perform common setup tasks.
+func (suite *EventRoutingTestSuite) SetupTest() {
+	suite.API = new(dmtest.MockApi)
+	suite.Inbound = new(test.MockKafkaReader)
+	suite.Resolved = new(dmtest.MockRecordingKafkaWriter)
+	suite.Routed = make(map[string]*dmtest.MockRecordingKafkaWriter)
+	suite.Router = NewEventRouter(dmtest.DeviceManagementMicroservice, suite.API,
+		func(topic string) (kcore.KafkaWriter, error) {
+			writer := new(dmtest.MockRecordingKafkaWriter)
+			writer.Mock.On("WriteMessages").Return(nil)
+			suite.Routed[topic] = writer
+			return writer, nil
+		})
+}
+
+// Build a routing rule for the given topics.
+func buildRoutingRule(topics string) dmodel.EventRoutingRule {
+	return dmodel.EventRoutingRule{Topics: datatypes.JSON(topics)}
+}
+
+// Load rules into the router.
+func (suite *EventRoutingTestSuite) loadRules(rules ...dmodel.EventRoutingRule) {
+	suite.API.Mock.On("EventRoutingRules").Return(&dmodel.EventRoutingRuleSearchResults{Results: rules}, nil)
+	assert.Nil(suite.T(), suite.Router.Reload(context.Background()))
+}
+
+// Build resolution results for a locations event.
+func buildResolutionResults() EventResolutionResults {
+	return EventResolutionResults{
+		Device:       buildDevice(),
+		Relationship: buildDeviceRelationship(),
+		Resolved:     &dmodel.ResolvedEvent{EventType: buildLocationsEvent().EventType},
+	}
+}
+
+// Test rules only match when every criterion that is set matches.
+func (suite *EventRoutingTestSuite) TestRuleCriteria() {
+	byType := buildRoutingRule(`["locations"]`)
+	byType.EventType = sql.NullString{String: "Location", Valid: true}
+	byDeviceType := buildRoutingRule(`["device-type", "locations"]`)
+	deviceTypeId := uint(123)
+	byDeviceType.DeviceTypeId = &deviceTypeId
+	byAlert := buildRoutingRule(`["alerts"]`)
+	byAlert.EventType = sql.NullString{String: "Alert", Valid: true}
+	byArea := buildRoutingRule(`["area"]`)
+	areaId := uint(5)
+	byArea.AreaId = &areaId
+	suite.loadRules(byType, byDeviceType, byAlert, byArea)
+
+	assert.Equal(suite.T(), []string{"locations", "device-type"}, suite.Router.Route(buildResolutionResults()))
+}
+
+// Test customer and area criteria match the targets of events reached by fan-out.
+func (suite *EventRoutingTestSuite) TestFannedOutEventMatched() {
+	byCustomer := buildRoutingRule(`["customer"]`)
+	customerId := uint(30)
+	byCustomer.CustomerId = &customerId
+	byArea := buildRoutingRule(`["area"]`)
+	areaId := uint(40)
+	byArea.AreaId = &areaId
+	suite.loadRules(byCustomer, byArea)
+
+	results := buildResolutionResults()
+	assert.Empty(suite.T(), suite.Router.Route(results))
+
+	fanout := NewRelationshipFanOut(dmtest.DeviceManagementMicroservice, suite.API, config.NewFanOutConfiguration())
+	retry := NewRetrier(dmtest.DeviceManagementMicroservice, config.RetryConfiguration{})
+	resolver := NewEventResolver(1, suite.API, retry, PipelineStages{FanOut: fanout}, nil, nil, nil, nil)
+	fanned := resolver.FanOutResolvedEvent(results, RelationshipHop{
+		SourceKind:   dmodel.ENTITY_KIND_ASSET,
+		Relationship: &dmodel.EntityRelationship{TargetCustomerId: &customerId},
+		Depth:        1,
+	})
+	assert.Equal(suite.T(), []string{"customer"}, suite.Router.Route(fanned))
+}
+
+// Test rules are replaced when reloaded.
+func (suite *EventRoutingTestSuite) TestReload() {
+	suite.loadRules(buildRoutingRule(`["first"]`))
+	assert.Equal(suite.T(), []string{"first"}, suite.Router.Route(buildResolutionResults()))
+
+	suite.API.Mock.ExpectedCalls = nil
+	suite.loadRules()
+	assert.Empty(suite.T(), suite.Router.Route(buildResolutionResults()))
+}
+
+// Test resolved events are written to routed topics in addition to the resolved events topic.
+func (suite *EventRoutingTestSuite) TestRoutedEventsWritten() {
+	suite.loadRules(buildRoutingRule(`["north", "south"]`))
+	iproc := NewInboundEventsProcessor(dmtest.DeviceManagementMicroservice, suite.Inbound, suite.Resolved,
+		new(test.MockKafkaWriter), new(test.MockKafkaWriter), config.NewProcessorConfiguration(),
+		config.NewRetryConfiguration(), PipelineStages{Router: suite.Router}, core.NewNoOpLifecycleCallbacks(), suite.API)
+	iproc.Initialize(context.Background())
+
+	bytes, err := esproto.MarshalUnresolvedEvent(buildLocationsEvent())
+	assert.Nil(suite.T(), err)
+	msg := kafka.Message{Key: []byte("TEST-123"), Value: bytes}
+	suite.Inbound.Mock.On("ReadMessage", mock.Anything).Return(msg, nil)
+	suite.Resolved.Mock.On("WriteMessages").Return(nil)
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships").Return(buildDeviceRelationshipSearchResults(), nil)
+
+	ctx := context.Background()
+	iproc.ProcessMessage(ctx)
+	iproc.ProcessResolvedEvent(ctx)
+
+	assert.Equal(suite.T(), 1, len(suite.Resolved.Written))
+	assert.Equal(suite.T(), 2, len(suite.Routed))
+	for _, topic := range []string{"north", "south"} {
+		assert.Equal(suite.T(), 1, len(suite.Routed[topic].Written))
+		assert.Equal(suite.T(), suite.Resolved.Written[0].Value, suite.Routed[topic].Written[0].Value)
+	}
+}
+
+// Run all tests.
+func TestEventRoutingTestSuite(t *testing.T) {
+	suite.Run(t, new(EventRoutingTestSuite))
+}
@@ -0,0 +1,102 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"context"
+
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	TRACER_NAME = "github.com/devicechain-io/dc-device-management/processor"
+)
+
+// Tracer used for spans created while processing events.
+func tracer() trace.Tracer {
+	return otel.Tracer(TRACER_NAME)
+}
+
+// Carries trace context in Kafka message headers.
+type KafkaHeaderCarrier struct {
+	Message *kafka.Message
+}
+
+// Get the value of a header.
+func (carrier KafkaHeaderCarrier) Get(key string) string {
+	for _, header := range carrier.Message.Headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+// Set the value of a header, replacing any existing value. Headers are copied so that
+// other copies of the message are not affected.
+func (carrier KafkaHeaderCarrier) Set(key string, value string) {
+	headers := make([]kafka.Header, 0, len(carrier.Message.Headers)+1)
+	for _, header := range carrier.Message.Headers {
+		if header.Key != key {
+			headers = append(headers, header)
+		}
+	}
+	carrier.Message.Headers = append(headers, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+// List header keys.
+func (carrier KafkaHeaderCarrier) Keys() []string {
+	keys := make([]string, 0, len(carrier.Message.Headers))
+	for _, header := range carrier.Message.Headers {
+		keys = append(keys, header.Key)
+	}
+	return keys
+}
+
+// Get a context that carries the trace context from message headers.
+func ExtractTraceContext(ctx context.Context, msg kafka.Message) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, KafkaHeaderCarrier{Message: &msg})
+}
+
+// Add the trace context from a context to message headers.
+func InjectTraceContext(ctx context.Context, msg *kafka.Message) {
+	otel.GetTextMapPropagator().Inject(ctx, KafkaHeaderCarrier{Message: msg})
+}
+
+// Start a span for an event processing step.
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End a span, recording an error if one occurred.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// Propagator used for trace context in Kafka headers and http requests.
+func NewTraceContextPropagator() propagation.TextMapPropagator {
+	return propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+}
@@ -0,0 +1,128 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devicechain-io/dc-device-management/config"
+	dmodel "github.com/devicechain-io/dc-device-management/model"
+	dmtest "github.com/devicechain-io/dc-device-management/test"
+	esproto "github.com/devicechain-io/dc-event-sources/proto"
+	"github.com/devicechain-io/dc-microservice/core"
+	test "github.com/devicechain-io/dc-microservice/test"
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	TEST_TRACE_PARENT = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	TEST_TRACE_ID     = "4bf92f3577b34da6a3ce929d0e0e4736"
+)
+
+type TracingTestSuite struct {
+	suite.Suite
+	Exporter *tracetest.InMemoryExporter
+	Provider *sdktrace.TracerProvider
+	Inbound  *test.MockKafkaReader
+	Resolved *dmtest.MockRecordingKafkaWriter
+	API      *dmtest.MockApi
+	IP       *InboundEventsProcessor
+}
+
+// Perform common setup tasks.
+func (suite *TracingTestSuite) SetupTest() {
+	suite.Exporter = tracetest.NewInMemoryExporter()
+	suite.Provider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(suite.Exporter))
+	otel.SetTracerProvider(suite.Provider)
+	otel.SetTextMapPropagator(NewTraceContextPropagator())
+
+	suite.Inbound = new(test.MockKafkaReader)
+	suite.Resolved = new(dmtest.MockRecordingKafkaWriter)
+	suite.API = new(dmtest.MockApi)
+	suite.IP = NewInboundEventsProcessor(dmtest.DeviceManagementMicroservice, suite.Inbound, suite.Resolved,
+		new(test.MockKafkaWriter), new(test.MockKafkaWriter), config.NewProcessorConfiguration(),
+		config.NewRetryConfiguration(), PipelineStages{}, core.NewNoOpLifecycleCallbacks(), suite.API)
+	suite.IP.Initialize(context.Background())
+}
+
+// Restore global tracing state.
+func (suite *TracingTestSuite) TearDownTest() {
+	suite.Provider.Shutdown(context.Background())
+	otel.SetTracerProvider(trace.NewNoopTracerProvider())
+}
+
+// Test trace context survives a round trip through message headers.
+func (suite *TracingTestSuite) TestHeaderRoundTrip() {
+	ctx, span := tracer().Start(context.Background(), "test")
+	defer span.End()
+
+	msg := kafka.Message{Headers: []kafka.Header{{Key: REPLAY_HEADER, Value: []byte("true")}}}
+	InjectTraceContext(ctx, &msg)
+	InjectTraceContext(ctx, &msg)
+	assert.Equal(suite.T(), 2, len(msg.Headers))
+
+	extracted := trace.SpanContextFromContext(ExtractTraceContext(context.Background(), msg))
+	assert.Equal(suite.T(), span.SpanContext().TraceID(), extracted.TraceID())
+	assert.Equal(suite.T(), span.SpanContext().SpanID(), extracted.SpanID())
+}
+
+// Test spans from receive through resolution share the producer trace, which is passed on to
+// the resolved event.
+func (suite *TracingTestSuite) TestTracePropagated() {
+	bytes, err := esproto.MarshalUnresolvedEvent(buildLocationsEvent())
+	assert.Nil(suite.T(), err)
+	msg := kafka.Message{
+		Key:     []byte("TEST-123"),
+		Value:   bytes,
+		Headers: []kafka.Header{{Key: "traceparent", Value: []byte(TEST_TRACE_PARENT)}},
+	}
+
+	suite.Inbound.Mock.On("ReadMessage", mock.Anything).Return(msg, nil)
+	suite.Resolved.Mock.On("WriteMessages").Return(nil)
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships").Return(buildDeviceRelationshipSearchResults(), nil)
+
+	ctx := context.Background()
+	suite.IP.ProcessMessage(ctx)
+	suite.IP.ProcessResolvedEvent(ctx)
+
+	names := make([]string, 0)
+	for _, span := range suite.Exporter.GetSpans() {
+		names = append(names, span.Name)
+		assert.Equal(suite.T(), TEST_TRACE_ID, span.SpanContext.TraceID().String())
+	}
+	assert.ElementsMatch(suite.T(), []string{"inbound-events receive", "ResolveEvent", "DevicesByToken",
+		"HandleStandardEvent", "DeviceRelationships"}, names)
+
+	assert.Equal(suite.T(), 1, len(suite.Resolved.Written))
+	written := trace.SpanContextFromContext(ExtractTraceContext(ctx, suite.Resolved.Written[0]))
+	assert.Equal(suite.T(), TEST_TRACE_ID, written.TraceID().String())
+}
+
+// Run all tests.
+func TestTracingTestSuite(t *testing.T) {
+	suite.Run(t, new(TracingTestSuite))
+}
@@ -0,0 +1,128 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/devicechain-io/dc-device-management/model"
+	"github.com/devicechain-io/dc-device-management/transform"
+	esmodel "github.com/devicechain-io/dc-event-sources/model"
+	"github.com/devicechain-io/dc-microservice/core"
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	transformMetricsOnce sync.Once
+	transformedEvents    *prometheus.CounterVec
+	transformsLoaded     prometheus.Gauge
+)
+
+// Applies transform scripts stored in the database to inbound events based on the type of
+// the sending device. Scripts are compiled and cached and reloaded when changed.
+type EventTransformer struct {
+	Api model.DeviceManagementApi
+
+	mutex   sync.RWMutex
+	scripts map[uint][]*transform.Script
+}
+
+// Create a new event transformer.
+func NewEventTransformer(ms *core.Microservice, api model.DeviceManagementApi) *EventTransformer {
+	transformMetricsOnce.Do(func() {
+		transformedEvents = ms.NewCounterVec("transformed_events_total",
+			"Number of inbound events processed by transform scripts", []string{"result"})
+		transformsLoaded = ms.NewGauge("event_transforms_loaded",
+			"Number of enabled event transforms currently applied", []string{})
+	})
+	return &EventTransformer{
+		Api:     api,
+		scripts: make(map[uint][]*transform.Script),
+	}
+}
+
+// Load and compile the current set of enabled transforms. Transforms that fail to compile
+// are logged and skipped.
+func (xformer *EventTransformer) Reload(ctx context.Context) error {
+	if xformer == nil {
+		return nil
+	}
+	found, err := xformer.Api.EventTransforms(ctx, model.EventTransformSearchCriteria{
+		Pagination: rdb.Pagination{
+			PageNumber: 1,
+			PageSize:   0,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	scripts := make(map[uint][]*transform.Script)
+	loaded := 0
+	for _, xform := range found.Results {
+		script, err := transform.Compile(xform.Script)
+		if err != nil {
+			log.Error().Err(err).Msg(fmt.Sprintf("unable to compile event transform '%s'", xform.Token))
+			continue
+		}
+		scripts[xform.DeviceTypeId] = append(scripts[xform.DeviceTypeId], script)
+		loaded++
+	}
+	xformer.mutex.Lock()
+	xformer.scripts = scripts
+	xformer.mutex.Unlock()
+	transformsLoaded.Set(float64(loaded))
+	return nil
+}
+
+// Apply transforms for the device type to an inbound event. Events for device types without
+// transforms are returned unchanged.
+func (xformer *EventTransformer) Apply(device *model.Device,
+	event *esmodel.UnresolvedEvent) ([]*esmodel.UnresolvedEvent, error) {
+	events := []*esmodel.UnresolvedEvent{event}
+	if xformer == nil {
+		return events, nil
+	}
+	xformer.mutex.RLock()
+	scripts := xformer.scripts[device.DeviceTypeId]
+	xformer.mutex.RUnlock()
+	if len(scripts) == 0 {
+		return events, nil
+	}
+
+	for _, script := range scripts {
+		next := make([]*esmodel.UnresolvedEvent, 0)
+		for _, current := range events {
+			results, err := script.Apply(current)
+			if err != nil {
+				transformedEvents.WithLabelValues("failed").Inc()
+				return nil, err
+			}
+			next = append(next, results...)
+		}
+		events = next
+	}
+	if len(events) == 0 {
+		transformedEvents.WithLabelValues("dropped").Inc()
+	} else {
+		transformedEvents.WithLabelValues("transformed").Inc()
+	}
+	return events, nil
+}
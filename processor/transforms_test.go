@@ -0,0 +1,197 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package processor
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/devicechain-io/dc-device-management/config"
+	dmodel "github.com/devicechain-io/dc-device-management/model"
+	dmproto "github.com/devicechain-io/dc-device-management/proto"
+	dmtest "github.com/devicechain-io/dc-device-management/test"
+	"github.com/devicechain-io/dc-device-management/transform"
+	"github.com/devicechain-io/dc-event-sources/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type EventTransformTestSuite struct {
+	suite.Suite
+	API         *dmtest.MockApi
+	Transformer *EventTransformer
+	Resolver    *EventResolver
+}
+
+// Perform common setup tasks.
+func (suite *EventTransformTestSuite) SetupTest() {
+	suite.API = new(dmtest.MockApi)
+	suite.Transformer = NewEventTransformer(dmtest.DeviceManagementMicroservice, suite.API)
+	retry := NewRetrier(dmtest.DeviceManagementMicroservice, config.RetryConfiguration{})
+	suite.Resolver = NewEventResolver(1, suite.API, retry, PipelineStages{Transformer: suite.Transformer}, nil, nil, nil, nil)
+}
+
+// Load transforms for the given device type into the transformer.
+func (suite *EventTransformTestSuite) loadTransforms(deviceTypeId uint, scripts ...string) {
+	xforms := make([]dmodel.EventTransform, 0)
+	for _, script := range scripts {
+		xforms = append(xforms, dmodel.EventTransform{DeviceTypeId: deviceTypeId, Script: script})
+	}
+	suite.API.Mock.On("EventTransforms").Return(&dmodel.EventTransformSearchResults{Results: xforms}, nil)
+	assert.Nil(suite.T(), suite.Transformer.Reload(context.Background()))
+}
+
+// Apply a script to the standard measurements event.
+func (suite *EventTransformTestSuite) apply(source string) []*model.UnresolvedEvent {
+	script, err := transform.Compile(source)
+	assert.Nil(suite.T(), err)
+	events, err := script.Apply(buildMeasurementsEvent())
+	assert.Nil(suite.T(), err)
+	return events
+}
+
+// Get measurements for each entry of a transformed event.
+func measurementsOf(event *model.UnresolvedEvent) []map[string]string {
+	mxs := make([]map[string]string, 0)
+	for _, entry := range event.Payload.(*model.UnresolvedMeasurementsPayload).Entries {
+		mxs = append(mxs, entry.Measurements)
+	}
+	return mxs
+}
+
+// Test renaming, computing and dropping measurements.
+func (suite *EventTransformTestSuite) TestScriptStatements() {
+	events := suite.apply(`
+		# Normalize vendor names.
+		rename temp.inDegreesCelcius temperature
+		set fahrenheit = round(temperature * 9 / 5 + 32, 1)
+		drop speed.inMilesPerHour
+	`)
+	assert.Equal(suite.T(), 1, len(events))
+	assert.Equal(suite.T(), []map[string]string{{"temperature": "101.5", "fahrenheit": "214.7"}}, measurementsOf(events[0]))
+	assert.Equal(suite.T(), "TEST-123", events[0].Device)
+}
+
+// Test computed measurements are skipped when an input is missing.
+func (suite *EventTransformTestSuite) TestMissingInputSkipped() {
+	events := suite.apply("set power = voltage * current")
+	assert.Equal(suite.T(), 1, len(events))
+	assert.NotContains(suite.T(), measurementsOf(events[0])[0], "power")
+}
+
+// Test measurements may be split into a separate event.
+func (suite *EventTransformTestSuite) TestSplitEvent() {
+	events := suite.apply("split speed.inMilesPerHour")
+	assert.Equal(suite.T(), 2, len(events))
+	assert.Equal(suite.T(), []map[string]string{{"temp.inDegreesCelcius": "101.5"}}, measurementsOf(events[0]))
+	assert.Equal(suite.T(), []map[string]string{{"speed.inMilesPerHour": "77.5"}}, measurementsOf(events[1]))
+}
+
+// Test non-measurement events are not changed.
+func (suite *EventTransformTestSuite) TestOtherEventsUnchanged() {
+	script, err := transform.Compile("drop level")
+	assert.Nil(suite.T(), err)
+	event := buildAlertsEvent()
+	events, err := script.Apply(event)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []*model.UnresolvedEvent{event}, events)
+}
+
+// Test invalid scripts are rejected with the line number.
+func (suite *EventTransformTestSuite) TestCompileErrors() {
+	for _, source := range []string{
+		"rename a",
+		"set = a * b",
+		"set x = (a",
+		"drop a, not valid",
+		"set x = power**",
+		"\nconvert a b",
+		"set x = len(map(1..100000000, {#}))",
+		"set x = 1..10",
+		"set x = len(filter(a, {# > 1}))",
+		"set x = name matches \"^a+$\"",
+		"set x = a.b",
+		"set x = a[0]",
+		"set x = exec(a)",
+		"set x = " + strings.Repeat("a + ", transform.MAX_EXPRESSION_LENGTH) + "a",
+	} {
+		_, err := transform.Compile(source)
+		assert.NotNil(suite.T(), err, source)
+	}
+	_, err := transform.Compile("\nconvert a b")
+	assert.Contains(suite.T(), err.Error(), "line 2")
+}
+
+// Test resolver resolves each event produced by a split.
+func (suite *EventTransformTestSuite) TestResolveSplitEvent() {
+	suite.loadTransforms(buildDevice().DeviceTypeId, "split speed.inMilesPerHour")
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships").Return(buildDeviceRelationshipSearchResults(), nil)
+
+	results, reason, err := suite.Resolver.ResolveEvent(context.Background(), buildMeasurementsEvent())
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(0), reason)
+	assert.Equal(suite.T(), 2, len(results))
+}
+
+// Test events are not resolved when all measurements are dropped.
+func (suite *EventTransformTestSuite) TestResolveDroppedEvent() {
+	suite.loadTransforms(buildDevice().DeviceTypeId, "drop temp.inDegreesCelcius, speed.inMilesPerHour")
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{buildDevice()}, nil)
+
+	results, _, err := suite.Resolver.ResolveEvent(context.Background(), buildMeasurementsEvent())
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), results)
+	suite.API.Mock.AssertNotCalled(suite.T(), "DeviceRelationships")
+}
+
+// Test transforms for other device types are not applied.
+func (suite *EventTransformTestSuite) TestOtherDeviceTypeUntransformed() {
+	suite.loadTransforms(999, "drop temp.inDegreesCelcius, speed.inMilesPerHour")
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{buildDevice()}, nil)
+	suite.API.Mock.On("DeviceRelationships").Return(buildDeviceRelationshipSearchResults(), nil)
+
+	results, _, err := suite.Resolver.ResolveEvent(context.Background(), buildMeasurementsEvent())
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 1, len(results))
+}
+
+// Test script runtime errors fail resolution with the expected reason.
+func (suite *EventTransformTestSuite) TestTransformFailure() {
+	suite.loadTransforms(buildDevice().DeviceTypeId, "rename temp.inDegreesCelcius temp\nset x = len(temp)")
+	suite.API.Mock.On("DevicesByToken").Return([]*dmodel.Device{buildDevice()}, nil)
+
+	_, reason, err := suite.Resolver.ResolveEvent(context.Background(), buildMeasurementsEvent())
+	assert.NotNil(suite.T(), err)
+	assert.Equal(suite.T(), uint(dmproto.FailureReason_TransformFailed), reason)
+}
+
+// Test transforms that fail to compile are skipped on reload.
+func (suite *EventTransformTestSuite) TestInvalidTransformSkipped() {
+	suite.loadTransforms(buildDevice().DeviceTypeId, "convert a b", "drop speed.inMilesPerHour")
+
+	events, err := suite.Transformer.Apply(buildDevice(), buildMeasurementsEvent())
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []map[string]string{{"temp.inDegreesCelcius": "101.5"}}, measurementsOf(events[0]))
+}
+
+// Run all tests.
+func TestEventTransformTestSuite(t *testing.T) {
+	suite.Run(t, new(EventTransformTestSuite))
+}
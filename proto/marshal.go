@@ -18,6 +18,7 @@ package proto
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/devicechain-io/dc-device-management/model"
 	esmodel "github.com/devicechain-io/dc-event-sources/model"
@@ -65,6 +66,52 @@ func UnmarshalFailedEvent(encoded []byte) (*model.FailedEvent, error) {
 	return event, nil
 }
 
+// Marshal a throttle event to protobuf bytes.
+func MarshalThrottleEvent(event *model.ThrottleEvent) ([]byte, error) {
+	// Encode protobuf event.
+	pbevent := &PThrottleEvent{
+		DeviceToken:  event.DeviceToken,
+		Throttled:    event.Throttled,
+		Rate:         event.Rate,
+		Burst:        int64(event.Burst),
+		Dropped:      event.Dropped,
+		OccurredTime: event.OccurredTime.Format(time.RFC3339Nano),
+	}
+
+	// Marshal event to bytes.
+	bytes, err := proto.Marshal(pbevent)
+	if err != nil {
+		return nil, err
+	}
+
+	return bytes, nil
+}
+
+// Unmarshal encoded throttle event.
+func UnmarshalThrottleEvent(encoded []byte) (*model.ThrottleEvent, error) {
+	// Unmarshal protobuf event.
+	pbevent := &PThrottleEvent{}
+	err := proto.Unmarshal(encoded, pbevent)
+	if err != nil {
+		return nil, err
+	}
+	occurred, err := time.Parse(time.RFC3339Nano, pbevent.OccurredTime)
+	if err != nil {
+		return nil, err
+	}
+
+	event := &model.ThrottleEvent{
+		DeviceToken:  pbevent.DeviceToken,
+		Throttled:    pbevent.Throttled,
+		Rate:         pbevent.Rate,
+		Burst:        int(pbevent.Burst),
+		Dropped:      pbevent.Dropped,
+		OccurredTime: occurred,
+	}
+
+	return event, nil
+}
+
 // Marshal payload for a new relationship event.
 func MarshalPayloadForNewRelationshipEvent(payload *model.ResolvedNewRelationshipPayload) ([]byte, error) {
 	pbpayload := &PResolvedNewRelationshipPayload{
@@ -291,6 +338,94 @@ func UnmarshalResolvedPayload(etype esmodel.EventType, payload []byte) (interfac
 	}
 }
 
+// Convert an entity snapshot to its protobuf representation.
+func marshalEntitySnapshot(snapshot *model.EntitySnapshot) *PEntitySnapshot {
+	if snapshot == nil {
+		return nil
+	}
+	return &PEntitySnapshot{
+		Token:     snapshot.Token,
+		TypeToken: snapshot.TypeToken,
+		Name:      snapshot.Name,
+		Metadata:  snapshot.Metadata,
+	}
+}
+
+// Convert resolved event enrichment to its protobuf representation.
+func marshalEnrichment(enrichment *model.ResolvedEventEnrichment) *PResolvedEventEnrichment {
+	if enrichment == nil {
+		return nil
+	}
+	return &PResolvedEventEnrichment{
+		SourceDevice:        marshalEntitySnapshot(enrichment.SourceDevice),
+		RelationshipType:    marshalEntitySnapshot(enrichment.RelationshipType),
+		TargetDevice:        marshalEntitySnapshot(enrichment.TargetDevice),
+		TargetDeviceGroup:   marshalEntitySnapshot(enrichment.TargetDeviceGroup),
+		TargetCustomer:      marshalEntitySnapshot(enrichment.TargetCustomer),
+		TargetCustomerGroup: marshalEntitySnapshot(enrichment.TargetCustomerGroup),
+		TargetArea:          marshalEntitySnapshot(enrichment.TargetArea),
+		TargetAreaGroup:     marshalEntitySnapshot(enrichment.TargetAreaGroup),
+		TargetAsset:         marshalEntitySnapshot(enrichment.TargetAsset),
+		TargetAssetGroup:    marshalEntitySnapshot(enrichment.TargetAssetGroup),
+	}
+}
+
+// Convert a protobuf entity snapshot to its model representation.
+func unmarshalEntitySnapshot(snapshot *PEntitySnapshot) *model.EntitySnapshot {
+	if snapshot == nil {
+		return nil
+	}
+	return &model.EntitySnapshot{
+		Token:     snapshot.Token,
+		TypeToken: snapshot.TypeToken,
+		Name:      snapshot.Name,
+		Metadata:  snapshot.Metadata,
+	}
+}
+
+// Convert protobuf resolved event enrichment to its model representation.
+func unmarshalEnrichment(enrichment *PResolvedEventEnrichment) *model.ResolvedEventEnrichment {
+	if enrichment == nil {
+		return nil
+	}
+	return &model.ResolvedEventEnrichment{
+		SourceDevice:        unmarshalEntitySnapshot(enrichment.SourceDevice),
+		RelationshipType:    unmarshalEntitySnapshot(enrichment.RelationshipType),
+		TargetDevice:        unmarshalEntitySnapshot(enrichment.TargetDevice),
+		TargetDeviceGroup:   unmarshalEntitySnapshot(enrichment.TargetDeviceGroup),
+		TargetCustomer:      unmarshalEntitySnapshot(enrichment.TargetCustomer),
+		TargetCustomerGroup: unmarshalEntitySnapshot(enrichment.TargetCustomerGroup),
+		TargetArea:          unmarshalEntitySnapshot(enrichment.TargetArea),
+		TargetAreaGroup:     unmarshalEntitySnapshot(enrichment.TargetAreaGroup),
+		TargetAsset:         unmarshalEntitySnapshot(enrichment.TargetAsset),
+		TargetAssetGroup:    unmarshalEntitySnapshot(enrichment.TargetAssetGroup),
+	}
+}
+
+// Convert resolved event fan-out to its protobuf representation.
+func marshalFanOut(fanout *model.ResolvedEventFanOut) *PResolvedEventFanOut {
+	if fanout == nil {
+		return nil
+	}
+	return &PResolvedEventFanOut{
+		SourceKind:     fanout.SourceKind,
+		RelationshipId: uint64(fanout.RelationshipId),
+		Depth:          fanout.Depth,
+	}
+}
+
+// Convert protobuf resolved event fan-out to its model representation.
+func unmarshalFanOut(fanout *PResolvedEventFanOut) *model.ResolvedEventFanOut {
+	if fanout == nil {
+		return nil
+	}
+	return &model.ResolvedEventFanOut{
+		SourceKind:     fanout.SourceKind,
+		RelationshipId: uint(fanout.RelationshipId),
+		Depth:          fanout.Depth,
+	}
+}
+
 // Marshal a resolved event to protobuf bytes.
 func MarshalResolvedEvent(event *model.ResolvedEvent) ([]byte, error) {
 	// Encode payload.
@@ -315,6 +450,8 @@ func MarshalResolvedEvent(event *model.ResolvedEvent) ([]byte, error) {
 		TargetAreaGroupId:     util.NullUint64Of(event.TargetAreaGroupId),
 		EventType:             int64(event.EventType),
 		Payload:               pybytes,
+		Enrichment:            marshalEnrichment(event.Enrichment),
+		FanOut:                marshalFanOut(event.FanOut),
 	}
 
 	// Marshal event to bytes.
@@ -356,6 +493,8 @@ func UnmarshalResolvedEvent(encoded []byte) (*model.ResolvedEvent, error) {
 		TargetAreaGroupId:     util.NullUintOf(pbevent.TargetAreaGroupId),
 		EventType:             esmodel.EventType(pbevent.EventType),
 		Payload:               payload,
+		Enrichment:            unmarshalEnrichment(pbevent.Enrichment),
+		FanOut:                unmarshalFanOut(pbevent.FanOut),
 	}
 
 	return event, nil
@@ -0,0 +1,36 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	v7 "github.com/devicechain-io/dc-device-management/schema/v7"
+	gormigrate "github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// Creates the migration that adds tables for dead letter events and replay jobs.
+func NewDeadLettersSchema() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20261019000600",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&v7.DeadLetterEvent{}, &v7.FailedEventReplayJob{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			return dropTables(tx, []string{"failed_event_replay_jobs", "dead_letter_events"})
+		},
+	}
+}
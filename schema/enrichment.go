@@ -0,0 +1,42 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	v9 "github.com/devicechain-io/dc-device-management/schema/v9"
+	gormigrate "github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// Creates the migration that adds event enrichment settings to device relationship types.
+func NewEnrichmentSchema() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20261019000800",
+		Migrate: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&v9.DeviceRelationshipType{})
+		},
+		Rollback: func(tx *gorm.DB) error {
+			for _, column := range []string{"enriched", "enriched_metadata_keys"} {
+				err := tx.Migrator().DropColumn(&v9.DeviceRelationshipType{}, column)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
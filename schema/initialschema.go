@@ -24,7 +24,7 @@ import (
 
 // Drop all tables from the list.
 func dropTables(tx *gorm.DB, tables []string) error {
-	for table := range tables {
+	for _, table := range tables {
 		err := tx.Migrator().DropTable(table)
 		if err != nil {
 			return err
@@ -23,5 +23,21 @@ import (
 var (
 	Migrations = []*gormigrate.Migration{
 		NewInitialSchema(),
+		NewPendingDevicesSchema(),
+		NewDeviceCredentialsSchema(),
+		NewCertificatesSchema(),
+		NewDeviceStatusSchema(),
+		NewAuditSchema(),
+		NewDeadLettersSchema(),
+		NewRateLimitsSchema(),
+		NewEnrichmentSchema(),
+		NewRoutingRulesSchema(),
+		NewEventTransformsSchema(),
+		NewAlertRulesSchema(),
+		NewAlertsSchema(),
+		NewTrackedRelationshipsSchema(),
+		NewRelationshipTargetIndexesSchema(),
+		NewRelationshipConstraintsSchema(),
+		NewPreSharedKeyHashesSchema(),
 	}
 )
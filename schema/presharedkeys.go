@@ -0,0 +1,50 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	v3 "github.com/devicechain-io/dc-device-management/schema/v3"
+	gormigrate "github.com/go-gormigrate/gormigrate/v2"
+	"gorm.io/gorm"
+)
+
+// Creates the migration that replaces stored pre-shared keys with their hashes. The keys can not
+// be recovered from the hashes, so the migration can not be rolled back.
+func NewPreSharedKeyHashesSchema() *gormigrate.Migration {
+	return &gormigrate.Migration{
+		ID: "20261019001600",
+		Migrate: func(tx *gorm.DB) error {
+			creds := make([]*v3.DeviceCredential, 0)
+			result := tx.Unscoped().Where("credential_type = ?", "PreSharedKey").Find(&creds)
+			if result.Error != nil {
+				return result.Error
+			}
+			for _, cred := range creds {
+				sum := sha256.Sum256([]byte(cred.Value))
+				result = tx.Unscoped().Model(&v3.DeviceCredential{}).Where("id = ?", cred.ID).
+					Update("value", hex.EncodeToString(sum[:]))
+				if result.Error != nil {
+					return result.Error
+				}
+			}
+			return nil
+		},
+	}
+}
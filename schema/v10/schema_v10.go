@@ -0,0 +1,46 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v10
+
+import (
+	"database/sql"
+
+	v1 "github.com/devicechain-io/dc-device-management/schema/v1"
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Rule that routes resolved events matching its criteria to additional topics.
+type EventRoutingRule struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+
+	EventType          sql.NullString `gorm:"size:32"`
+	DeviceTypeId       *uint
+	DeviceType         *v1.DeviceType
+	RelationshipTypeId *uint
+	RelationshipType   *v1.DeviceRelationshipType
+	CustomerId         *uint
+	Customer           *v1.Customer
+	AreaId             *uint
+	Area               *v1.Area
+	Topics             datatypes.JSON
+	Disabled           bool `gorm:"not null;default:false"`
+}
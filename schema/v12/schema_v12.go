@@ -0,0 +1,47 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v12
+
+import (
+	"database/sql"
+
+	v1 "github.com/devicechain-io/dc-device-management/schema/v1"
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+)
+
+// Rule that generates an alert when a measurement crosses a threshold.
+type AlertRule struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+
+	DeviceTypeId *uint
+	DeviceType   *v1.DeviceType
+	DeviceId     *uint
+	Device       *v1.Device
+	Measurement  string  `gorm:"size:128;not null"`
+	Comparator   string  `gorm:"size:8;not null"`
+	Threshold    float64 `gorm:"not null"`
+	DurationMs   int32   `gorm:"not null;default:0"`
+	Hysteresis   float64 `gorm:"not null;default:0"`
+	AlertType    string  `gorm:"size:128;not null"`
+	Level        uint32  `gorm:"not null"`
+	Message      sql.NullString
+	Disabled     bool `gorm:"not null;default:false"`
+}
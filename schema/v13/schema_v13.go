@@ -0,0 +1,64 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v13
+
+import (
+	"database/sql"
+	"time"
+
+	v1 "github.com/devicechain-io/dc-device-management/schema/v1"
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+)
+
+// Alert instance for a device.
+type Alert struct {
+	gorm.Model
+	rdb.TokenReference
+
+	DeviceId          uint `gorm:"index"`
+	Device            *v1.Device
+	AlertType         string `gorm:"size:128;not null;index"`
+	State             string `gorm:"size:16;not null;index"`
+	Level             uint32 `gorm:"not null"`
+	Message           sql.NullString
+	Source            sql.NullString `gorm:"size:128"`
+	Occurrences       uint           `gorm:"not null"`
+	FirstOccurred     time.Time
+	LastOccurred      time.Time
+	AcknowledgedAt    sql.NullTime
+	AcknowledgedBy    sql.NullString `gorm:"size:128"`
+	ResolvedAt        sql.NullTime
+	ResolvedBy        sql.NullString `gorm:"size:128"`
+	ResolutionComment sql.NullString
+}
+
+// Window during which alerts for a device or device group are not recorded.
+type AlertSuppression struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+
+	DeviceId      *uint
+	Device        *v1.Device
+	DeviceGroupId *uint
+	DeviceGroup   *v1.DeviceGroup
+	AlertType     sql.NullString `gorm:"size:128"`
+	StartTime     time.Time      `gorm:"not null"`
+	EndTime       time.Time      `gorm:"not null;index"`
+}
@@ -0,0 +1,49 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v14
+
+import (
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+)
+
+// Represents an asset relationship type that may be tracked for event fan-out.
+type AssetRelationshipType struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+	Tracked bool `gorm:"not null;default:false"`
+}
+
+// Represents an area relationship type that may be tracked for event fan-out.
+type AreaRelationshipType struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+	Tracked bool `gorm:"not null;default:false"`
+}
+
+// Represents a customer relationship type that may be tracked for event fan-out.
+type CustomerRelationshipType struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+	Tracked bool `gorm:"not null;default:false"`
+}
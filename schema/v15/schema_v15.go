@@ -0,0 +1,95 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v15
+
+// Target columns of an entity relationship, indexed for lookups by target.
+type EntityRelationshipTargets struct {
+	TargetDeviceId        *uint `gorm:"index"`
+	TargetDeviceGroupId   *uint `gorm:"index"`
+	TargetAssetId         *uint `gorm:"index"`
+	TargetAssetGroupId    *uint `gorm:"index"`
+	TargetAreaId          *uint `gorm:"index"`
+	TargetAreaGroupId     *uint `gorm:"index"`
+	TargetCustomerId      *uint `gorm:"index"`
+	TargetCustomerGroupId *uint `gorm:"index"`
+}
+
+// Names of the indexed target fields.
+var EntityRelationshipTargetFields = []string{
+	"TargetDeviceId",
+	"TargetDeviceGroupId",
+	"TargetAssetId",
+	"TargetAssetGroupId",
+	"TargetAreaId",
+	"TargetAreaGroupId",
+	"TargetCustomerId",
+	"TargetCustomerGroupId",
+}
+
+// Device relationship with indexed targets.
+type DeviceRelationship struct {
+	EntityRelationshipTargets
+}
+
+// Device group relationship with indexed targets.
+type DeviceGroupRelationship struct {
+	EntityRelationshipTargets
+}
+
+// Asset relationship with indexed targets.
+type AssetRelationship struct {
+	EntityRelationshipTargets
+}
+
+// Asset group relationship with indexed targets.
+type AssetGroupRelationship struct {
+	EntityRelationshipTargets
+}
+
+// Area relationship with indexed targets.
+type AreaRelationship struct {
+	EntityRelationshipTargets
+}
+
+// Area group relationship with indexed targets.
+type AreaGroupRelationship struct {
+	EntityRelationshipTargets
+}
+
+// Customer relationship with indexed targets.
+type CustomerRelationship struct {
+	EntityRelationshipTargets
+}
+
+// Customer group relationship with indexed targets.
+type CustomerGroupRelationship struct {
+	EntityRelationshipTargets
+}
+
+// All relationship models with indexed targets.
+func RelationshipModels() []interface{} {
+	return []interface{}{
+		&DeviceRelationship{},
+		&DeviceGroupRelationship{},
+		&AssetRelationship{},
+		&AssetGroupRelationship{},
+		&AreaRelationship{},
+		&AreaGroupRelationship{},
+		&CustomerRelationship{},
+		&CustomerGroupRelationship{},
+	}
+}
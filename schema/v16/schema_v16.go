@@ -0,0 +1,127 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v16
+
+import (
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Constraints on cardinality and allowed entities for relationships of a given type.
+type RelationshipConstraints struct {
+	Cardinality        string `gorm:"size:16;not null;default:ManyToMany"`
+	OnConflict         string `gorm:"size:16;not null;default:Reject"`
+	AllowedTargetKinds *datatypes.JSON
+	AllowedSourceTypes *datatypes.JSON
+	AllowedTargetTypes *datatypes.JSON
+}
+
+// Columns added for relationship constraints.
+var RelationshipConstraintColumns = []string{
+	"cardinality",
+	"on_conflict",
+	"allowed_target_kinds",
+	"allowed_source_types",
+	"allowed_target_types",
+}
+
+// Represents a device relationship type with constraints.
+type DeviceRelationshipType struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+	RelationshipConstraints
+}
+
+// Represents a device group relationship type with constraints.
+type DeviceGroupRelationshipType struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+	RelationshipConstraints
+}
+
+// Represents an asset relationship type with constraints.
+type AssetRelationshipType struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+	RelationshipConstraints
+}
+
+// Represents an asset group relationship type with constraints.
+type AssetGroupRelationshipType struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+	RelationshipConstraints
+}
+
+// Represents an area relationship type with constraints.
+type AreaRelationshipType struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+	RelationshipConstraints
+}
+
+// Represents an area group relationship type with constraints.
+type AreaGroupRelationshipType struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+	RelationshipConstraints
+}
+
+// Represents a customer relationship type with constraints.
+type CustomerRelationshipType struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+	RelationshipConstraints
+}
+
+// Represents a customer group relationship type with constraints.
+type CustomerGroupRelationshipType struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+	RelationshipConstraints
+}
+
+// Get all relationship type models that carry constraints.
+func RelationshipTypeModels() []interface{} {
+	return []interface{}{
+		&DeviceRelationshipType{},
+		&DeviceGroupRelationshipType{},
+		&AssetRelationshipType{},
+		&AssetGroupRelationshipType{},
+		&AreaRelationshipType{},
+		&AreaGroupRelationshipType{},
+		&CustomerRelationshipType{},
+		&CustomerGroupRelationshipType{},
+	}
+}
@@ -0,0 +1,47 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v2
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+)
+
+// Represents a device token that has sent events but has not been registered.
+type PendingDevice struct {
+	gorm.Model
+	rdb.TokenReference
+	FirstSeen     time.Time
+	LastSeen      time.Time
+	Source        string `gorm:"size:128"`
+	SamplePayload sql.NullString
+	EventCount    uint
+	Rejected      bool
+
+	Events []PendingDeviceEvent
+}
+
+// Event held for a pending device until the device is approved or rejected.
+type PendingDeviceEvent struct {
+	gorm.Model
+	PendingDeviceId uint
+	PendingDevice   *PendingDevice
+	Payload         []byte
+}
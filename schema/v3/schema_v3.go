@@ -0,0 +1,38 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v3
+
+import (
+	"database/sql"
+
+	v1 "github.com/devicechain-io/dc-device-management/schema/v1"
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+)
+
+// Authentication material associated with a device.
+type DeviceCredential struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.MetadataEntity
+	DeviceId       uint
+	Device         *v1.Device
+	CredentialType string `gorm:"size:32;not null"`
+	Value          string `gorm:"size:256;not null;index"`
+	ExpiresAt      sql.NullTime
+	RevokedAt      sql.NullTime
+}
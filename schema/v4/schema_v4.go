@@ -0,0 +1,48 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v4
+
+import (
+	"database/sql"
+	"time"
+
+	v1 "github.com/devicechain-io/dc-device-management/schema/v1"
+	"gorm.io/gorm"
+)
+
+// Certificate authority used to issue device certificates for a tenant.
+type CertificateAuthority struct {
+	gorm.Model
+	Tenant      string `gorm:"size:128;unique;not null"`
+	Certificate string `gorm:"not null"`
+	PrivateKey  string `gorm:"not null"`
+}
+
+// Certificate issued to a device by the tenant certificate authority.
+type DeviceCertificate struct {
+	gorm.Model
+	DeviceId         uint
+	Device           *v1.Device
+	SerialNumber     string `gorm:"size:64;unique;not null"`
+	Subject          string `gorm:"size:256"`
+	Fingerprint      string `gorm:"size:64;index"`
+	Certificate      string `gorm:"not null"`
+	NotBefore        time.Time
+	NotAfter         time.Time
+	RevokedAt        sql.NullTime
+	RevocationReason sql.NullString `gorm:"size:128"`
+}
@@ -0,0 +1,48 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v5
+
+import (
+	"database/sql"
+
+	v1 "github.com/devicechain-io/dc-device-management/schema/v1"
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+)
+
+// Represents a device.
+type Device struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+
+	DeviceTypeId uint
+	DeviceType   *v1.DeviceType
+	Status       string `gorm:"size:32;not null;default:Active;index"`
+}
+
+// Record of a device moving from one status to another.
+type DeviceStatusTransition struct {
+	gorm.Model
+	DeviceId   uint
+	Device     *Device
+	FromStatus string         `gorm:"size:32;not null"`
+	ToStatus   string         `gorm:"size:32;not null"`
+	Actor      sql.NullString `gorm:"size:128"`
+	Reason     sql.NullString
+}
@@ -0,0 +1,36 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v6
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// Record of a change made through the API.
+type AuditEntry struct {
+	gorm.Model
+	Actor       string `gorm:"size:128;not null;index"`
+	Tenant      string `gorm:"size:128;index"`
+	EntityKind  string `gorm:"size:64;not null;index"`
+	EntityToken string `gorm:"size:128;index"`
+	Operation   string `gorm:"size:32;not null"`
+	Changes     datatypes.JSON
+	OccurredAt  time.Time `gorm:"not null;index"`
+}
@@ -0,0 +1,57 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v7
+
+import (
+	"database/sql"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Failed event stored so that it may be inspected and replayed.
+type DeadLetterEvent struct {
+	gorm.Model
+	Reason       uint   `gorm:"index"`
+	Service      string `gorm:"size:128;index"`
+	Message      string
+	Error        string
+	DeviceToken  sql.NullString `gorm:"size:128;index"`
+	Payload      []byte
+	PayloadHash  string `gorm:"size:64;uniqueIndex"`
+	FailureCount uint
+	ReplayCount  uint
+	Status       string `gorm:"size:32;index"`
+	FirstFailed  time.Time
+	LastFailed   time.Time `gorm:"index"`
+}
+
+// Tracks progress of replaying failed events that match a filter.
+type FailedEventReplayJob struct {
+	gorm.Model
+	Status      string `gorm:"size:32;index"`
+	Reason      sql.NullInt32
+	Service     sql.NullString `gorm:"size:128"`
+	DeviceToken sql.NullString `gorm:"size:128"`
+	Since       sql.NullTime
+	Until       sql.NullTime
+	Total       uint
+	Replayed    uint
+	Quarantined uint
+	CompletedAt sql.NullTime
+	Error       sql.NullString
+}
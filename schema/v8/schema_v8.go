@@ -0,0 +1,49 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v8
+
+import (
+	"database/sql"
+
+	"github.com/devicechain-io/dc-microservice/rdb"
+	"gorm.io/gorm"
+)
+
+// Represents a device type with default limits on inbound event rates.
+type DeviceType struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.BrandedEntity
+	rdb.MetadataEntity
+
+	RateLimit sql.NullFloat64
+	RateBurst sql.NullInt64
+}
+
+// Represents a device with overrides for inbound event rates.
+type Device struct {
+	gorm.Model
+	rdb.TokenReference
+	rdb.NamedEntity
+	rdb.MetadataEntity
+
+	DeviceTypeId uint
+	Status       string `gorm:"size:32;not null;default:Active;index"`
+	RateLimit    sql.NullFloat64
+	RateBurst    sql.NullInt64
+}
@@ -18,11 +18,13 @@ package test
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/devicechain-io/dc-device-management/model"
 	"github.com/devicechain-io/dc-microservice/config"
 	"github.com/devicechain-io/dc-microservice/core"
+	"github.com/segmentio/kafka-go"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -39,6 +41,63 @@ var DeviceManagementMicroservice = &core.Microservice{
 	MicroserviceConfigurationRaw: make([]byte, 0),
 }
 
+/**
+ * Mock for Kafka reader that supports explicit offset commits.
+ */
+
+type MockCommittingKafkaReader struct {
+	mock.Mock
+}
+
+func (reader *MockCommittingKafkaReader) ReadMessage(ctx context.Context) (kafka.Message, error) {
+	args := reader.Called()
+	return args.Get(0).(kafka.Message), args.Error(1)
+}
+
+func (reader *MockCommittingKafkaReader) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	args := reader.Called()
+	return args.Get(0).(kafka.Message), args.Error(1)
+}
+
+func (reader *MockCommittingKafkaReader) CommitMessages(ctx context.Context, msgs ...kafka.Message) error {
+	args := reader.Called(msgs)
+	return args.Error(0)
+}
+
+func (reader *MockCommittingKafkaReader) HandleResponse(err error) {
+}
+
+/**
+ * Mock for Kafka writer that keeps the messages written.
+ */
+
+type MockRecordingKafkaWriter struct {
+	mock.Mock
+	Written []kafka.Message
+}
+
+func (writer *MockRecordingKafkaWriter) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	args := writer.Called()
+	if args.Error(0) == nil {
+		writer.Written = append(writer.Written, msgs...)
+	}
+	var werrs kafka.WriteErrors
+	if errors.As(args.Error(0), &werrs) && len(werrs) == len(msgs) {
+		for i, werr := range werrs {
+			if werr == nil {
+				writer.Written = append(writer.Written, msgs[i])
+			}
+		}
+	}
+	return args.Error(0)
+}
+
+func (writer *MockRecordingKafkaWriter) HandleResponse(err error) {
+}
+
 /**
  * Mock for device management API.
  */
@@ -97,3 +156,90 @@ func (api *MockApi) CreateDeviceRelationship(ctx context.Context, request *model
 	args := api.Mock.Called()
 	return args.Get(0).(*model.DeviceRelationship), args.Error(1)
 }
+
+func (api *MockApi) AssetRelationships(ctx context.Context,
+	criteria model.AssetRelationshipSearchCriteria) (*model.AssetRelationshipSearchResults, error) {
+	args := api.Mock.Called(criteria)
+	return args.Get(0).(*model.AssetRelationshipSearchResults), args.Error(1)
+}
+
+func (api *MockApi) AreaRelationships(ctx context.Context,
+	criteria model.AreaRelationshipSearchCriteria) (*model.AreaRelationshipSearchResults, error) {
+	args := api.Mock.Called(criteria)
+	return args.Get(0).(*model.AreaRelationshipSearchResults), args.Error(1)
+}
+
+func (api *MockApi) CustomerRelationships(ctx context.Context,
+	criteria model.CustomerRelationshipSearchCriteria) (*model.CustomerRelationshipSearchResults, error) {
+	args := api.Mock.Called(criteria)
+	return args.Get(0).(*model.CustomerRelationshipSearchResults), args.Error(1)
+}
+
+func (api *MockApi) RecordPendingDeviceEvent(ctx context.Context,
+	request *model.PendingDeviceEventCreateRequest) (*model.PendingDevice, bool, error) {
+	args := api.Mock.Called()
+	return args.Get(0).(*model.PendingDevice), args.Bool(1), args.Error(2)
+}
+
+func (api *MockApi) RecordDeadLetterEvent(ctx context.Context,
+	request *model.DeadLetterEventCreateRequest) (*model.DeadLetterEvent, error) {
+	args := api.Mock.Called()
+	return args.Get(0).(*model.DeadLetterEvent), args.Error(1)
+}
+
+func (api *MockApi) CountReplayableDeadLetterEvents(ctx context.Context,
+	job *model.FailedEventReplayJob) (int64, error) {
+	args := api.Mock.Called()
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (api *MockApi) ReplayableDeadLetterEvents(ctx context.Context, job *model.FailedEventReplayJob,
+	limit int) ([]*model.DeadLetterEvent, error) {
+	args := api.Mock.Called()
+	return args.Get(0).([]*model.DeadLetterEvent), args.Error(1)
+}
+
+func (api *MockApi) QuarantineDeadLetterEvent(ctx context.Context, event *model.DeadLetterEvent) error {
+	args := api.Mock.Called()
+	return args.Error(0)
+}
+
+func (api *MockApi) MarkDeadLetterEventsReplayed(ctx context.Context, events []*model.DeadLetterEvent) error {
+	args := api.Mock.Called(events)
+	return args.Error(0)
+}
+
+func (api *MockApi) CreateFailedEventReplayJob(ctx context.Context,
+	request *model.FailedEventReplayRequest) (*model.FailedEventReplayJob, error) {
+	args := api.Mock.Called()
+	return args.Get(0).(*model.FailedEventReplayJob), args.Error(1)
+}
+
+func (api *MockApi) UpdateFailedEventReplayJob(ctx context.Context, job *model.FailedEventReplayJob) error {
+	args := api.Mock.Called()
+	return args.Error(0)
+}
+
+func (api *MockApi) EventRoutingRules(ctx context.Context,
+	criteria model.EventRoutingRuleSearchCriteria) (*model.EventRoutingRuleSearchResults, error) {
+	args := api.Mock.Called()
+	return args.Get(0).(*model.EventRoutingRuleSearchResults), args.Error(1)
+}
+
+func (api *MockApi) EventTransforms(ctx context.Context,
+	criteria model.EventTransformSearchCriteria) (*model.EventTransformSearchResults, error) {
+	args := api.Mock.Called()
+	return args.Get(0).(*model.EventTransformSearchResults), args.Error(1)
+}
+
+func (api *MockApi) AlertRules(ctx context.Context,
+	criteria model.AlertRuleSearchCriteria) (*model.AlertRuleSearchResults, error) {
+	args := api.Mock.Called()
+	return args.Get(0).(*model.AlertRuleSearchResults), args.Error(1)
+}
+
+func (api *MockApi) RecordAlertOccurrence(ctx context.Context,
+	request *model.AlertOccurrenceRequest) (*model.Alert, bool, error) {
+	args := api.Mock.Called(request)
+	return args.Get(0).(*model.Alert), args.Bool(1), args.Error(2)
+}
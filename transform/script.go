@@ -0,0 +1,396 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transform
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/ast"
+	"github.com/antonmedv/expr/parser"
+	"github.com/antonmedv/expr/vm"
+	esmodel "github.com/devicechain-io/dc-event-sources/model"
+)
+
+const (
+	OP_RENAME = "rename"
+	OP_SET    = "set"
+	OP_DROP   = "drop"
+	OP_SPLIT  = "split"
+
+	// Limit on number of statements in a single script.
+	MAX_STATEMENTS = 256
+
+	// Limit on length of a single expression.
+	MAX_EXPRESSION_LENGTH = 512
+
+	// Limit on length of a value computed by an expression.
+	MAX_VALUE_LENGTH = 1024
+
+	// Limit on time spent applying a script to an event.
+	MAX_APPLY_DURATION = 100 * time.Millisecond
+)
+
+// Valid measurement names referenced by scripts.
+var namePattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_.-]{0,127}$`)
+
+// Helper functions made available to expressions.
+var helpers = map[string]interface{}{
+	"abs": math.Abs,
+	"round": func(value float64, places int) float64 {
+		scale := math.Pow(10, float64(places))
+		return math.Round(value*scale) / scale
+	},
+}
+
+// Single statement within a transformation script.
+type Statement struct {
+	Line       int
+	Op         string
+	Names      []string
+	Expression string
+	program    *vm.Program
+	inputs     []string
+}
+
+// Compiled transformation script.
+//
+// Scripts consist of one statement per line. Blank lines and lines starting with '#' are ignored.
+//
+//	rename <from> <to>      rename a measurement
+//	set <name> = <expr>     compute a measurement from others (e.g. set power = voltage * current)
+//	drop <name>, ...        remove measurements
+//	split <name>, ...       move measurements into a separate event
+//
+// Expressions are evaluated in a sandbox that only exposes the measurements of the current
+// entry (numeric values as numbers) along with the abs(), round() and len() helpers. Only
+// literals, operators, conditionals and helper calls are allowed, so that evaluation time is
+// bounded by the length of the expression. Ranges, builtins such as map() and filter(), regular
+// expression matching and member access are rejected. Measurements with names that are not
+// valid identifiers (such as those containing dots) must be renamed before they can be
+// referenced in expressions. A 'set' statement is skipped if any measurement it
+// references is missing. An expression evaluating to nil removes the measurement. Values
+// computed by expressions are limited to MAX_VALUE_LENGTH characters so that repeated string
+// concatenation can not grow a measurement without bound.
+type Script struct {
+	Statements []Statement
+}
+
+// Collects identifiers referenced by an expression and rejects nodes that are not allowed.
+type identifiers struct {
+	names []string
+	err   error
+}
+
+func (ids *identifiers) Enter(node *ast.Node) {}
+
+func (ids *identifiers) Exit(node *ast.Node) {
+	if ids.err != nil {
+		return
+	}
+	switch n := (*node).(type) {
+	case *ast.NilNode, *ast.IntegerNode, *ast.FloatNode, *ast.BoolNode, *ast.StringNode,
+		*ast.UnaryNode, *ast.ConditionalNode:
+	case *ast.IdentifierNode:
+		if _, helper := helpers[n.Value]; !helper {
+			ids.names = append(ids.names, n.Value)
+		}
+	case *ast.BinaryNode:
+		if n.Operator == ".." {
+			ids.err = errors.New("ranges are not allowed in expressions")
+		}
+	case *ast.FunctionNode:
+		if _, helper := helpers[n.Name]; !helper {
+			ids.err = fmt.Errorf("unknown function: '%s'", n.Name)
+		}
+	case *ast.BuiltinNode:
+		if n.Name == "len" {
+			break
+		}
+		ids.err = fmt.Errorf("builtin '%s' is not allowed in expressions", n.Name)
+	case *ast.MatchesNode:
+		ids.err = errors.New("'matches' is not allowed in expressions")
+	default:
+		ids.err = errors.New("expressions may only use measurements, operators, conditionals and helpers")
+	}
+}
+
+// Compile an expression, rejecting it before compilation if it uses nodes that are not allowed.
+// Returns the program along with the measurements it references.
+func compileExpression(expression string) (*vm.Program, []string, error) {
+	if len(expression) > MAX_EXPRESSION_LENGTH {
+		return nil, nil, fmt.Errorf("expression exceeds limit of %d characters", MAX_EXPRESSION_LENGTH)
+	}
+	tree, err := parser.Parse(expression)
+	if err != nil {
+		return nil, nil, err
+	}
+	ids := &identifiers{}
+	ast.Walk(&tree.Node, ids)
+	if ids.err != nil {
+		return nil, nil, ids.err
+	}
+	program, err := expr.Compile(expression)
+	if err != nil {
+		return nil, nil, err
+	}
+	return program, ids.names, nil
+}
+
+// Parse a comma-separated list of measurement names.
+func parseNames(args string) ([]string, error) {
+	names := make([]string, 0)
+	for _, name := range strings.Split(args, ",") {
+		name = strings.TrimSpace(name)
+		if !namePattern.MatchString(name) {
+			return nil, fmt.Errorf("invalid measurement name: '%s'", name)
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
+
+// Parse a single statement.
+func parseStatement(line int, text string) (*Statement, error) {
+	op, args, _ := cut(text, " ")
+	args = strings.TrimSpace(args)
+	stmt := &Statement{Line: line, Op: op}
+	switch op {
+	case OP_RENAME:
+		fields := strings.Fields(args)
+		if len(fields) != 2 {
+			return nil, errors.New("rename expects a source and target name")
+		}
+		for _, field := range fields {
+			if !namePattern.MatchString(field) {
+				return nil, fmt.Errorf("invalid measurement name: '%s'", field)
+			}
+		}
+		stmt.Names = fields
+	case OP_SET:
+		name, expression, found := cut(args, "=")
+		name = strings.TrimSpace(name)
+		expression = strings.TrimSpace(expression)
+		if !found || expression == "" {
+			return nil, errors.New("set expects '<name> = <expression>'")
+		}
+		if !namePattern.MatchString(name) {
+			return nil, fmt.Errorf("invalid measurement name: '%s'", name)
+		}
+		program, inputs, err := compileExpression(expression)
+		if err != nil {
+			return nil, err
+		}
+		stmt.Names = []string{name}
+		stmt.Expression = expression
+		stmt.program = program
+		stmt.inputs = inputs
+	case OP_DROP, OP_SPLIT:
+		names, err := parseNames(args)
+		if err != nil {
+			return nil, err
+		}
+		stmt.Names = names
+	default:
+		return nil, fmt.Errorf("unknown statement: '%s'", op)
+	}
+	return stmt, nil
+}
+
+// Split text around the first instance of separator.
+func cut(text string, sep string) (string, string, bool) {
+	if i := strings.Index(text, sep); i >= 0 {
+		return text[:i], text[i+len(sep):], true
+	}
+	return text, "", false
+}
+
+// Compile a transformation script.
+func Compile(source string) (*Script, error) {
+	script := &Script{Statements: make([]Statement, 0)}
+	for i, line := range strings.Split(source, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if len(script.Statements) == MAX_STATEMENTS {
+			return nil, fmt.Errorf("script exceeds limit of %d statements", MAX_STATEMENTS)
+		}
+		stmt, err := parseStatement(i+1, line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		script.Statements = append(script.Statements, *stmt)
+	}
+	return script, nil
+}
+
+// Build the expression environment for a set of measurements.
+func environment(measurements map[string]string) map[string]interface{} {
+	env := make(map[string]interface{})
+	for name, value := range measurements {
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			env[name] = number
+		} else {
+			env[name] = value
+		}
+	}
+	for name, helper := range helpers {
+		env[name] = helper
+	}
+	return env
+}
+
+// Format an expression result as a measurement value.
+func format(value interface{}) string {
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Evaluate a set statement against measurements.
+func (stmt *Statement) evaluate(measurements map[string]string) error {
+	for _, input := range stmt.inputs {
+		if _, ok := measurements[input]; !ok {
+			return nil
+		}
+	}
+	result, err := expr.Run(stmt.program, environment(measurements))
+	if err != nil {
+		return fmt.Errorf("line %d: %w", stmt.Line, err)
+	}
+	if result == nil {
+		delete(measurements, stmt.Names[0])
+		return nil
+	}
+	value := format(result)
+	if len(value) > MAX_VALUE_LENGTH {
+		return fmt.Errorf("line %d: value exceeds limit of %d characters", stmt.Line, MAX_VALUE_LENGTH)
+	}
+	measurements[stmt.Names[0]] = value
+	return nil
+}
+
+// Apply the script to measurements from a single entry. Returns the remaining measurements
+// along with measurements moved by each split statement.
+func (script *Script) applyEntry(source map[string]string, deadline time.Time) (map[string]string, []map[string]string, error) {
+	measurements := make(map[string]string)
+	for name, value := range source {
+		measurements[name] = value
+	}
+	splits := make([]map[string]string, 0)
+	for i := range script.Statements {
+		stmt := &script.Statements[i]
+		switch stmt.Op {
+		case OP_RENAME:
+			if value, ok := measurements[stmt.Names[0]]; ok {
+				delete(measurements, stmt.Names[0])
+				measurements[stmt.Names[1]] = value
+			}
+		case OP_SET:
+			if err := stmt.evaluate(measurements); err != nil {
+				return nil, nil, err
+			}
+			if time.Now().After(deadline) {
+				return nil, nil, fmt.Errorf("script exceeded limit of %s", MAX_APPLY_DURATION)
+			}
+		case OP_DROP:
+			for _, name := range stmt.Names {
+				delete(measurements, name)
+			}
+		case OP_SPLIT:
+			moved := make(map[string]string)
+			for _, name := range stmt.Names {
+				if value, ok := measurements[name]; ok {
+					moved[name] = value
+					delete(measurements, name)
+				}
+			}
+			splits = append(splits, moved)
+		}
+	}
+	return measurements, splits, nil
+}
+
+// Create a copy of an event with the given measurement entries.
+func withEntries(event *esmodel.UnresolvedEvent, entries []esmodel.UnresolvedMeasurementsEntry) *esmodel.UnresolvedEvent {
+	copied := *event
+	copied.Payload = &esmodel.UnresolvedMeasurementsPayload{Entries: entries}
+	return &copied
+}
+
+// Apply the script to an unresolved event. Only measurement events are transformed. Other
+// events are returned unchanged. The result may contain no events if all measurements were
+// dropped or several events if measurements were split. Applying the script fails if it takes
+// longer than MAX_APPLY_DURATION.
+func (script *Script) Apply(event *esmodel.UnresolvedEvent) ([]*esmodel.UnresolvedEvent, error) {
+	payload, ok := event.Payload.(*esmodel.UnresolvedMeasurementsPayload)
+	if !ok || event.EventType != esmodel.Measurement {
+		return []*esmodel.UnresolvedEvent{event}, nil
+	}
+
+	deadline := time.Now().Add(MAX_APPLY_DURATION)
+	entries := make([]esmodel.UnresolvedMeasurementsEntry, 0)
+	splits := make([][]esmodel.UnresolvedMeasurementsEntry, 0)
+	for _, entry := range payload.Entries {
+		measurements, moved, err := script.applyEntry(entry.Measurements, deadline)
+		if err != nil {
+			return nil, err
+		}
+		if len(measurements) > 0 {
+			entries = append(entries, esmodel.UnresolvedMeasurementsEntry{
+				Measurements: measurements,
+				OccurredTime: entry.OccurredTime,
+			})
+		}
+		for i, mxs := range moved {
+			if len(splits) <= i {
+				splits = append(splits, make([]esmodel.UnresolvedMeasurementsEntry, 0))
+			}
+			if len(mxs) > 0 {
+				splits[i] = append(splits[i], esmodel.UnresolvedMeasurementsEntry{
+					Measurements: mxs,
+					OccurredTime: entry.OccurredTime,
+				})
+			}
+		}
+	}
+
+	events := make([]*esmodel.UnresolvedEvent, 0)
+	if len(entries) > 0 {
+		events = append(events, withEntries(event, entries))
+	}
+	for _, split := range splits {
+		if len(split) > 0 {
+			events = append(events, withEntries(event, split))
+		}
+	}
+	return events, nil
+}
@@ -0,0 +1,98 @@
+/**
+ * Copyright © 2022 DeviceChain
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package transform
+
+import (
+	"strings"
+	"testing"
+
+	esmodel "github.com/devicechain-io/dc-event-sources/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type ScriptTestSuite struct {
+	suite.Suite
+}
+
+// Build a measurements event with a single entry.
+func measurementsEvent(measurements map[string]string) *esmodel.UnresolvedEvent {
+	return &esmodel.UnresolvedEvent{
+		Device:    "TEST-123",
+		EventType: esmodel.Measurement,
+		Payload: &esmodel.UnresolvedMeasurementsPayload{
+			Entries: []esmodel.UnresolvedMeasurementsEntry{{Measurements: measurements}},
+		},
+	}
+}
+
+// Get measurements for the first entry of an event.
+func firstEntry(event *esmodel.UnresolvedEvent) map[string]string {
+	return event.Payload.(*esmodel.UnresolvedMeasurementsPayload).Entries[0].Measurements
+}
+
+// Test string values may be computed from other measurements.
+func (suite *ScriptTestSuite) TestStringValues() {
+	script, err := Compile(`set label = name + "-" + unit`)
+	assert.Nil(suite.T(), err)
+	events, err := script.Apply(measurementsEvent(map[string]string{"name": "temp", "unit": "C"}))
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "temp-C", firstEntry(events[0])["label"])
+}
+
+// Test an expression evaluating to nil removes the measurement.
+func (suite *ScriptTestSuite) TestNilRemovesMeasurement() {
+	script, err := Compile("set level = level > 100 ? nil : level")
+	assert.Nil(suite.T(), err)
+	events, err := script.Apply(measurementsEvent(map[string]string{"level": "150", "other": "1"}))
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), map[string]string{"other": "1"}, firstEntry(events[0]))
+}
+
+// Test repeated concatenation can not grow a value past the limit.
+func (suite *ScriptTestSuite) TestStringGrowthLimited() {
+	script, err := Compile(strings.Repeat("set x = x + x\n", MAX_STATEMENTS))
+	assert.Nil(suite.T(), err)
+	_, err = script.Apply(measurementsEvent(map[string]string{"x": "ab"}))
+	assert.NotNil(suite.T(), err)
+	assert.Contains(suite.T(), err.Error(), "value exceeds limit")
+	assert.Contains(suite.T(), err.Error(), "line 10")
+}
+
+// Test values at the limit are allowed.
+func (suite *ScriptTestSuite) TestValueAtLimit() {
+	script, err := Compile("set x = x + x")
+	assert.Nil(suite.T(), err)
+	half := strings.Repeat("a", MAX_VALUE_LENGTH/2)
+	events, err := script.Apply(measurementsEvent(map[string]string{"x": half}))
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), MAX_VALUE_LENGTH, len(firstEntry(events[0])["x"]))
+}
+
+// Test scripts over the statement limit are rejected.
+func (suite *ScriptTestSuite) TestStatementLimit() {
+	_, err := Compile(strings.Repeat("drop a\n", MAX_STATEMENTS))
+	assert.Nil(suite.T(), err)
+	_, err = Compile(strings.Repeat("drop a\n", MAX_STATEMENTS+1))
+	assert.NotNil(suite.T(), err)
+}
+
+// Run all tests.
+func TestScriptTestSuite(t *testing.T) {
+	suite.Run(t, new(ScriptTestSuite))
+}